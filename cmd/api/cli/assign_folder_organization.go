@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"gallery-service/internal/application"
+	"github.com/spf13/cobra"
+)
+
+const AssignFolderOrganizationCommand = "assign-folder-organization"
+
+var (
+	assignFolderID       string
+	assignOrganizationID string
+)
+
+var assignFolderOrganization = &cobra.Command{
+	Use:   AssignFolderOrganizationCommand,
+	Short: "Move a folder subtree and its clusters into an organization",
+	Long:  "Move a folder subtree and its clusters into an organization. Leave --organization empty to make them global.",
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		app, appErr := application.New(configPath)
+		if appErr != nil {
+			return appErr
+		}
+
+		return app.AssignFolderOrganization(assignFolderID, assignOrganizationID)
+	},
+}
+
+func init() {
+	assignFolderOrganization.Flags().StringVar(&assignFolderID, "folder", "", "id of the root folder of the subtree")
+	assignFolderOrganization.Flags().StringVar(&assignOrganizationID, "organization", "", "id of the owner organization, empty for global")
+	_ = assignFolderOrganization.MarkFlagRequired("folder")
+
+	cmd.AddCommand(assignFolderOrganization)
+}
@@ -0,0 +1,25 @@
+package cli
+
+import (
+	"gallery-service/internal/application"
+	"github.com/spf13/cobra"
+)
+
+const BackfillFolderAncestorsCommand = "backfill-folder-ancestors"
+
+var backfillFolderAncestors = &cobra.Command{
+	Use:   BackfillFolderAncestorsCommand,
+	Short: "Fill ancestors and depth of existing folders",
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		app, appErr := application.New(configPath)
+		if appErr != nil {
+			return appErr
+		}
+
+		return app.BackfillFolderAncestors()
+	},
+}
+
+func init() {
+	cmd.AddCommand(backfillFolderAncestors)
+}
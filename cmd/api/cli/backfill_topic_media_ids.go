@@ -0,0 +1,26 @@
+package cli
+
+import (
+	"gallery-service/internal/application"
+	"github.com/spf13/cobra"
+)
+
+const BackfillTopicMediaIDsCommand = "backfill-topic-media-ids"
+
+var backfillTopicMediaIDs = &cobra.Command{
+	Use:   BackfillTopicMediaIDsCommand,
+	Short: "Give an id to the media of existing topics",
+	Long:  "Give an id to the images, videos and audios of the topics stored before media items had one. Topics edited while it runs are left for the next run.",
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		app, appErr := application.New(configPath)
+		if appErr != nil {
+			return appErr
+		}
+
+		return app.BackfillTopicMediaIDs()
+	},
+}
+
+func init() {
+	cmd.AddCommand(backfillTopicMediaIDs)
+}
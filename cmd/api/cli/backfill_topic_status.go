@@ -0,0 +1,26 @@
+package cli
+
+import (
+	"gallery-service/internal/application"
+	"github.com/spf13/cobra"
+)
+
+const BackfillTopicStatusCommand = "backfill-topic-status"
+
+var backfillTopicStatus = &cobra.Command{
+	Use:   BackfillTopicStatusCommand,
+	Short: "Set the workflow status of existing topics from is_published",
+	Long:  "Set the workflow status of the topics stored before the editorial workflow: published when is_published is set, draft otherwise.",
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		app, appErr := application.New(configPath)
+		if appErr != nil {
+			return appErr
+		}
+
+		return app.BackfillTopicStatus()
+	},
+}
+
+func init() {
+	cmd.AddCommand(backfillTopicStatus)
+}
@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"gallery-service/internal/application"
+	"gallery-service/internal/pkg/constants"
+	"github.com/spf13/cobra"
+)
+
+const ExportFolderCommand = "export-folder"
+
+var (
+	exportFolderID string
+	exportTopicIDs []string
+	exportOutput   string
+	exportFormat   string
+)
+
+var exportFolder = &cobra.Command{
+	Use:   ExportFolderCommand,
+	Short: "Export a folder subtree, its clusters and related topics to a bundle",
+	Long:  "Export a folder subtree, its clusters and the topics given with --topic to a versioned bundle with a manifest, as one JSON document or as a ZIP archive.",
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		app, appErr := application.New(configPath)
+		if appErr != nil {
+			return appErr
+		}
+
+		return app.ExportFolder(exportFolderID, exportTopicIDs, exportOutput, constants.BundleEncoding(exportFormat))
+	},
+}
+
+func init() {
+	exportFolder.Flags().StringVar(&exportFolderID, "folder", "", "id of the root folder of the subtree")
+	exportFolder.Flags().StringSliceVar(&exportTopicIDs, "topic", nil, "id of a topic to bundle along, repeatable")
+	exportFolder.Flags().StringVarP(&exportOutput, "output", "o", "", "bundle file to write, folder-<id>.<format> by default")
+	exportFolder.Flags().StringVar(&exportFormat, "format", constants.BundleEncodingJSON.String(), "json or zip")
+	_ = exportFolder.MarkFlagRequired("folder")
+
+	cmd.AddCommand(exportFolder)
+}
@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"gallery-service/internal/application"
+	"gallery-service/internal/pkg/constants"
+	"github.com/spf13/cobra"
+)
+
+const ImportFolderCommand = "import-folder"
+
+var (
+	importInput      string
+	importParentID   string
+	importOnConflict string
+	importDryRun     bool
+)
+
+var importFolder = &cobra.Command{
+	Use:   ImportFolderCommand,
+	Short: "Import a bundle written by export-folder",
+	Long:  "Import a bundle written by export-folder under a folder, or at the root. Every entry gets a new id, folders merge into a folder of the same name, and clusters and topics whose name is taken are skipped or overwritten. Use --dry-run to report what would change.",
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		app, appErr := application.New(configPath)
+		if appErr != nil {
+			return appErr
+		}
+
+		return app.ImportFolder(importInput, importParentID, constants.BundleConflict(importOnConflict), importDryRun)
+	},
+}
+
+func init() {
+	importFolder.Flags().StringVarP(&importInput, "input", "i", "", "bundle file to read")
+	importFolder.Flags().StringVar(&importParentID, "parent", "", "id of the folder to import under, empty for root")
+	importFolder.Flags().StringVar(&importOnConflict, "on-conflict", constants.BundleConflictSkip.String(), "skip or overwrite clusters and topics whose name is taken")
+	importFolder.Flags().BoolVar(&importDryRun, "dry-run", false, "report what would change without writing")
+	_ = importFolder.MarkFlagRequired("input")
+
+	cmd.AddCommand(importFolder)
+}
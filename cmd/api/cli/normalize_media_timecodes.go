@@ -0,0 +1,26 @@
+package cli
+
+import (
+	"gallery-service/internal/application"
+	"github.com/spf13/cobra"
+)
+
+const NormalizeMediaTimecodesCommand = "normalize-media-timecodes"
+
+var normalizeMediaTimecodes = &cobra.Command{
+	Use:   NormalizeMediaTimecodesCommand,
+	Short: "Rewrite media start and end times in the canonical timecode form",
+	Long:  "Rewrite media start and end times of clusters and topics in the canonical timecode form. Values that cannot be parsed are left as they are and reported in the log.",
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		app, appErr := application.New(configPath)
+		if appErr != nil {
+			return appErr
+		}
+
+		return app.NormalizeMediaTimecodes()
+	},
+}
+
+func init() {
+	cmd.AddCommand(normalizeMediaTimecodes)
+}
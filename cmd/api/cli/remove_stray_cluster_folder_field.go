@@ -0,0 +1,26 @@
+package cli
+
+import (
+	"gallery-service/internal/application"
+	"github.com/spf13/cobra"
+)
+
+const RemoveStrayClusterFolderFieldCommand = "remove-stray-cluster-folder-field"
+
+var removeStrayClusterFolderField = &cobra.Command{
+	Use:   RemoveStrayClusterFolderFieldCommand,
+	Short: "Remove the misspelt folder field cluster updates stored",
+	Long:  "Remove the \"folder_id\\t\" field that cluster updates stored instead of moving the cluster. Clusters stay in the folder of their folder_id.",
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		app, appErr := application.New(configPath)
+		if appErr != nil {
+			return appErr
+		}
+
+		return app.RemoveStrayClusterFolderField()
+	},
+}
+
+func init() {
+	cmd.AddCommand(removeStrayClusterFolderField)
+}
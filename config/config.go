@@ -8,6 +8,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 	"os"
+	"time"
 )
 
 // AppConfiguration holds the application-specific configuration
@@ -46,6 +47,31 @@ type Registry struct {
 	Host string `mapstructure:"host" validate:"required"`
 }
 
+// TrashConfig holds how long deleted content is kept and how often the trash is purged
+type TrashConfig struct {
+	Retention     time.Duration `mapstructure:"retention"`
+	PurgeInterval time.Duration `mapstructure:"purge_interval"`
+}
+
+// ScheduleConfig holds how often scheduled publications are applied and how long the
+// replica applying them holds the lease that keeps the others from doing it too
+type ScheduleConfig struct {
+	Interval time.Duration `mapstructure:"interval"`
+	LeaseTTL time.Duration `mapstructure:"lease_ttl"`
+}
+
+// LanguageConfig holds the languages user and gateway reads fall back to, in order, when
+// the content has none of the languages the request asks for
+type LanguageConfig struct {
+	Fallback []string `mapstructure:"fallback"`
+}
+
+// RegistryCacheConfig holds how often each replica reloads the languages and components
+// from the database, to pick up the changes made through another replica
+type RegistryCacheConfig struct {
+	RefreshInterval time.Duration `mapstructure:"refresh_interval"`
+}
+
 // Config is the overall configuration structure
 type Config struct {
 	App      AppConfiguration `mapstructure:"app"`
@@ -53,6 +79,11 @@ type Config struct {
 	Consul   Consul           `mapstructure:"consul" validate:"required"`
 	Registry Registry         `mapstructure:"registry" validate:"required"`
 	Kafka    kafka.Config     `mapstructure:"kafka" validate:"required"`
+	Trash    TrashConfig      `mapstructure:"trash"`
+	Schedule ScheduleConfig   `mapstructure:"schedule"`
+	Language LanguageConfig   `mapstructure:"language"`
+
+	RegistryCache RegistryCacheConfig `mapstructure:"registry_cache"`
 }
 
 // LoadConfig reads the configuration from a file
@@ -69,6 +100,19 @@ func LoadConfig(cfg *viper.Viper, configPath string) (*Config, error) {
 
 	cfg.AutomaticEnv() // read in environment variables that match
 
+	// Collections added after the first release default so older config files keep working
+	cfg.SetDefault("mongo.collections.revision", "revisions")
+	cfg.SetDefault("mongo.collections.taxonomy", "taxonomy_terms")
+	cfg.SetDefault("mongo.collections.lease", "leases")
+	cfg.SetDefault("mongo.collections.language", "languages")
+	cfg.SetDefault("mongo.collections.component", "components")
+	cfg.SetDefault("trash.retention", "720h")
+	cfg.SetDefault("trash.purge_interval", "1h")
+	cfg.SetDefault("schedule.interval", "1m")
+	cfg.SetDefault("schedule.lease_ttl", "3m")
+	cfg.SetDefault("language.fallback", []string{"vi", "en"})
+	cfg.SetDefault("registry_cache.refresh_interval", "1m")
+
 	// If a config file is found, read it in.
 	if err := cfg.ReadInConfig(); err == nil {
 
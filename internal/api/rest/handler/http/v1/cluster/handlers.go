@@ -1,21 +1,29 @@
 package cluster
 
 import (
+	"context"
 	"gallery-service/config"
 	"gallery-service/internal/api/rest/validator"
 	clusterCommands "gallery-service/internal/application/commands/v1/cluster"
 	requests "gallery-service/internal/application/dto/requests/cluster"
-	"gallery-service/internal/application/dto/responses"
+	revisionRequests "gallery-service/internal/application/dto/requests/revision"
+	responses "gallery-service/internal/application/dto/responses/cluster"
+	"gallery-service/internal/application/mappers"
 	clusterQueries "gallery-service/internal/application/queries/cluster"
 	"gallery-service/internal/domain/service"
 	constants2 "gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/language"
+	"gallery-service/internal/pkg/registry"
+	"gallery-service/internal/pkg/tags"
 	"gallery-service/pkg/constants"
 	httpPkg "gallery-service/pkg/http"
 	"gallery-service/pkg/utils"
 	"gallery-service/pkg/zap"
 	"net/http"
+	"net/url"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 )
@@ -51,7 +59,7 @@ func NewClusterHandlers(
 // @Success 201 {string} id ""
 // @Router /clusters [post]
 func (p *clusterHandlers) CreateCluster(c *fiber.Ctx) error {
-	ctx := c.Context()
+	ctx := c.UserContext()
 	var reqDto requests.CreateClusterReqDto
 	if err := c.BodyParser(&reqDto); err != nil {
 		p.log.Errorf("(Bind) err: {%v}", err)
@@ -69,6 +77,7 @@ func (p *clusterHandlers) CreateCluster(c *fiber.Ctx) error {
 		reqDto.Image,
 		reqDto.LanguageConfig,
 		reqDto.FolderID,
+		reqDto.Tags,
 	)
 
 	clusterID, err := p.ps.Commands.CreateCluster.Handle(ctx, command)
@@ -116,6 +125,7 @@ func (p *clusterHandlers) UpdateCluster(c *fiber.Ctx) error {
 		reqDto.Image,
 		reqDto.LanguageConfig,
 		reqDto.FolderID,
+		reqDto.Tags,
 	)
 	err = p.ps.Commands.UpdateCluster.Handle(ctx, command)
 
@@ -128,20 +138,316 @@ func (p *clusterHandlers) UpdateCluster(c *fiber.Ctx) error {
 	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Cluster updated", clusterID.Hex())
 }
 
+// PatchCluster
+// @Tags clusters
+// @Summary Patch Cluster
+// @Description Change some fields of a Cluster with a JSON merge patch (RFC 7396). The merged Cluster is checked like an update and only the changed fields are saved
+// @Accept json
+// @Produce json
+// @Param id path string true "Cluster ID"
+// @Param Cluster body dto.PatchClusterReqDto true "merge patch"
+// @Success 200 {string} id ""
+// @Router /clusters/{id} [patch]
+func (p *clusterHandlers) PatchCluster(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	clusterID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.Patch)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	command := clusterCommands.NewPatchClusterCommand(clusterID.Hex(), c.Body())
+	err = p.val.DataValidation(command)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	err = p.ps.Commands.PatchCluster.Handle(ctx, command)
+	if err != nil {
+		p.log.Errorf("(Patch.Handle) id: {%s}, err: {%v}", clusterID.Hex(), err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Cluster patched) id: {%s}", clusterID.Hex())
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Cluster patched", clusterID.Hex())
+}
+
+// SetClusterLanguage
+// @Tags clusters
+// @Summary Set Cluster language
+// @Description Add the entry of one language to a Cluster, or replace the one it has. code is one of the Cluster languages
+// @Accept json
+// @Produce json
+// @Param id path string true "Cluster ID"
+// @Param code path string true "language code"
+// @Param Cluster body dto.SetClusterLanguageReqDto true "language entry"
+// @Success 200 {string} id ""
+// @Success 201 {string} id ""
+// @Router /clusters/{id}/languages/{code} [put]
+func (p *clusterHandlers) SetClusterLanguage(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	clusterID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.SetLanguage)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	var reqDto requests.SetClusterLanguageReqDto
+	if err := c.BodyParser(&reqDto); err != nil {
+		p.log.Errorf("(Bind) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	command := clusterCommands.NewSetClusterLanguageCommand(clusterID.Hex(), c.Params(constants.Code), reqDto.Video, reqDto.Audio)
+	err = p.val.DataValidation(command)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	added, err := p.ps.Commands.SetLanguage.Handle(ctx, command)
+	if err != nil {
+		p.log.Errorf("(SetLanguage.Handle) id: {%s}, code: {%s}, err: {%v}", clusterID.Hex(), command.Code, err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Cluster language saved) id: {%s}, code: {%s}", clusterID.Hex(), command.Code)
+	if added {
+		return httpPkg.SuccessCtxResponse(c, http.StatusCreated, "Cluster language added", clusterID.Hex())
+	}
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Cluster language replaced", clusterID.Hex())
+}
+
+// RemoveClusterLanguage
+// @Tags clusters
+// @Summary Remove Cluster language
+// @Description Remove the entry of one language from a Cluster
+// @Accept json
+// @Produce json
+// @Param id path string true "Cluster ID"
+// @Param code path string true "language code"
+// @Success 200 {string} id ""
+// @Router /clusters/{id}/languages/{code} [delete]
+func (p *clusterHandlers) RemoveClusterLanguage(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	clusterID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.RemoveLanguage)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	command := clusterCommands.NewRemoveClusterLanguageCommand(clusterID.Hex(), c.Params(constants.Code))
+	err = p.val.DataValidation(command)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	err = p.ps.Commands.RemoveLanguage.Handle(ctx, command)
+	if err != nil {
+		p.log.Errorf("(RemoveLanguage.Handle) id: {%s}, code: {%s}, err: {%v}", clusterID.Hex(), command.Code, err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Cluster language removed) id: {%s}, code: {%s}", clusterID.Hex(), command.Code)
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Cluster language removed", clusterID.Hex())
+}
+
+// AddClusterTerm
+// @Tags clusters
+// @Summary Add Cluster taxonomy term
+// @Description Classify a Cluster with a taxonomy term
+// @Accept json
+// @Produce json
+// @Param id path string true "Cluster ID"
+// @Param term path string true "taxonomy term ID"
+// @Success 200 {string} id ""
+// @Success 201 {string} id ""
+// @Router /clusters/{id}/terms/{term} [put]
+func (p *clusterHandlers) AddClusterTerm(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	clusterID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.AddTerm)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	command := clusterCommands.NewAddClusterTermCommand(clusterID.Hex(), c.Params(constants.Term))
+	err = p.val.DataValidation(command)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	added, err := p.ps.Commands.AddTerm.Handle(ctx, command)
+	if err != nil {
+		p.log.Errorf("(AddTerm.Handle) id: {%s}, term: {%s}, err: {%v}", clusterID.Hex(), command.TermID, err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Cluster term added) id: {%s}, term: {%s}", clusterID.Hex(), command.TermID)
+	if added {
+		return httpPkg.SuccessCtxResponse(c, http.StatusCreated, "Cluster term added", clusterID.Hex())
+	}
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Cluster term already added", clusterID.Hex())
+}
+
+// RemoveClusterTerm
+// @Tags clusters
+// @Summary Remove Cluster taxonomy term
+// @Description Remove a taxonomy term from the classification of a Cluster
+// @Accept json
+// @Produce json
+// @Param id path string true "Cluster ID"
+// @Param term path string true "taxonomy term ID"
+// @Success 200 {string} id ""
+// @Router /clusters/{id}/terms/{term} [delete]
+func (p *clusterHandlers) RemoveClusterTerm(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	clusterID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.RemoveTerm)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	command := clusterCommands.NewRemoveClusterTermCommand(clusterID.Hex(), c.Params(constants.Term))
+	err = p.val.DataValidation(command)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	err = p.ps.Commands.RemoveTerm.Handle(ctx, command)
+	if err != nil {
+		p.log.Errorf("(RemoveTerm.Handle) id: {%s}, term: {%s}, err: {%v}", clusterID.Hex(), command.TermID, err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Cluster term removed) id: {%s}, term: {%s}", clusterID.Hex(), command.TermID)
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Cluster term removed", clusterID.Hex())
+}
+
+// ReorderClusters
+// @Tags clusters
+// @Summary Reorder Clusters
+// @Description Set the manual order of the Clusters in a Folder. Clusters left out keep their relative order after the listed ones
+// @Accept json
+// @Produce json
+// @Param Cluster body dto.ReorderClustersReqDto true "reorder Clusters"
+// @Success 200 {string} id ""
+// @Router /clusters/reorder [put]
+func (p *clusterHandlers) ReorderClusters(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var reqDto requests.ReorderClustersReqDto
+	if err := c.BodyParser(&reqDto); err != nil {
+		p.log.Errorf("(Bind) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	command := clusterCommands.NewReorderClustersCommand(reqDto.FolderID, reqDto.IDs)
+	err := p.val.DataValidation(command)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	err = p.ps.Commands.ReorderClusters.Handle(ctx, command)
+	if err != nil {
+		p.log.Errorf("(Reorder.Handle) folder_id: {%s}, err: {%v}", command.FolderID, err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Clusters reordered) folder_id: {%s}", command.FolderID)
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Clusters reordered", command.FolderID)
+}
+
+// MoveClusters
+// @Tags clusters
+// @Summary Move Clusters
+// @Description Move Clusters into a Folder in one transaction, appended after its existing Clusters. Reports the outcome of every requested Cluster
+// @Accept json
+// @Produce json
+// @Param Cluster body dto.MoveClustersReqDto true "move Clusters"
+// @Success 200 {object} responses.MoveClustersResponseDto
+// @Router /clusters/move [post]
+func (p *clusterHandlers) MoveClusters(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var reqDto requests.MoveClustersReqDto
+	if err := c.BodyParser(&reqDto); err != nil {
+		p.log.Errorf("(Bind) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	command := clusterCommands.NewMoveClustersCommand(reqDto.FolderID, reqDto.IDs)
+	err := p.val.DataValidation(command)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	res, err := p.ps.Commands.MoveClusters.Handle(ctx, command)
+	if err != nil {
+		p.log.Errorf("(Move.Handle) folder_id: {%s}, err: {%v}", command.FolderID, err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Clusters moved) folder_id: {%s}, moved: {%d}", command.FolderID, res.Moved)
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Clusters moved", res)
+}
+
 // GetAllCluster
 // @Tags clusters
 // @Summary Get all clusters
-// @Description Get all clusters
+// @Description Get all clusters, optionally carrying tags, with the number of clusters listed per tag in facets
 // @Accept json
 // @Produce json
+// @Param tags query string false "comma separated tags"
+// @Param tag_mode query string false "and (every tag, the default) or or (any tag)"
 // @Success 200 {object} responses.GetAllClusterResponseDto
 // @Router /clusters/ [get]
 func (p *clusterHandlers) GetAllCluster(c *fiber.Ctx) error {
-	ctx := c.Context()
+	return p.getAllCluster(c, p.ps.Queries.GetAllCluster.Handle)
+}
+
+// GetAllCluster4App
+// @Tags clusters
+// @Summary Get all clusters shown to users
+// @Description Get all clusters that are not hidden, optionally carrying tags, with the number of clusters listed per tag in facets
+// @Accept json
+// @Produce json
+// @Param tags query string false "comma separated tags"
+// @Param tag_mode query string false "and (every tag, the default) or or (any tag)"
+// @Success 200 {object} responses.GetAllClusterResponseDto
+// @Router /user/gallery/clusters/ [get]
+func (p *clusterHandlers) GetAllCluster4App(c *fiber.Ctx) error {
+	return p.getAllCluster(c, p.ps.Queries.GetAllCluster.Handle4App)
+}
+
+func (p *clusterHandlers) getAllCluster(
+	c *fiber.Ctx,
+	handle func(ctx context.Context, query *clusterQueries.GetAllClusterQuery) (*responses.GetAllClusterResponseDto, error),
+) error {
+	ctx := c.UserContext()
+
+	var reqDto requests.GetAllClusterReqDto
+	if err := c.QueryParser(&reqDto); err != nil {
+		p.log.Errorf("(Bind) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+	if err := p.val.DataValidation(reqDto); err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
 
 	pq := utils.NewPaginationQuery(0, 0)
+	query := clusterQueries.NewGetAllClusterQuery(tags.Split(reqDto.Tags), constants2.TagMatch(reqDto.TagMode), tags.Split(reqDto.Terms), pq)
 
-	response, err := p.ps.Queries.GetAllCluster.Handle(ctx, pq)
+	response, err := handle(ctx, query)
 	if err != nil {
 		p.log.Errorf("(Create.Handle) Error fetching clusters: {%v}", err)
 		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
@@ -162,7 +468,7 @@ func (p *clusterHandlers) GetAllCluster(c *fiber.Ctx) error {
 // @Success 200 {object} dto.ClusterResponseDto
 // @Router /clusters/{id} [get]
 func (p *clusterHandlers) GetClusterByID(c *fiber.Ctx) error {
-	ctx := c.Context()
+	ctx := c.UserContext()
 	param := c.Params(constants.ID)
 	p.log.Infof("(Handlers.GetByID) id: {%s}", param)
 
@@ -189,6 +495,170 @@ func (p *clusterHandlers) GetClusterByID(c *fiber.Ctx) error {
 	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Cluster found", cluster)
 }
 
+// GetClusterByID4App
+// @Tags clusters
+// @Summary Get Cluster in one language
+// @Description Get Cluster by id in the language asked for with lang or Accept-Language, following the configured fallback chain. The served language is in language and the Content-Language header
+// @Accept json
+// @Produce json
+// @Param id path string true "Cluster ID"
+// @Param lang query string false "language code"
+// @Param Accept-Language header string false "preferred languages"
+// @Success 200 {object} responses.LocalizedClusterResponseDto
+// @Router /user/gallery/clusters/{id} [get]
+func (p *clusterHandlers) GetClusterByID4App(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+	p.log.Infof("(Handlers.GetByID) id: {%s}", param)
+
+	clusterID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetByID)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	languages, err := language.Chain(c.Query(constants.Lang), c.Get(fiber.HeaderAcceptLanguage), p.cfg.Language.Fallback)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	clusterQuery := clusterQueries.NewGetLocalizedClusterQuery(clusterID.Hex(), languages)
+	err = p.val.DataValidation(clusterQuery)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	cluster, err := p.ps.Queries.GetClusterByID.Handle4App(ctx, clusterQuery)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetByID)(Handle) id: {%s}, err: {%v}", clusterID.String(), err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	if code := registry.Code(cluster.Language); code != "" {
+		c.Set(fiber.HeaderContentLanguage, code)
+	}
+
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Cluster found", cluster)
+}
+
+// GetClusterRevisions
+// @Tags clusters
+// @Summary Get Cluster revisions
+// @Description Get the revisions of a Cluster, newest first. Each revision holds the Cluster as it was before the change, who made the change and when
+// @Accept json
+// @Produce json
+// @Param id path string true "Cluster ID"
+// @Success 200 {object} responses.GetAllRevisionResponseDto
+// @Router /clusters/{id}/revisions [get]
+func (p *clusterHandlers) GetClusterRevisions(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	clusterID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetRevisions)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	clusterQuery := clusterQueries.NewGetClusterByIDQuery(clusterID.Hex())
+	err = p.val.DataValidation(clusterQuery)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	revisions, err := p.ps.Queries.GetRevisions.Handle(ctx, clusterQuery)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetRevisions)(Handle) id: {%s}, err: {%v}", clusterID.String(), err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Cluster revisions found", revisions)
+}
+
+// GetClusterRevisionDiff
+// @Tags clusters
+// @Summary Diff Cluster revisions
+// @Description Compare two revisions of a Cluster field by field. to defaults to the current Cluster
+// @Accept json
+// @Produce json
+// @Param id path string true "Cluster ID"
+// @Param from query int true "revision number"
+// @Param to query int false "revision number, 0 for the current Cluster"
+// @Success 200 {object} responses.RevisionDiffResponseDto
+// @Router /clusters/{id}/revisions/diff [get]
+func (p *clusterHandlers) GetClusterRevisionDiff(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	clusterID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetRevisionDiff)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	var reqDto revisionRequests.RevisionDiffReqDto
+	if err := c.QueryParser(&reqDto); err != nil {
+		p.log.Errorf("(Bind) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	clusterQuery := clusterQueries.NewGetClusterRevisionDiffQuery(clusterID.Hex(), reqDto.From, reqDto.To)
+	err = p.val.DataValidation(clusterQuery)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	diff, err := p.ps.Queries.GetRevisionDiff.Handle(ctx, clusterQuery)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetRevisionDiff)(Handle) id: {%s}, err: {%v}", clusterID.String(), err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Cluster revision diff found", diff)
+}
+
+// RestoreClusterRevision
+// @Tags clusters
+// @Summary Restore Cluster revision
+// @Description Write an old revision back to the Cluster. The replaced content is kept as a new revision, the Cluster stays in its folder
+// @Accept json
+// @Produce json
+// @Param id path string true "Cluster ID"
+// @Param revision path int true "revision number"
+// @Success 200 {integer} revision ""
+// @Router /clusters/{id}/revisions/{revision}/restore [post]
+func (p *clusterHandlers) RestoreClusterRevision(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	clusterID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.RestoreRevision)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	number, err := c.ParamsInt(constants.Revision)
+	if err != nil {
+		p.log.Errorf("(Handlers.RestoreRevision)(ParamsInt) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	command := clusterCommands.NewRestoreClusterRevisionCommand(clusterID.Hex(), int64(number))
+	err = p.val.DataValidation(command)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	revision, err := p.ps.Commands.RestoreRevision.Handle(ctx, command)
+	if err != nil {
+		p.log.Errorf("(RestoreRevision.Handle) id: {%s}, err: {%v}", clusterID.Hex(), err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Cluster revision restored) id: {%s}, revision: {%d}", clusterID.Hex(), command.Revision)
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Cluster revision restored", revision)
+}
+
 // SearchCluster
 // @Tags clusters
 // @Summary Search clusters
@@ -196,12 +666,38 @@ func (p *clusterHandlers) GetClusterByID(c *fiber.Ctx) error {
 // @Accept json
 // @Produce json
 // @Param search queries string false "search text"
+// @Param tags query string false "comma separated tags"
+// @Param tag_mode query string false "and (every tag, the default) or or (any tag)"
 // @Param page queries string false "page number"
 // @Param size queries string false "number of elements"
 // @Success 200 {object} dto.ClusterSearchResponseDto
 // @Router /clusters/search [get]
 func (p *clusterHandlers) SearchCluster(c *fiber.Ctx) error {
-	ctx := c.Context()
+	return p.searchCluster(c, p.ps.Queries.SearchClusters.Handle)
+}
+
+// SearchCluster4App
+// @Tags clusters
+// @Summary Search clusters shown to users
+// @Description Full text search by title and description among the clusters that are not hidden
+// @Accept json
+// @Produce json
+// @Param search queries string false "search text"
+// @Param tags query string false "comma separated tags"
+// @Param tag_mode query string false "and (every tag, the default) or or (any tag)"
+// @Param page queries string false "page number"
+// @Param size queries string false "number of elements"
+// @Success 200 {object} dto.ClusterSearchResponseDto
+// @Router /user/gallery/clusters/search [get]
+func (p *clusterHandlers) SearchCluster4App(c *fiber.Ctx) error {
+	return p.searchCluster(c, p.ps.Queries.SearchClusters.Handle4App)
+}
+
+func (p *clusterHandlers) searchCluster(
+	c *fiber.Ctx,
+	handle func(ctx context.Context, query *clusterQueries.SearchClustersQuery) (*responses.GetAllClusterResponseDto, error),
+) error {
+	ctx := c.UserContext()
 	pq := utils.NewPaginationFromQueryParams(c.Query(constants.Size), c.Query(constants.Page))
 
 	var reqDto requests.SearchClusterFilterReqDto
@@ -216,10 +712,13 @@ func (p *clusterHandlers) SearchCluster(c *fiber.Ctx) error {
 
 	clusterQuery := clusterQueries.NewSearchClustersQuery(
 		reqDto.Keyword,
+		tags.Split(reqDto.Tags),
+		constants2.TagMatch(reqDto.TagMode),
+		tags.Split(reqDto.Terms),
 		pq,
 	)
 
-	searchRes, err := p.ps.Queries.SearchClusters.Handle(ctx, clusterQuery)
+	searchRes, err := handle(ctx, clusterQuery)
 	if err != nil {
 		p.log.Errorf("(Handlers.Search)(Handle) query: {%v}, err: {%v}", reqDto, err)
 		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
@@ -233,14 +732,14 @@ func (p *clusterHandlers) SearchCluster(c *fiber.Ctx) error {
 // DeleteCluster
 // @Tags clusters
 // @Summary Delete Cluster
-// @Description Delete Cluster by id
+// @Description Move Cluster to the trash by id
 // @Accept json
 // @Produce json
 // @Param id path string true "Cluster ID"
 // @Success 200 {object} dto.ClusterResponseDto
 // @Router /clusters/{id} [post]
 func (p *clusterHandlers) DeleteCluster(c *fiber.Ctx) error {
-	ctx := c.Context()
+	ctx := c.UserContext()
 	param := c.Params(constants.ID)
 	p.log.Infof("(Handlers.Delete) id: {%s}", param)
 
@@ -267,32 +766,75 @@ func (p *clusterHandlers) DeleteCluster(c *fiber.Ctx) error {
 	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Cluster deleted", clusterID)
 }
 
+// GetClusterComponents
+// @Tags clusters
+// @Summary Get Cluster components
+// @Description Get the enabled components in display order, each named in the language asked for with lang or Accept-Language
+// @Produce json
+// @Param lang query string false "language code"
+// @Param Accept-Language header string false "preferred languages"
+// @Success 200 {object} []dto.KeyValueResponseDto
+// @Router /clusters/components [get]
 func (p *clusterHandlers) GetClusterComponents(c *fiber.Ctx) error {
-	res := make([]responses.KeyValueResponseDto, 0, len(constants2.Components))
-	for k, v := range constants2.Components {
-		res = append(res, responses.KeyValueResponseDto{
-			Key:   k,
-			Value: v.String(),
-		})
+	languages, err := language.Chain(c.Query(constants.Lang), c.Get(fiber.HeaderAcceptLanguage), p.cfg.Language.Fallback)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
 	}
 
-	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Cluster components found", res)
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Cluster components found", mappers.GetComponentOptions(languages))
 }
 
+// GetClusterLanguages
+// @Tags clusters
+// @Summary Get Cluster languages
+// @Description Get the enabled languages in display order, each named in the language asked for with lang or Accept-Language
+// @Produce json
+// @Param lang query string false "language code"
+// @Param Accept-Language header string false "preferred languages"
+// @Success 200 {object} []dto.KeyValueResponseDto
+// @Router /clusters/languages [get]
 func (p *clusterHandlers) GetClusterLanguages(c *fiber.Ctx) error {
-	res := make([]responses.KeyValueResponseDto, 0, len(constants2.GalleryLanguages))
-	for k, v := range constants2.GalleryLanguages {
-		res = append(res, responses.KeyValueResponseDto{
-			Key:   k,
-			Value: v.String(),
-		})
+	languages, err := language.Chain(c.Query(constants.Lang), c.Get(fiber.HeaderAcceptLanguage), p.cfg.Language.Fallback)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
 	}
 
-	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Cluster languages found", res)
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Cluster languages found", mappers.GetLanguageOptions(languages))
 }
 
+// GetClusterFolders
+// @Tags clusters
+// @Summary Get clusters of a folder
+// @Description Get clusters of a folder, including its subfolders when recursive is true
+// @Accept json
+// @Produce json
+// @Param id path string true "Folder ID"
+// @Param recursive query bool false "include clusters of subfolders"
+// @Success 200 {object} responses.GetAllClusterResponseDto
+// @Router /clusters/{id}/folders [get]
 func (p *clusterHandlers) GetClusterFolders(c *fiber.Ctx) error {
-	ctx := c.Context()
+	return p.getClusterFolders(c, p.ps.Queries.GetAllClusterFolder.Handle)
+}
+
+// GetClusterFolders4App
+// @Tags clusters
+// @Summary Get clusters of a folder shown to users
+// @Description Get the clusters of a folder that are not hidden, including its subfolders when recursive is true
+// @Accept json
+// @Produce json
+// @Param id path string true "Folder ID"
+// @Param recursive query bool false "include clusters of subfolders"
+// @Success 200 {object} responses.GetAllClusterResponseDto
+// @Router /user/gallery/clusters/{id}/folders [get]
+func (p *clusterHandlers) GetClusterFolders4App(c *fiber.Ctx) error {
+	return p.getClusterFolders(c, p.ps.Queries.GetAllClusterFolder.Handle4App)
+}
+
+func (p *clusterHandlers) getClusterFolders(
+	c *fiber.Ctx,
+	handle func(ctx context.Context, query *clusterQueries.GetFolderID, pq *utils.Pagination) (*responses.GetAllClusterResponseDto, error),
+) error {
+	ctx := c.UserContext()
 	param := c.Params(constants.ID)
 	p.log.Infof("(Handlers.GetByID) id: {%s}", param)
 
@@ -302,7 +844,7 @@ func (p *clusterHandlers) GetClusterFolders(c *fiber.Ctx) error {
 		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
 	}
 
-	clusterQuery := clusterQueries.NewGetFolderID(folderID.Hex())
+	clusterQuery := clusterQueries.NewGetFolderID(folderID.Hex(), c.QueryBool(constants.Recursive))
 	err = p.val.DataValidation(clusterQuery)
 	if err != nil {
 		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
@@ -310,7 +852,7 @@ func (p *clusterHandlers) GetClusterFolders(c *fiber.Ctx) error {
 
 	pq := utils.NewPaginationQuery(0, 0)
 
-	response, err := p.ps.Queries.GetAllClusterFolder.Handle(ctx, clusterQuery, pq)
+	response, err := handle(ctx, clusterQuery, pq)
 	if err != nil {
 		p.log.Errorf("(Create.Handle) Error fetching clusters: {%v}", err)
 		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
@@ -320,3 +862,103 @@ func (p *clusterHandlers) GetClusterFolders(c *fiber.Ctx) error {
 
 	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Cluster found", response)
 }
+
+// GetClusterTags
+// @Tags clusters
+// @Summary Get cluster tags
+// @Description Get every tag with the number of clusters carrying it, most used first
+// @Accept json
+// @Produce json
+// @Success 200 {object} responses.GetAllClusterTagResponseDto
+// @Router /clusters/tags [get]
+func (p *clusterHandlers) GetClusterTags(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	response, err := p.ps.Queries.GetTags.Handle(ctx)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetTags) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Cluster tags found", response)
+}
+
+// RenameClusterTag
+// @Tags clusters
+// @Summary Rename cluster tag
+// @Description Rename a tag on every cluster the user can write. Renaming to a tag that exists merges the two
+// @Accept json
+// @Produce json
+// @Param tag path string true "tag"
+// @Param Cluster body dto.RenameClusterTagReqDto true "new name"
+// @Success 200 {object} responses.RenameClusterTagResponseDto
+// @Router /clusters/tags/{tag} [put]
+func (p *clusterHandlers) RenameClusterTag(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	tag, err := url.PathUnescape(c.Params(constants.Tag))
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, errors.Wrap(httpPkg.BadRequest, "invalid tag"), p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	var reqDto requests.RenameClusterTagReqDto
+	if err := c.BodyParser(&reqDto); err != nil {
+		p.log.Errorf("(Bind) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	command := clusterCommands.NewRenameClusterTagCommand(tag, reqDto.Name)
+	if err := p.val.DataValidation(command); err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	res, err := p.ps.Commands.RenameTag.Handle(ctx, command)
+	if err != nil {
+		p.log.Errorf("(RenameTag.Handle) tag: {%s}, err: {%v}", tag, err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Cluster tag renamed) tag: {%s}, name: {%s}, renamed: {%d}", res.Tag, res.Name, res.Renamed)
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Cluster tag renamed", res)
+}
+
+// ScheduleCluster
+// @Tags clusters
+// @Summary Schedule Cluster
+// @Description Set when a Cluster is shown to users and when it is hidden. A Cluster to be published later is hidden until then. A time left out is cleared
+// @Accept json
+// @Produce json
+// @Param id path string true "Cluster ID"
+// @Param Cluster body dto.ScheduleClusterReqDto true "schedule"
+// @Success 200 {string} id ""
+// @Router /clusters/{id}/schedule [put]
+func (p *clusterHandlers) ScheduleCluster(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	clusterID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.Schedule)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	var reqDto requests.ScheduleClusterReqDto
+	if err := c.BodyParser(&reqDto); err != nil {
+		p.log.Errorf("(Bind) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	command := clusterCommands.NewScheduleClusterCommand(clusterID.Hex(), reqDto.PublishAt, reqDto.UnpublishAt)
+	err = p.val.DataValidation(command)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	if err := p.ps.Commands.Schedule.Handle(ctx, command); err != nil {
+		p.log.Errorf("(Schedule.Handle) id: {%s}, err: {%v}", clusterID.Hex(), err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Cluster scheduled) id: {%s}, publish at: {%v}, unpublish at: {%v}", clusterID.Hex(), reqDto.PublishAt, reqDto.UnpublishAt)
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Cluster scheduled", clusterID.Hex())
+}
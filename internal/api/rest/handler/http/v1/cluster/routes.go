@@ -7,20 +7,46 @@ import (
 )
 
 func (p *clusterHandlers) MapRoutes() func(router fiber.Router) {
+	return p.mapRoutes(p.GetAllCluster, p.SearchCluster, p.GetClusterByID, p.GetClusterFolders)
+}
+
+// MapRoutesUser serves the same routes as MapRoutes, except that hidden clusters are left
+// out of the listings and a single cluster is read in one negotiated language
+func (p *clusterHandlers) MapRoutesUser() func(router fiber.Router) {
+	return p.mapRoutes(p.GetAllCluster4App, p.SearchCluster4App, p.GetClusterByID4App, p.GetClusterFolders4App)
+}
+
+func (p *clusterHandlers) mapRoutes(getAll, search, getByID, getFolders fiber.Handler) func(router fiber.Router) {
 	return func(router fiber.Router) {
 		clusterRepository := repository.NewClusterRepository(p.log, p.cfg, p.mongoClient)
 		folderRepository := repository.NewFolderRepository(p.log, p.cfg, p.mongoClient)
+		revisionRepository := repository.NewRevisionRepository(p.log, p.cfg, p.mongoClient)
+		taxonomyRepository := repository.NewTaxonomyRepository(p.log, p.cfg, p.mongoClient)
+		txManager := repository.NewTransactionManager(p.log, p.mongoClient)
 
-		p.ps = service.NewClusterService(p.cfg.Kafka, p.log, clusterRepository, folderRepository)
-		router.Get("/", p.GetAllCluster)
-		router.Get("/search", p.SearchCluster)
+		p.ps = service.NewClusterService(p.cfg.Kafka, p.log, p.val, clusterRepository, folderRepository, revisionRepository, taxonomyRepository, txManager)
+		router.Get("/", getAll)
+		router.Get("/search", search)
 		router.Get("/components", p.GetClusterComponents)
 		router.Get("/languages", p.GetClusterLanguages)
-		router.Get("/:id", p.GetClusterByID)
-		router.Get("/:id/folders", p.GetClusterFolders)
+		router.Get("/tags", p.GetClusterTags)
+		router.Get("/:id", getByID)
+		router.Get("/:id/folders", getFolders)
+		router.Get("/:id/revisions", p.GetClusterRevisions)
+		router.Get("/:id/revisions/diff", p.GetClusterRevisionDiff)
 
 		router.Post("/", p.CreateCluster)
+		router.Post("/move", p.MoveClusters)
+		router.Post("/:id/revisions/:revision/restore", p.RestoreClusterRevision)
 		router.Put("/", p.UpdateCluster)
+		router.Put("/reorder", p.ReorderClusters)
+		router.Put("/tags/:tag", p.RenameClusterTag)
+		router.Put("/:id/languages/:code", p.SetClusterLanguage)
+		router.Put("/:id/schedule", p.ScheduleCluster)
+		router.Put("/:id/terms/:term", p.AddClusterTerm)
+		router.Patch("/:id", p.PatchCluster)
 		router.Delete("/:id", p.DeleteCluster)
+		router.Delete("/:id/languages/:code", p.RemoveClusterLanguage)
+		router.Delete("/:id/terms/:term", p.RemoveClusterTerm)
 	}
 }
@@ -1,17 +1,25 @@
 package folder
 
 import (
+	"bytes"
+	"fmt"
 	"gallery-service/config"
 	"gallery-service/internal/api/rest/validator"
+	"gallery-service/internal/application/bundle"
 	folderCommands "gallery-service/internal/application/commands/v1/folder"
 	requests "gallery-service/internal/application/dto/requests/folder"
 	folderQueries "gallery-service/internal/application/queries/folder"
+	topicQueries "gallery-service/internal/application/queries/topic"
 	"gallery-service/internal/domain/service"
+	constants2 "gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/language"
+	"gallery-service/internal/pkg/tags"
 	"gallery-service/pkg/constants"
 	httpPkg "gallery-service/pkg/http"
 	"gallery-service/pkg/utils"
 	"gallery-service/pkg/zap"
 	"github.com/gofiber/fiber/v2"
+	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"net/http"
@@ -21,6 +29,7 @@ type folderHandlers struct {
 	log         zap.Logger
 	cfg         *config.Config
 	ps          *service.FolderService
+	ts          *service.TopicService
 	val         *validator.Wrapper
 	mongoClient *mongo.Client
 }
@@ -48,7 +57,7 @@ func NewFolderHandlers(
 // @Success 201 {string} id ""
 // @Router /folders [post]
 func (p *folderHandlers) CreateFolder(c *fiber.Ctx) error {
-	ctx := c.Context()
+	ctx := c.UserContext()
 	var reqDto requests.CreateFolderReqDto
 	if err := c.BodyParser(&reqDto); err != nil {
 		p.log.Errorf("(Bind) err: {%v}", err)
@@ -121,6 +130,286 @@ func (p *folderHandlers) UpdateFolder(c *fiber.Ctx) error {
 	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Folder updated", folderID.Hex())
 }
 
+// MoveFolder
+// @Tags folders
+// @Summary Move Folder
+// @Description Move a Folder under a new parent, or to the root when parent_id is empty
+// @Accept json
+// @Produce json
+// @Param id path string true "Folder ID"
+// @Param Folder body dto.MoveFolderReqDto true "move Folder"
+// @Success 200 {string} id ""
+// @Router /folders/{id}/move [put]
+func (p *folderHandlers) MoveFolder(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	folderID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.Move)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	var reqDto requests.MoveFolderReqDto
+	if err := c.BodyParser(&reqDto); err != nil {
+		p.log.Errorf("(Bind) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	command := folderCommands.NewMoveFolderCommand(folderID.Hex(), reqDto.ParentID)
+	err = p.val.DataValidation(command)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	err = p.ps.Commands.MoveFolder.Handle(ctx, command)
+	if err != nil {
+		p.log.Errorf("(Move.Handle) id: {%s}, err: {%v}", folderID.Hex(), err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Folder moved) id: {%s}", folderID.Hex())
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Folder moved", folderID.Hex())
+}
+
+// PatchFolder
+// @Tags folders
+// @Summary Patch Folder
+// @Description Change some fields of a Folder with a JSON merge patch (RFC 7396). The merged Folder is checked like an update and only the changed fields are saved
+// @Accept json
+// @Produce json
+// @Param id path string true "Folder ID"
+// @Param Folder body dto.UpdateFolderReqDto true "merge patch"
+// @Success 200 {string} id ""
+// @Router /folders/{id} [patch]
+func (p *folderHandlers) PatchFolder(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	folderID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.Patch)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	command := folderCommands.NewPatchFolderCommand(folderID.Hex(), c.Body())
+	err = p.val.DataValidation(command)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	err = p.ps.Commands.PatchFolder.Handle(ctx, command)
+	if err != nil {
+		p.log.Errorf("(Patch.Handle) id: {%s}, err: {%v}", folderID.Hex(), err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Folder patched) id: {%s}", folderID.Hex())
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Folder patched", folderID.Hex())
+}
+
+// ReorderFolders
+// @Tags folders
+// @Summary Reorder Folders
+// @Description Set the manual order of the children of a Folder, or of the root folders when parent_id is empty. Siblings left out keep their relative order after the listed ones
+// @Accept json
+// @Produce json
+// @Param Folder body dto.ReorderFoldersReqDto true "reorder Folders"
+// @Success 200 {string} id ""
+// @Router /folders/reorder [put]
+func (p *folderHandlers) ReorderFolders(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var reqDto requests.ReorderFoldersReqDto
+	if err := c.BodyParser(&reqDto); err != nil {
+		p.log.Errorf("(Bind) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	command := folderCommands.NewReorderFoldersCommand(reqDto.ParentID, reqDto.IDs)
+	err := p.val.DataValidation(command)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	err = p.ps.Commands.ReorderFolders.Handle(ctx, command)
+	if err != nil {
+		p.log.Errorf("(Reorder.Handle) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Folders reordered) count: {%d}", len(command.IDs))
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Folders reordered", command.IDs)
+}
+
+// DuplicateFolder
+// @Tags folders
+// @Summary Duplicate Folder
+// @Description Deep-copy a Folder with its subfolders and clusters. parent_id keeps the source parent when omitted and means root when empty
+// @Accept json
+// @Produce json
+// @Param id path string true "Folder ID"
+// @Param Folder body dto.DuplicateFolderReqDto false "duplicate Folder"
+// @Success 201 {object} responses.DuplicateFolderResponseDto
+// @Router /folders/{id}/duplicate [post]
+func (p *folderHandlers) DuplicateFolder(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	folderID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.Duplicate)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	var reqDto requests.DuplicateFolderReqDto
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&reqDto); err != nil {
+			p.log.Errorf("(Bind) err: {%v}", err)
+			return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+		}
+	}
+
+	command := folderCommands.NewDuplicateFolderCommand(folderID.Hex(), reqDto.FolderName, reqDto.ParentID)
+	err = p.val.DataValidation(command)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	res, err := p.ps.Commands.DuplicateFolder.Handle(ctx, command)
+	if err != nil {
+		p.log.Errorf("(Duplicate.Handle) id: {%s}, err: {%v}", folderID.Hex(), err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Folder duplicated) id: {%s}, copy: {%s}", folderID.Hex(), res.FolderID)
+	return httpPkg.SuccessCtxResponse(c, http.StatusCreated, "Folder duplicated", res)
+}
+
+// GetFolderACL
+// @Tags folders
+// @Summary Get Folder access
+// @Description Get the grants on a Folder, the grants inherited from its ancestors and the permission of the caller
+// @Accept json
+// @Produce json
+// @Param id path string true "Folder ID"
+// @Success 200 {object} responses.GetFolderACLResponseDto
+// @Router /folders/{id}/acl [get]
+func (p *folderHandlers) GetFolderACL(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	folderID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetACL)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	folderQuery := folderQueries.NewGetFolderByIDQuery(folderID.Hex())
+	err = p.val.DataValidation(folderQuery)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	acl, err := p.ps.Queries.GetFolderACL.Handle(ctx, folderQuery)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetACL)(Handle) id: {%s}, err: {%v}", folderID.String(), err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Folder access found", acl)
+}
+
+// GrantFolderAccess
+// @Tags folders
+// @Summary Grant Folder access
+// @Description Grant read, write or manage on a Folder and everything below it to a user_id or a role. Replaces an earlier grant to the same user or role
+// @Accept json
+// @Produce json
+// @Param id path string true "Folder ID"
+// @Param Folder body dto.GrantFolderAccessReqDto true "grant"
+// @Success 200 {string} id ""
+// @Router /folders/{id}/acl [post]
+func (p *folderHandlers) GrantFolderAccess(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	folderID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.Grant)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	var reqDto requests.GrantFolderAccessReqDto
+	if err := c.BodyParser(&reqDto); err != nil {
+		p.log.Errorf("(Bind) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	command := folderCommands.NewGrantFolderAccessCommand(
+		folderID.Hex(),
+		reqDto.UserID,
+		reqDto.Role,
+		constants2.FolderPermission(reqDto.Permission),
+	)
+	err = p.val.DataValidation(command)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	err = p.ps.Commands.GrantAccess.Handle(ctx, command)
+	if err != nil {
+		p.log.Errorf("(Grant.Handle) id: {%s}, err: {%v}", folderID.Hex(), err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Folder access granted) id: {%s}, permission: {%s}", folderID.Hex(), command.Permission)
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Folder access granted", folderID.Hex())
+}
+
+// RevokeFolderAccess
+// @Tags folders
+// @Summary Revoke Folder access
+// @Description Remove the grant of a user_id or a role from a Folder
+// @Accept json
+// @Produce json
+// @Param id path string true "Folder ID"
+// @Param user_id query string false "user id"
+// @Param role query string false "role name"
+// @Success 200 {string} id ""
+// @Router /folders/{id}/acl [delete]
+func (p *folderHandlers) RevokeFolderAccess(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	folderID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.Revoke)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	var reqDto requests.RevokeFolderAccessReqDto
+	if err := c.QueryParser(&reqDto); err != nil {
+		p.log.Errorf("(Bind) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	command := folderCommands.NewRevokeFolderAccessCommand(folderID.Hex(), reqDto.UserID, reqDto.Role)
+	err = p.val.DataValidation(command)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	err = p.ps.Commands.RevokeAccess.Handle(ctx, command)
+	if err != nil {
+		p.log.Errorf("(Revoke.Handle) id: {%s}, err: {%v}", folderID.Hex(), err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Folder access revoked) id: {%s}", folderID.Hex())
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Folder access revoked", folderID.Hex())
+}
+
 // GetAllFolder
 // @Tags folders
 // @Summary Get all folders
@@ -130,7 +419,7 @@ func (p *folderHandlers) UpdateFolder(c *fiber.Ctx) error {
 // @Success 200 {object} responses.GetAllFolderResponseDto
 // @Router /folders/ [get]
 func (p *folderHandlers) GetAllFolder(c *fiber.Ctx) error {
-	ctx := c.Context()
+	ctx := c.UserContext()
 
 	pq := utils.NewPaginationQuery(0, 0)
 
@@ -155,7 +444,7 @@ func (p *folderHandlers) GetAllFolder(c *fiber.Ctx) error {
 // @Success 200 {object} dto.FolderResponseDto
 // @Router /folders/{id} [get]
 func (p *folderHandlers) GetFolderByID(c *fiber.Ctx) error {
-	ctx := c.Context()
+	ctx := c.UserContext()
 	param := c.Params(constants.ID)
 	p.log.Infof("(Handlers.GetByID) id: {%s}", param)
 
@@ -182,6 +471,40 @@ func (p *folderHandlers) GetFolderByID(c *fiber.Ctx) error {
 	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Folder found", folder)
 }
 
+// GetFolderPath
+// @Tags folders
+// @Summary Get Folder path
+// @Description Get the breadcrumb of a Folder, from the root folder down to the Folder itself
+// @Accept json
+// @Produce json
+// @Param id path string true "Folder ID"
+// @Success 200 {object} responses.GetFolderPathResponseDto
+// @Router /folders/{id}/path [get]
+func (p *folderHandlers) GetFolderPath(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	folderID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetPath)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	folderQuery := folderQueries.NewGetFolderByIDQuery(folderID.Hex())
+	err = p.val.DataValidation(folderQuery)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	path, err := p.ps.Queries.GetFolderPath.Handle(ctx, folderQuery)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetPath)(Handle) id: {%s}, err: {%v}", folderID.String(), err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Folder path found", path)
+}
+
 // SearchFolder
 // @Tags folders
 // @Summary Search folders
@@ -194,7 +517,7 @@ func (p *folderHandlers) GetFolderByID(c *fiber.Ctx) error {
 // @Success 200 {object} dto.FolderSearchResponseDto
 // @Router /folders/search [get]
 func (p *folderHandlers) SearchFolder(c *fiber.Ctx) error {
-	ctx := c.Context()
+	ctx := c.UserContext()
 	pq := utils.NewPaginationFromQueryParams(c.Query(constants.Size), c.Query(constants.Page))
 
 	var reqDto requests.SearchFolderFilterReqDto
@@ -223,17 +546,59 @@ func (p *folderHandlers) SearchFolder(c *fiber.Ctx) error {
 	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Folder found", searchRes)
 }
 
+// GetFolderTree
+// @Tags folders
+// @Summary Get folder tree
+// @Description Get the folder hierarchy, or the subtree under root_id, as nested folders
+// @Accept json
+// @Produce json
+// @Param root_id query string false "root folder id"
+// @Param max_depth query int false "number of levels below the root, 0 for all"
+// @Success 200 {object} responses.GetFolderTreeResponseDto
+// @Router /folders/tree [get]
+func (p *folderHandlers) GetFolderTree(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var reqDto requests.GetFolderTreeReqDto
+	if err := c.QueryParser(&reqDto); err != nil {
+		p.log.Errorf("(Bind) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+	err := p.val.DataValidation(reqDto)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	if reqDto.RootID != "" {
+		if _, err := primitive.ObjectIDFromHex(reqDto.RootID); err != nil {
+			p.log.Errorf("(Handlers.GetTree)(uuid.FromString) err: {%v}", err)
+			return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+		}
+	}
+
+	folderQuery := folderQueries.NewGetFolderTreeQuery(reqDto.RootID, reqDto.MaxDepth)
+
+	response, err := p.ps.Queries.GetFolderTree.Handle(ctx, folderQuery)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetTree)(Handle) query: {%v}, err: {%v}", reqDto, err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Folder tree found", response)
+}
+
 // DeleteFolder
 // @Tags folders
 // @Summary Delete Folder
-// @Description Delete Folder by id
+// @Description Move Folder to the trash by id. strategy is one of reject (default), cascade or reparent
 // @Accept json
 // @Produce json
 // @Param id path string true "Folder ID"
+// @Param strategy query string false "reject, cascade or reparent"
 // @Success 200 {object} dto.FolderResponseDto
-// @Router /folders/{id} [post]
+// @Router /folders/{id} [delete]
 func (p *folderHandlers) DeleteFolder(c *fiber.Ctx) error {
-	ctx := c.Context()
+	ctx := c.UserContext()
 	param := c.Params(constants.ID)
 	p.log.Infof("(Handlers.Delete) id: {%s}", param)
 
@@ -243,7 +608,8 @@ func (p *folderHandlers) DeleteFolder(c *fiber.Ctx) error {
 		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
 	}
 
-	folderCommand := folderCommands.NewDeleteFolderCommand(folderID.Hex())
+	strategy := constants2.FolderDeleteStrategy(c.Query(constants.Strategy))
+	folderCommand := folderCommands.NewDeleteFolderCommand(folderID.Hex(), strategy)
 	err = p.val.DataValidation(folderCommand)
 	if err != nil {
 		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
@@ -259,3 +625,194 @@ func (p *folderHandlers) DeleteFolder(c *fiber.Ctx) error {
 
 	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Folder deleted", folderID)
 }
+
+// ExportFolder
+// @Tags folders
+// @Summary Export Folder
+// @Description Download a Folder with its subfolders, clusters and the given topics as a versioned bundle with a manifest, as one JSON document or as a ZIP archive
+// @Produce json
+// @Produce application/zip
+// @Param id path string true "Folder ID"
+// @Param topics query string false "comma separated topic ids to bundle along"
+// @Param format query string false "json (default) or zip"
+// @Success 200 {file} file ""
+// @Router /folders/{id}/export [get]
+func (p *folderHandlers) ExportFolder(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	folderID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.Export)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	var reqDto requests.ExportFolderReqDto
+	if err := c.QueryParser(&reqDto); err != nil {
+		p.log.Errorf("(Bind) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+	if err := p.val.DataValidation(reqDto); err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	query := folderQueries.NewExportFolderQuery(folderID.Hex(), tags.Split(reqDto.Topics))
+
+	b, err := p.ps.Queries.ExportFolder.Handle(ctx, query)
+	if err != nil {
+		p.log.Errorf("(Handlers.Export)(Handle) id: {%s}, err: {%v}", folderID.Hex(), err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	encoding := constants2.BundleEncoding(reqDto.Format)
+	if encoding == "" {
+		encoding = constants2.BundleEncodingJSON
+	}
+
+	var buf bytes.Buffer
+	if err := bundle.Encode(&buf, b, encoding); err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	contentType := fiber.MIMEApplicationJSON
+	if encoding == constants2.BundleEncodingZIP {
+		contentType = "application/zip"
+	}
+
+	c.Set(fiber.HeaderContentType, contentType)
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="folder-%s.%s"`, folderID.Hex(), encoding))
+	return c.Status(http.StatusOK).Send(buf.Bytes())
+}
+
+// ImportFolder
+// @Tags folders
+// @Summary Import Folder
+// @Description Import a bundle written by the export, JSON or ZIP, as the request body. Every entry gets a new id, folders merge into a folder of the same name, and clusters and topics whose name is taken are skipped or overwritten. dry_run reports what would change without writing
+// @Accept json
+// @Accept application/zip
+// @Produce json
+// @Param parent_id query string false "folder to import under, root when empty"
+// @Param on_conflict query string false "skip (default) or overwrite"
+// @Param dry_run query bool false "report without writing"
+// @Success 200 {object} responses.ImportFolderResponseDto
+// @Router /folders/import [post]
+func (p *folderHandlers) ImportFolder(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var reqDto requests.ImportFolderReqDto
+	if err := c.QueryParser(&reqDto); err != nil {
+		p.log.Errorf("(Bind) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+	if err := p.val.DataValidation(reqDto); err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	if len(c.Body()) == 0 {
+		return httpPkg.ErrorCtxResponse(c, errors.Wrap(httpPkg.BadRequest, "bundle required"), p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	b, err := bundle.Decode(c.Body())
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	command := folderCommands.NewImportFolderCommand(b, reqDto.ParentID, constants2.BundleConflict(reqDto.OnConflict), reqDto.DryRun)
+	if err := p.val.DataValidation(command); err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	res, err := p.ps.Commands.ImportFolder.Handle(ctx, command)
+	if err != nil {
+		p.log.Errorf("(Import.Handle) root: {%s}, err: {%v}", b.Manifest.RootFolderID, err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	if reqDto.DryRun {
+		return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Folder import checked", res)
+	}
+
+	p.log.Infof("(Folder imported) root: {%s}, folder: {%s}", b.Manifest.RootFolderID, res.FolderID)
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Folder imported", res)
+}
+
+// GetFolderTopics
+// @Tags folders
+// @Summary Get topics of a folder
+// @Description Get a page of the topics filed in a folder, including its subfolders when recursive is true
+// @Accept json
+// @Produce json
+// @Param id path string true "Folder ID"
+// @Param recursive query bool false "include topics of subfolders"
+// @Param page query string false "page number"
+// @Param size query string false "number of elements"
+// @Success 200 {object} responses.GetAllTopicResponseDto
+// @Router /folders/{id}/topics [get]
+func (p *folderHandlers) GetFolderTopics(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	topicQuery, pq, err := p.folderTopicsQuery(c, nil)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	response, err := p.ts.Queries.GetAllTopicFolder.Handle(ctx, topicQuery, pq)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetFolderTopics) id: {%s}, err: {%v}", topicQuery.ID, err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Folder topics found", response)
+}
+
+// GetFolderTopics4App
+// @Tags folders
+// @Summary Get published topics of a folder
+// @Description Get a page of the published topics filed in a folder, each in the first language of lang or Accept-Language it has
+// @Accept json
+// @Produce json
+// @Param id path string true "Folder ID"
+// @Param recursive query bool false "include topics of subfolders"
+// @Param lang query string false "language"
+// @Param page query string false "page number"
+// @Param size query string false "number of elements"
+// @Success 200 {object} responses.GetPublishedTopicsResponseDto
+// @Router /user/gallery/folders/{id}/topics [get]
+func (p *folderHandlers) GetFolderTopics4App(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	languages, err := language.Chain(c.Query(constants.Lang), c.Get(fiber.HeaderAcceptLanguage), p.cfg.Language.Fallback)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	topicQuery, pq, err := p.folderTopicsQuery(c, languages)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	response, err := p.ts.Queries.GetAllTopicFolder.Handle4App(ctx, topicQuery, pq)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetFolderTopics4App) id: {%s}, err: {%v}", topicQuery.ID, err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Folder topics found", response)
+}
+
+// folderTopicsQuery reads the listing of the topics of a folder from the path and the
+// query string
+func (p *folderHandlers) folderTopicsQuery(c *fiber.Ctx, languages []constants2.Language) (*topicQueries.GetFolderID, *utils.Pagination, error) {
+	folderID, err := primitive.ObjectIDFromHex(c.Params(constants.ID))
+	if err != nil {
+		p.log.Errorf("(Handlers.GetFolderTopics)(uuid.FromString) err: {%v}", err)
+		return nil, nil, err
+	}
+
+	topicQuery := topicQueries.NewGetFolderID(folderID.Hex(), c.QueryBool(constants.Recursive), languages)
+	if err := p.val.DataValidation(topicQuery); err != nil {
+		return nil, nil, err
+	}
+
+	return topicQuery, utils.NewPaginationFromQueryParams(c.Query(constants.Size), c.Query(constants.Page)), nil
+}
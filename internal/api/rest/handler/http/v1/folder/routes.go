@@ -8,16 +8,44 @@ import (
 )
 
 func (p *folderHandlers) MapRoutes() func(router fiber.Router) {
+	return p.mapRoutes(p.GetFolderTopics)
+}
+
+// MapRoutesUser serves the same routes as MapRoutes, except that only the published
+// topics of a folder are listed, each in one negotiated language
+func (p *folderHandlers) MapRoutesUser() func(router fiber.Router) {
+	return p.mapRoutes(p.GetFolderTopics4App)
+}
+
+func (p *folderHandlers) mapRoutes(getTopics fiber.Handler) func(router fiber.Router) {
 	return func(router fiber.Router) {
 		folderRepository := repository.NewFolderRepository(p.log, p.cfg, p.mongoClient)
+		clusterRepository := repository.NewClusterRepository(p.log, p.cfg, p.mongoClient)
+		topicRepository := repository.NewTopicRepository(p.log, p.cfg, p.mongoClient)
+		revisionRepository := repository.NewRevisionRepository(p.log, p.cfg, p.mongoClient)
+		taxonomyRepository := repository.NewTaxonomyRepository(p.log, p.cfg, p.mongoClient)
+		txManager := repository.NewTransactionManager(p.log, p.mongoClient)
 
-		p.ps = service.NewFolderService(p.cfg.Kafka, p.log, folderRepository)
+		p.ps = service.NewFolderService(p.cfg.Kafka, p.log, p.val, folderRepository, clusterRepository, topicRepository, taxonomyRepository, txManager)
+		p.ts = service.NewTopicService(p.cfg.Kafka, p.log, p.val, topicRepository, folderRepository, revisionRepository, taxonomyRepository, txManager)
 		router.Get("/", p.GetAllFolder)
 		router.Get("/search", p.SearchFolder)
+		router.Get("/tree", p.GetFolderTree)
 		router.Get("/:id", p.GetFolderByID)
+		router.Get("/:id/path", p.GetFolderPath)
+		router.Get("/:id/acl", p.GetFolderACL)
+		router.Get("/:id/topics", getTopics)
+		router.Get("/:id/export", p.ExportFolder)
 
 		router.Post("", p.CreateFolder)
+		router.Post("/import", p.ImportFolder)
+		router.Post("/:id/duplicate", p.DuplicateFolder)
+		router.Post("/:id/acl", p.GrantFolderAccess)
 		router.Put("/", p.UpdateFolder)
+		router.Put("/reorder", p.ReorderFolders)
+		router.Patch("/:id", p.PatchFolder)
+		router.Put("/:id/move", p.MoveFolder)
 		router.Delete("/:id", p.DeleteFolder)
+		router.Delete("/:id/acl", p.RevokeFolderAccess)
 	}
 }
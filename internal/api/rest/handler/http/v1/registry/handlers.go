@@ -0,0 +1,294 @@
+package registry
+
+import (
+	"gallery-service/config"
+	"gallery-service/internal/api/rest/validator"
+	registryCommands "gallery-service/internal/application/commands/v1/registry"
+	requests "gallery-service/internal/application/dto/requests/registry"
+	"gallery-service/internal/domain/service"
+	constants2 "gallery-service/internal/pkg/constants"
+	"gallery-service/pkg/constants"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type registryHandlers struct {
+	log         zap.Logger
+	cfg         *config.Config
+	ps          *service.RegistryService
+	val         *validator.Wrapper
+	mongoClient *mongo.Client
+}
+
+func NewRegistryHandlers(
+	log zap.Logger,
+	cfg *config.Config,
+	mongoClient *mongo.Client,
+) *registryHandlers {
+	return &registryHandlers{
+		log:         log,
+		cfg:         cfg,
+		val:         validator.NewValidator(log, cfg),
+		mongoClient: mongoClient,
+	}
+}
+
+// GetAllLanguages
+// @Tags registry
+// @Summary Get all languages
+// @Description Get every language content can be written in, disabled ones included, in display order
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.GetAllLanguageResponseDto
+// @Router /registry/languages [get]
+func (p *registryHandlers) GetAllLanguages(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	res, err := p.ps.Queries.GetAllLanguages.Handle(ctx)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Languages found", res)
+}
+
+// CreateLanguage
+// @Tags registry
+// @Summary Create language
+// @Description Add a language content can be written in. name is what the content is stored under and cannot be changed later
+// @Param Language body dto.CreateLanguageReqDto true "create language"
+// @Accept json
+// @Produce json
+// @Success 201 {string} id ""
+// @Router /registry/languages [post]
+func (p *registryHandlers) CreateLanguage(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	var reqDto requests.CreateLanguageReqDto
+	if err := c.BodyParser(&reqDto); err != nil {
+		p.log.Errorf("(Bind) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+	err := p.val.DataValidation(reqDto)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	command := registryCommands.NewCreateLanguageCommand(
+		reqDto.Code,
+		reqDto.Name,
+		reqDto.DisplayNames,
+		reqDto.Position,
+		reqDto.Enabled == nil || *reqDto.Enabled,
+	)
+
+	id, err := p.ps.Commands.CreateLanguage.Handle(ctx, command)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Language created) code: {%s}", reqDto.Code)
+	return httpPkg.SuccessCtxResponse(c, http.StatusCreated, "Language created", *id)
+}
+
+// UpdateLanguage
+// @Tags registry
+// @Summary Update language
+// @Description Change the display names, display order or enabled flag of a language. Only the fields sent change
+// @Accept json
+// @Produce json
+// @Param code path string true "language code"
+// @Param Language body dto.UpdateLanguageReqDto true "update language"
+// @Success 200 {string} code ""
+// @Router /registry/languages/{code} [put]
+func (p *registryHandlers) UpdateLanguage(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	code := c.Params(constants.Code)
+
+	var reqDto requests.UpdateLanguageReqDto
+	if err := c.BodyParser(&reqDto); err != nil {
+		p.log.Errorf("(Bind) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+	err := p.val.DataValidation(reqDto)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	command := registryCommands.NewUpdateLanguageCommand(code, reqDto.DisplayNames, reqDto.Position, reqDto.Enabled)
+
+	err = p.ps.Commands.UpdateLanguage.Handle(ctx, command)
+	if err != nil {
+		p.log.Errorf("(UpdateLanguage.Handle) code: {%s}, err: {%v}", code, err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Language updated) code: {%s}", code)
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Language updated", code)
+}
+
+// DeleteLanguage
+// @Tags registry
+// @Summary Delete language
+// @Description Delete a language no cluster or topic is written in. A language in use can be disabled instead
+// @Accept json
+// @Produce json
+// @Param code path string true "language code"
+// @Success 200 {string} code ""
+// @Router /registry/languages/{code} [delete]
+func (p *registryHandlers) DeleteLanguage(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	code := c.Params(constants.Code)
+
+	err := p.ps.Commands.DeleteLanguage.Handle(ctx, registryCommands.NewDeleteLanguageCommand(code))
+	if err != nil {
+		p.log.Errorf("(DeleteLanguage.Handle) code: {%s}, err: {%v}", code, err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Language deleted) code: {%s}", code)
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Language deleted", code)
+}
+
+// GetAllComponents
+// @Tags registry
+// @Summary Get all components
+// @Description Get every component topic languages can be shown with, disabled ones included, in display order, with the media each needs
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.GetAllComponentResponseDto
+// @Router /registry/components [get]
+func (p *registryHandlers) GetAllComponents(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	res, err := p.ps.Queries.GetAllComponents.Handle(ctx)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Components found", res)
+}
+
+// CreateComponent
+// @Tags registry
+// @Summary Create component
+// @Description Add a component topic languages can be shown with, and the least number of images, videos and audios it needs
+// @Param Component body dto.CreateComponentReqDto true "create component"
+// @Accept json
+// @Produce json
+// @Success 201 {string} id ""
+// @Router /registry/components [post]
+func (p *registryHandlers) CreateComponent(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	var reqDto requests.CreateComponentReqDto
+	if err := c.BodyParser(&reqDto); err != nil {
+		p.log.Errorf("(Bind) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+	err := p.val.DataValidation(reqDto)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	var media constants2.MediaRequirement
+	if requirement := mediaRequirement(reqDto.Media); requirement != nil {
+		media = *requirement
+	}
+
+	command := registryCommands.NewCreateComponentCommand(
+		reqDto.Key,
+		reqDto.Name,
+		reqDto.DisplayNames,
+		media,
+		reqDto.Position,
+		reqDto.Enabled == nil || *reqDto.Enabled,
+	)
+
+	id, err := p.ps.Commands.CreateComponent.Handle(ctx, command)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Component created) key: {%s}", reqDto.Key)
+	return httpPkg.SuccessCtxResponse(c, http.StatusCreated, "Component created", *id)
+}
+
+// UpdateComponent
+// @Tags registry
+// @Summary Update component
+// @Description Change the display names, media requirements, display order or enabled flag of a component. Only the fields sent change
+// @Accept json
+// @Produce json
+// @Param key path string true "component key"
+// @Param Component body dto.UpdateComponentReqDto true "update component"
+// @Success 200 {string} key ""
+// @Router /registry/components/{key} [put]
+func (p *registryHandlers) UpdateComponent(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	key := c.Params(constants.Key)
+
+	var reqDto requests.UpdateComponentReqDto
+	if err := c.BodyParser(&reqDto); err != nil {
+		p.log.Errorf("(Bind) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+	err := p.val.DataValidation(reqDto)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	command := registryCommands.NewUpdateComponentCommand(
+		key,
+		reqDto.DisplayNames,
+		mediaRequirement(reqDto.Media),
+		reqDto.Position,
+		reqDto.Enabled,
+	)
+
+	err = p.ps.Commands.UpdateComponent.Handle(ctx, command)
+	if err != nil {
+		p.log.Errorf("(UpdateComponent.Handle) key: {%s}, err: {%v}", key, err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Component updated) key: {%s}", key)
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Component updated", key)
+}
+
+// DeleteComponent
+// @Tags registry
+// @Summary Delete component
+// @Description Delete a component no topic is shown with. A component in use can be disabled instead
+// @Accept json
+// @Produce json
+// @Param key path string true "component key"
+// @Success 200 {string} key ""
+// @Router /registry/components/{key} [delete]
+func (p *registryHandlers) DeleteComponent(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	key := c.Params(constants.Key)
+
+	err := p.ps.Commands.DeleteComponent.Handle(ctx, registryCommands.NewDeleteComponentCommand(key))
+	if err != nil {
+		p.log.Errorf("(DeleteComponent.Handle) key: {%s}, err: {%v}", key, err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Component deleted) key: {%s}", key)
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Component deleted", key)
+}
+
+func mediaRequirement(media *requests.ComponentMediaReqDto) *constants2.MediaRequirement {
+	if media == nil {
+		return nil
+	}
+
+	return &constants2.MediaRequirement{
+		MinImages: media.MinImages,
+		MinVideos: media.MinVideos,
+		MinAudios: media.MinAudios,
+	}
+}
@@ -0,0 +1,32 @@
+package registry
+
+import (
+	"gallery-service/internal/domain/service"
+	"gallery-service/internal/infrastructure/database/mongo/repository"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func (p *registryHandlers) MapRoutesAdmin() func(router fiber.Router) {
+	return func(router fiber.Router) {
+		p.initService()
+
+		router.Get("/languages", p.GetAllLanguages)
+		router.Post("/languages", p.CreateLanguage)
+		router.Put("/languages/:code", p.UpdateLanguage)
+		router.Delete("/languages/:code", p.DeleteLanguage)
+
+		router.Get("/components", p.GetAllComponents)
+		router.Post("/components", p.CreateComponent)
+		router.Put("/components/:key", p.UpdateComponent)
+		router.Delete("/components/:key", p.DeleteComponent)
+	}
+}
+
+func (p *registryHandlers) initService() {
+	registryRepository := repository.NewRegistryRepository(p.log, p.cfg, p.mongoClient)
+	clusterRepository := repository.NewClusterRepository(p.log, p.cfg, p.mongoClient)
+	topicRepository := repository.NewTopicRepository(p.log, p.cfg, p.mongoClient)
+
+	p.ps = service.NewRegistryService(p.log, registryRepository, clusterRepository, topicRepository)
+}
@@ -0,0 +1,284 @@
+package taxonomy
+
+import (
+	"gallery-service/config"
+	"gallery-service/internal/api/rest/validator"
+	taxonomyCommands "gallery-service/internal/application/commands/v1/taxonomy"
+	requests "gallery-service/internal/application/dto/requests/taxonomy"
+	taxonomyQueries "gallery-service/internal/application/queries/taxonomy"
+	"gallery-service/internal/domain/service"
+	constants2 "gallery-service/internal/pkg/constants"
+	"gallery-service/pkg/constants"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type taxonomyHandlers struct {
+	log         zap.Logger
+	cfg         *config.Config
+	ps          *service.TaxonomyService
+	val         *validator.Wrapper
+	mongoClient *mongo.Client
+}
+
+func NewTaxonomyHandlers(
+	log zap.Logger,
+	cfg *config.Config,
+	mongoClient *mongo.Client,
+) *taxonomyHandlers {
+	return &taxonomyHandlers{
+		log:         log,
+		cfg:         cfg,
+		val:         validator.NewValidator(log, cfg),
+		mongoClient: mongoClient,
+	}
+}
+
+// CreateTaxonomyTerm
+// @Tags taxonomy
+// @Summary Create taxonomy term
+// @Description Add a taxonomy term under parent_id, or a root term of kind. kind is one of subject, skill or age_range
+// @Param Term body dto.CreateTaxonomyTermReqDto true "create taxonomy term"
+// @Accept json
+// @Produce json
+// @Success 201 {string} id ""
+// @Router /taxonomy [post]
+func (p *taxonomyHandlers) CreateTaxonomyTerm(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	var reqDto requests.CreateTaxonomyTermReqDto
+	if err := c.BodyParser(&reqDto); err != nil {
+		p.log.Errorf("(Bind) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+	err := p.val.DataValidation(reqDto)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	command := taxonomyCommands.NewCreateTaxonomyTermCommand(reqDto.Name, reqDto.Kind, reqDto.ParentID)
+
+	termID, err := p.ps.Commands.CreateTerm.Handle(ctx, command)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	return httpPkg.SuccessCtxResponse(c, http.StatusCreated, "Taxonomy term created", *termID)
+}
+
+// UpdateTaxonomyTerm
+// @Tags taxonomy
+// @Summary Rename taxonomy term
+// @Description Rename a taxonomy term
+// @Accept json
+// @Produce json
+// @Param id path string true "taxonomy term ID"
+// @Param Term body dto.UpdateTaxonomyTermReqDto true "update taxonomy term"
+// @Success 200 {string} id ""
+// @Router /taxonomy/{id} [put]
+func (p *taxonomyHandlers) UpdateTaxonomyTerm(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	termID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.Update)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	var reqDto requests.UpdateTaxonomyTermReqDto
+	if err := c.BodyParser(&reqDto); err != nil {
+		p.log.Errorf("(Bind) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+	err = p.val.DataValidation(reqDto)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	command := taxonomyCommands.NewUpdateTaxonomyTermCommand(termID.Hex(), reqDto.Name)
+
+	err = p.ps.Commands.UpdateTerm.Handle(ctx, command)
+	if err != nil {
+		p.log.Errorf("(Update.Handle) id: {%s}, err: {%v}", termID.Hex(), err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Taxonomy term updated) id: {%s}", termID.Hex())
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Taxonomy term updated", termID.Hex())
+}
+
+// MoveTaxonomyTerm
+// @Tags taxonomy
+// @Summary Move taxonomy term
+// @Description Move a taxonomy term and the terms below it under a new parent of the same kind, or to the root when parent_id is empty
+// @Accept json
+// @Produce json
+// @Param id path string true "taxonomy term ID"
+// @Param Term body dto.MoveTaxonomyTermReqDto true "move taxonomy term"
+// @Success 200 {string} id ""
+// @Router /taxonomy/{id}/move [put]
+func (p *taxonomyHandlers) MoveTaxonomyTerm(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	termID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.Move)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	var reqDto requests.MoveTaxonomyTermReqDto
+	if err := c.BodyParser(&reqDto); err != nil {
+		p.log.Errorf("(Bind) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	command := taxonomyCommands.NewMoveTaxonomyTermCommand(termID.Hex(), reqDto.ParentID)
+	err = p.val.DataValidation(command)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	err = p.ps.Commands.MoveTerm.Handle(ctx, command)
+	if err != nil {
+		p.log.Errorf("(Move.Handle) id: {%s}, err: {%v}", termID.Hex(), err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Taxonomy term moved) id: {%s}", termID.Hex())
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Taxonomy term moved", termID.Hex())
+}
+
+// DeleteTaxonomyTerm
+// @Tags taxonomy
+// @Summary Delete taxonomy term
+// @Description Permanently delete a taxonomy term with the terms below it, and remove them from the clusters and topics classified with them
+// @Accept json
+// @Produce json
+// @Param id path string true "taxonomy term ID"
+// @Success 200 {integer} deleted ""
+// @Router /taxonomy/{id} [delete]
+func (p *taxonomyHandlers) DeleteTaxonomyTerm(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+	p.log.Infof("(Handlers.Delete) id: {%s}", param)
+
+	termID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.Delete)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	command := taxonomyCommands.NewDeleteTaxonomyTermCommand(termID.Hex())
+	err = p.val.DataValidation(command)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	deleted, err := p.ps.Commands.DeleteTerm.Handle(ctx, command)
+	if err != nil {
+		p.log.Errorf("(Handlers.Delete)(Handle) id: {%s}, err: {%v}", termID.Hex(), err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Taxonomy term deleted", deleted)
+}
+
+// GetAllTaxonomyTerms
+// @Tags taxonomy
+// @Summary Get taxonomy terms
+// @Description List the taxonomy terms, parents before their children, optionally of one kind
+// @Accept json
+// @Produce json
+// @Param kind query string false "subject, skill or age_range"
+// @Success 200 {object} responses.GetAllTaxonomyTermResponseDto
+// @Router /taxonomy [get]
+func (p *taxonomyHandlers) GetAllTaxonomyTerms(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var reqDto requests.GetTaxonomyReqDto
+	if err := c.QueryParser(&reqDto); err != nil {
+		p.log.Errorf("(Bind) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+	if err := p.val.DataValidation(reqDto); err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	query := taxonomyQueries.NewGetTaxonomyQuery(constants2.TaxonomyKind(reqDto.Kind))
+
+	response, err := p.ps.Queries.GetAllTerms.Handle(ctx, query)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetAll)(Handle) query: {%v}, err: {%v}", reqDto, err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Taxonomy terms found", response)
+}
+
+// GetTaxonomyTree
+// @Tags taxonomy
+// @Summary Get taxonomy tree
+// @Description Get the taxonomy as nested terms, optionally the trees of one kind
+// @Accept json
+// @Produce json
+// @Param kind query string false "subject, skill or age_range"
+// @Success 200 {object} responses.GetTaxonomyTreeResponseDto
+// @Router /taxonomy/tree [get]
+func (p *taxonomyHandlers) GetTaxonomyTree(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var reqDto requests.GetTaxonomyReqDto
+	if err := c.QueryParser(&reqDto); err != nil {
+		p.log.Errorf("(Bind) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+	if err := p.val.DataValidation(reqDto); err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	query := taxonomyQueries.NewGetTaxonomyQuery(constants2.TaxonomyKind(reqDto.Kind))
+
+	response, err := p.ps.Queries.GetTree.Handle(ctx, query)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetTree)(Handle) query: {%v}, err: {%v}", reqDto, err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Taxonomy tree found", response)
+}
+
+// GetTaxonomyTermByID
+// @Tags taxonomy
+// @Summary Get taxonomy term
+// @Description Get a taxonomy term by id
+// @Accept json
+// @Produce json
+// @Param id path string true "taxonomy term ID"
+// @Success 200 {object} responses.GetTaxonomyTermResponseDto
+// @Router /taxonomy/{id} [get]
+func (p *taxonomyHandlers) GetTaxonomyTermByID(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	termID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetByID)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	query := taxonomyQueries.NewGetTaxonomyTermByIDQuery(termID.Hex())
+
+	response, err := p.ps.Queries.GetTermByID.Handle(ctx, query)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetByID)(Handle) id: {%s}, err: {%v}", termID.Hex(), err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Taxonomy term found", response)
+}
@@ -0,0 +1,42 @@
+package taxonomy
+
+import (
+	"gallery-service/internal/domain/service"
+	"gallery-service/internal/infrastructure/database/mongo/repository"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func (p *taxonomyHandlers) MapRoutesAdmin() func(router fiber.Router) {
+	return func(router fiber.Router) {
+		p.initService()
+		p.mapReadRoutes(router)
+
+		router.Post("", p.CreateTaxonomyTerm)
+		router.Put("/:id", p.UpdateTaxonomyTerm)
+		router.Put("/:id/move", p.MoveTaxonomyTerm)
+		router.Delete("/:id", p.DeleteTaxonomyTerm)
+	}
+}
+
+func (p *taxonomyHandlers) MapRoutesUser() func(router fiber.Router) {
+	return func(router fiber.Router) {
+		p.initService()
+		p.mapReadRoutes(router)
+	}
+}
+
+func (p *taxonomyHandlers) mapReadRoutes(router fiber.Router) {
+	router.Get("", p.GetAllTaxonomyTerms)
+	router.Get("/tree", p.GetTaxonomyTree)
+	router.Get("/:id", p.GetTaxonomyTermByID)
+}
+
+func (p *taxonomyHandlers) initService() {
+	taxonomyRepository := repository.NewTaxonomyRepository(p.log, p.cfg, p.mongoClient)
+	clusterRepository := repository.NewClusterRepository(p.log, p.cfg, p.mongoClient)
+	topicRepository := repository.NewTopicRepository(p.log, p.cfg, p.mongoClient)
+	txManager := repository.NewTransactionManager(p.log, p.mongoClient)
+
+	p.ps = service.NewTaxonomyService(p.log, taxonomyRepository, clusterRepository, topicRepository, txManager)
+}
@@ -4,11 +4,16 @@ import (
 	"gallery-service/config"
 	"gallery-service/internal/api/rest/validator"
 	topicCommands "gallery-service/internal/application/commands/v1/topic"
+	revisionRequests "gallery-service/internal/application/dto/requests/revision"
 	requests "gallery-service/internal/application/dto/requests/topic"
-	"gallery-service/internal/application/dto/responses"
+	"gallery-service/internal/application/mappers"
 	topicQueries "gallery-service/internal/application/queries/topic"
+	"gallery-service/internal/domain/models"
 	"gallery-service/internal/domain/service"
 	constants2 "gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/language"
+	"gallery-service/internal/pkg/registry"
+	"gallery-service/internal/pkg/tags"
 	"gallery-service/pkg/constants"
 	httpPkg "gallery-service/pkg/http"
 	"gallery-service/pkg/utils"
@@ -51,7 +56,7 @@ func NewTopicHandlers(
 // @Success 201 {string} id ""
 // @Router /topics [post]
 func (p *topicHandlers) CreateTopic(c *fiber.Ctx) error {
-	ctx := c.Context()
+	ctx := c.UserContext()
 	var reqDto requests.CreateTopicReqDto
 	if err := c.BodyParser(&reqDto); err != nil {
 		p.log.Errorf("(Bind) err: {%v}", err)
@@ -64,7 +69,7 @@ func (p *topicHandlers) CreateTopic(c *fiber.Ctx) error {
 
 	command := topicCommands.NewCreateTopicCommand(
 		reqDto.TopicName,
-		reqDto.IsPublished,
+		reqDto.FolderID,
 		reqDto.LanguageConfig,
 	)
 
@@ -108,7 +113,6 @@ func (p *topicHandlers) UpdateTopic(c *fiber.Ctx) error {
 	command := topicCommands.NewUpdateTopicCommand(
 		topicID.Hex(),
 		reqDto.FileName,
-		reqDto.IsPublished,
 		reqDto.LanguageConfig,
 	)
 	err = p.ps.Commands.UpdateTopic.Handle(ctx, command)
@@ -122,209 +126,1117 @@ func (p *topicHandlers) UpdateTopic(c *fiber.Ctx) error {
 	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic updated", topicID.Hex())
 }
 
-// GetAllTopic
-// @Tags topics
-// @Summary Get all Topics
-// @Description Get all Topics
+// PatchTopic
+// @Tags Topics
+// @Summary Patch Topic
+// @Description Change some fields of a Topic with a JSON merge patch (RFC 7396). The merged Topic is checked like an update and only the changed fields are saved
 // @Accept json
 // @Produce json
-// @Success 200 {object} responses.GetAllTopicResponseDto
-// @Router /topics/ [get]
-func (p *topicHandlers) GetAllTopic(c *fiber.Ctx) error {
-	ctx := c.Context()
+// @Param id path string true "Topic ID"
+// @Param Topic body dto.UpdateTopicReqDto true "merge patch"
+// @Success 200 {string} id ""
+// @Router /topics/{id} [patch]
+func (p *topicHandlers) PatchTopic(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
 
-	pq := utils.NewPaginationQuery(0, 0)
+	topicID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.Patch)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
 
-	response, err := p.ps.Queries.GetAllTopic.Handle(ctx, pq)
+	command := topicCommands.NewPatchTopicCommand(topicID.Hex(), c.Body())
+	err = p.val.DataValidation(command)
 	if err != nil {
-		p.log.Errorf("(Create.Handle) Error fetching topics: {%v}", err)
 		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
 	}
 
-	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic found", response)
+	err = p.ps.Commands.PatchTopic.Handle(ctx, command)
+	if err != nil {
+		p.log.Errorf("(Patch.Handle) id: {%s}, err: {%v}", topicID.Hex(), err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Topic patched) id: {%s}", topicID.Hex())
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic patched", topicID.Hex())
 }
 
-// GetTopicByID
+// SetTopicLanguage
 // @Tags Topics
-// @Summary Get Topic
-// @Description Get Topic by id
+// @Summary Set Topic language
+// @Description Add the entry of one language to a Topic, or replace the one it has. code is one of the Topic languages
 // @Accept json
 // @Produce json
 // @Param id path string true "Topic ID"
-// @Success 200 {object} dto.TopicResponseDto
-// @Router /topics/{id} [get]
-func (p *topicHandlers) GetTopicByID(c *fiber.Ctx) error {
-	ctx := c.Context()
+// @Param code path string true "language code"
+// @Param Topic body dto.SetTopicLanguageReqDto true "language entry"
+// @Success 200 {string} id ""
+// @Success 201 {string} id ""
+// @Router /topics/{id}/languages/{code} [put]
+func (p *topicHandlers) SetTopicLanguage(c *fiber.Ctx) error {
+	ctx := c.UserContext()
 	param := c.Params(constants.ID)
-	p.log.Infof("(Handlers.GetByID) id: {%s}", param)
 
 	topicID, err := primitive.ObjectIDFromHex(param)
 	if err != nil {
-		p.log.Errorf("(Handlers.GetByID)(uuid.FromString) err: {%v}", err)
+		p.log.Errorf("(Handlers.SetLanguage)(uuid.FromString) err: {%v}", err)
 		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
 	}
 
-	topicQuery := topicQueries.NewGetTopicByIDQuery(topicID.Hex())
-	err = p.val.DataValidation(topicQuery)
+	var reqDto requests.SetTopicLanguageReqDto
+	if err := c.BodyParser(&reqDto); err != nil {
+		p.log.Errorf("(Bind) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	command := topicCommands.NewSetTopicLanguageCommand(
+		topicID.Hex(),
+		c.Params(constants.Code),
+		reqDto.Component,
+		reqDto.Title,
+		reqDto.Note,
+		reqDto.Description,
+		reqDto.Images,
+		reqDto.Videos,
+		reqDto.Audios,
+	)
+	err = p.val.DataValidation(command)
 	if err != nil {
 		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
 	}
 
-	topic, err := p.ps.Queries.GetTopicByID.Handle(ctx, topicQuery)
+	added, err := p.ps.Commands.SetLanguage.Handle(ctx, command)
 	if err != nil {
-		p.log.Errorf("(Handlers.GetByID)(Handle) id: {%s}, err: {%v}", topicID.String(), err)
+		p.log.Errorf("(SetLanguage.Handle) id: {%s}, code: {%s}, err: {%v}", topicID.Hex(), command.Code, err)
 		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
 	}
 
-	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic found", topic)
+	p.log.Infof("(Topic language saved) id: {%s}, code: {%s}", topicID.Hex(), command.Code)
+	if added {
+		return httpPkg.SuccessCtxResponse(c, http.StatusCreated, "Topic language added", topicID.Hex())
+	}
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic language replaced", topicID.Hex())
 }
 
-// SearchTopic
+// RemoveTopicLanguage
 // @Tags Topics
-// @Summary Search Topics
-// @Description Full text search by title and description
+// @Summary Remove Topic language
+// @Description Remove the entry of one language from a Topic
 // @Accept json
 // @Produce json
-// @Param search queries string false "search text"
-// @Param page queries string false "page number"
-// @Param size queries string false "number of elements"
-// @Success 200 {object} dto.TopicSearchResponseDto
-// @Router /topics/search [get]
-func (p *topicHandlers) SearchTopic(c *fiber.Ctx) error {
-	ctx := c.Context()
-	pq := utils.NewPaginationFromQueryParams(c.Query(constants.Size), c.Query(constants.Page))
+// @Param id path string true "Topic ID"
+// @Param code path string true "language code"
+// @Success 200 {string} id ""
+// @Router /topics/{id}/languages/{code} [delete]
+func (p *topicHandlers) RemoveTopicLanguage(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
 
-	var reqDto requests.SearchTopicFilterReqDto
-	if err := c.QueryParser(&reqDto); err != nil {
-		p.log.Errorf("(Bind) err: {%v}", err)
+	topicID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.RemoveLanguage)(uuid.FromString) err: {%v}", err)
 		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
 	}
-	err := p.val.DataValidation(reqDto)
+
+	command := topicCommands.NewRemoveTopicLanguageCommand(topicID.Hex(), c.Params(constants.Code))
+	err = p.val.DataValidation(command)
 	if err != nil {
 		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
 	}
 
-	topicQuery := topicQueries.NewSearchTopicsQuery(
-		reqDto.Keyword,
-		pq,
-	)
-
-	searchRes, err := p.ps.Queries.SearchTopics.Handle(ctx, topicQuery)
+	err = p.ps.Commands.RemoveLanguage.Handle(ctx, command)
 	if err != nil {
-		p.log.Errorf("(Handlers.Search)(Handle) query: {%v}, err: {%v}", reqDto, err)
+		p.log.Errorf("(RemoveLanguage.Handle) id: {%s}, code: {%s}, err: {%v}", topicID.Hex(), command.Code, err)
 		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
 	}
 
-	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic found", searchRes)
+	p.log.Infof("(Topic language removed) id: {%s}, code: {%s}", topicID.Hex(), command.Code)
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic language removed", topicID.Hex())
 }
 
-// DeleteTopic
+// AddTopicMedia
 // @Tags Topics
-// @Summary Delete Topic
-// @Description Delete Topic by id
+// @Summary Add Topic media item
+// @Description Append an image, video or audio to one language of a Topic. kind is images, videos or audios and the body is an item of that kind. A primary item stops the others of its kind from being primary.
 // @Accept json
 // @Produce json
 // @Param id path string true "Topic ID"
-// @Success 200 {object} dto.TopicResponseDto
-// @Router /topics/{id} [post]
-func (p *topicHandlers) DeleteTopic(c *fiber.Ctx) error {
-	ctx := c.Context()
+// @Param code path string true "language code"
+// @Param kind path string true "images, videos or audios"
+// @Success 201 {string} id "item id"
+// @Router /topics/{id}/languages/{code}/{kind} [post]
+func (p *topicHandlers) AddTopicMedia(c *fiber.Ctx) error {
+	ctx := c.UserContext()
 	param := c.Params(constants.ID)
-	p.log.Infof("(Handlers.Delete) id: {%s}", param)
 
 	topicID, err := primitive.ObjectIDFromHex(param)
 	if err != nil {
-		p.log.Errorf("(Handlers.Delete)(uuid.FromString) err: {%v}", err)
+		p.log.Errorf("(Handlers.AddMedia)(uuid.FromString) err: {%v}", err)
 		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
 	}
 
-	topicCommand := topicCommands.NewDeleteTopicCommand(topicID.Hex())
-	err = p.val.DataValidation(topicCommand)
+	kind := constants2.TopicMediaKind(c.Params(constants.Kind))
+	image, video, audio, err := p.topicMediaItem(c, kind)
 	if err != nil {
 		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
 	}
 
-	err = p.ps.Commands.DeleteTopic.Handle(ctx, topicCommand)
+	command := topicCommands.NewAddTopicMediaCommand(topicID.Hex(), c.Params(constants.Code), kind, image, video, audio)
+	err = p.val.DataValidation(command)
 	if err != nil {
-		p.log.Errorf("(Handlers.Delete)(Handle) id: {%s}, err: {%v}", topicID.String(), err)
 		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
 	}
 
-	p.log.Infof("(Handlers.Delete) topicID: {%s}", topicID.String())
+	itemID, err := p.ps.Commands.AddMedia.Handle(ctx, command)
+	if err != nil {
+		p.log.Errorf("(AddMedia.Handle) id: {%s}, code: {%s}, kind: {%s}, err: {%v}", topicID.Hex(), command.Code, kind, err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
 
-	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic deleted", topicID)
+	p.log.Infof("(Topic media added) id: {%s}, code: {%s}, kind: {%s}, item: {%s}", topicID.Hex(), command.Code, kind, itemID)
+	return httpPkg.SuccessCtxResponse(c, http.StatusCreated, "Topic media added", itemID)
 }
 
-func (p *topicHandlers) GetTopicComponents(c *fiber.Ctx) error {
-	res := make([]responses.KeyValueResponseDto, 0, len(constants2.Components))
-	for k, v := range constants2.Components {
-		res = append(res, responses.KeyValueResponseDto{
-			Key:   k,
-			Value: v.String(),
-		})
+// UpdateTopicMedia
+// @Tags Topics
+// @Summary Update Topic media item
+// @Description Replace an image, video or audio of one language of a Topic, which keeps its id. The body is an item of that kind.
+// @Accept json
+// @Produce json
+// @Param id path string true "Topic ID"
+// @Param code path string true "language code"
+// @Param kind path string true "images, videos or audios"
+// @Param item path string true "item id"
+// @Success 200 {string} id "item id"
+// @Router /topics/{id}/languages/{code}/{kind}/{item} [put]
+func (p *topicHandlers) UpdateTopicMedia(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	topicID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.UpdateMedia)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	kind := constants2.TopicMediaKind(c.Params(constants.Kind))
+	image, video, audio, err := p.topicMediaItem(c, kind)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	command := topicCommands.NewUpdateTopicMediaCommand(topicID.Hex(), c.Params(constants.Code), kind, c.Params(constants.Item), image, video, audio)
+	err = p.val.DataValidation(command)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	if err := p.ps.Commands.UpdateMedia.Handle(ctx, command); err != nil {
+		p.log.Errorf("(UpdateMedia.Handle) id: {%s}, code: {%s}, kind: {%s}, item: {%s}, err: {%v}", topicID.Hex(), command.Code, kind, command.ItemID, err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
 	}
 
-	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic components found", res)
+	p.log.Infof("(Topic media updated) id: {%s}, code: {%s}, kind: {%s}, item: {%s}", topicID.Hex(), command.Code, kind, command.ItemID)
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic media updated", command.ItemID)
 }
 
-func (p *topicHandlers) GetTopicLanguages(c *fiber.Ctx) error {
-	res := make([]responses.KeyValueResponseDto, 0, len(constants2.GalleryLanguages))
-	for k, v := range constants2.GalleryLanguages {
-		res = append(res, responses.KeyValueResponseDto{
-			Key:   k,
-			Value: v.String(),
-		})
+// RemoveTopicMedia
+// @Tags Topics
+// @Summary Remove Topic media item
+// @Description Remove an image, video or audio from one language of a Topic
+// @Accept json
+// @Produce json
+// @Param id path string true "Topic ID"
+// @Param code path string true "language code"
+// @Param kind path string true "images, videos or audios"
+// @Param item path string true "item id"
+// @Success 200 {string} id "item id"
+// @Router /topics/{id}/languages/{code}/{kind}/{item} [delete]
+func (p *topicHandlers) RemoveTopicMedia(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	topicID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.RemoveMedia)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
 	}
 
-	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic languages found", res)
+	kind := constants2.TopicMediaKind(c.Params(constants.Kind))
+	command := topicCommands.NewRemoveTopicMediaCommand(topicID.Hex(), c.Params(constants.Code), kind, c.Params(constants.Item))
+	err = p.val.DataValidation(command)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	if err := p.ps.Commands.RemoveMedia.Handle(ctx, command); err != nil {
+		p.log.Errorf("(RemoveMedia.Handle) id: {%s}, code: {%s}, kind: {%s}, item: {%s}, err: {%v}", topicID.Hex(), command.Code, kind, command.ItemID, err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Topic media removed) id: {%s}, code: {%s}, kind: {%s}, item: {%s}", topicID.Hex(), command.Code, kind, command.ItemID)
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic media removed", command.ItemID)
 }
 
-func (p *topicHandlers) GetAllTopic4App(c *fiber.Ctx) error {
-	ctx := c.Context()
-	response, err := p.ps.Queries.GetAllTopic.Handle4App(ctx)
+// ReorderTopicMedia
+// @Tags Topics
+// @Summary Reorder Topic media
+// @Description Order the images, videos or audios of one language of a Topic. The listed items come first, the others follow in their current order.
+// @Accept json
+// @Produce json
+// @Param id path string true "Topic ID"
+// @Param code path string true "language code"
+// @Param kind path string true "images, videos or audios"
+// @Param Topic body dto.ReorderTopicMediaReqDto true "item ids"
+// @Success 200 {array} string
+// @Router /topics/{id}/languages/{code}/{kind}/reorder [put]
+func (p *topicHandlers) ReorderTopicMedia(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	topicID, err := primitive.ObjectIDFromHex(param)
 	if err != nil {
-		p.log.Errorf("(Create.Handle) Error fetching topics: {%v}", err)
+		p.log.Errorf("(Handlers.ReorderMedia)(uuid.FromString) err: {%v}", err)
 		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
 	}
 
-	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic found", response)
+	var reqDto requests.ReorderTopicMediaReqDto
+	if err := c.BodyParser(&reqDto); err != nil {
+		p.log.Errorf("(Bind) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	kind := constants2.TopicMediaKind(c.Params(constants.Kind))
+	command := topicCommands.NewReorderTopicMediaCommand(topicID.Hex(), c.Params(constants.Code), kind, reqDto.IDs)
+	err = p.val.DataValidation(command)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	if err := p.ps.Commands.ReorderMedia.Handle(ctx, command); err != nil {
+		p.log.Errorf("(ReorderMedia.Handle) id: {%s}, code: {%s}, kind: {%s}, err: {%v}", topicID.Hex(), command.Code, kind, err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Topic media reordered) id: {%s}, code: {%s}, kind: {%s}, count: {%d}", topicID.Hex(), command.Code, kind, len(command.ItemIDs))
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic media reordered", command.ItemIDs)
 }
 
-// gateway handlers
-func (p *topicHandlers) GetAllTopic4Gateway(c *fiber.Ctx) error {
-	ctx := c.Context()
-	pq := utils.NewPaginationQuery(0, 0)
-	response, err := p.ps.Queries.GetAllTopic.Handle4Gateway(ctx, pq)
+// topicMediaItem reads the body as a media item of kind. The items of the other kinds
+// are left empty.
+func (p *topicHandlers) topicMediaItem(c *fiber.Ctx, kind constants2.TopicMediaKind) (image models.TopicImageConfig, video models.TopicVideoConfig, audio models.TopicAudioConfig, err error) {
+	switch kind {
+	case constants2.TopicMediaImages:
+		err = c.BodyParser(&image)
+	case constants2.TopicMediaVideos:
+		err = c.BodyParser(&video)
+	case constants2.TopicMediaAudios:
+		err = c.BodyParser(&audio)
+	}
 	if err != nil {
-		p.log.Errorf("(Create.Handle) Error fetching topics: {%v}", err)
+		p.log.Errorf("(Bind) err: {%v}", err)
+	}
+
+	return image, video, audio, err
+}
+
+// AddTopicTerm
+// @Tags Topics
+// @Summary Add Topic taxonomy term
+// @Description Classify a Topic with a taxonomy term
+// @Accept json
+// @Produce json
+// @Param id path string true "Topic ID"
+// @Param term path string true "taxonomy term ID"
+// @Success 200 {string} id ""
+// @Success 201 {string} id ""
+// @Router /topics/{id}/terms/{term} [put]
+func (p *topicHandlers) AddTopicTerm(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	topicID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.AddTerm)(uuid.FromString) err: {%v}", err)
 		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
 	}
 
-	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic found", response)
+	command := topicCommands.NewAddTopicTermCommand(topicID.Hex(), c.Params(constants.Term))
+	err = p.val.DataValidation(command)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	added, err := p.ps.Commands.AddTerm.Handle(ctx, command)
+	if err != nil {
+		p.log.Errorf("(AddTerm.Handle) id: {%s}, term: {%s}, err: {%v}", topicID.Hex(), command.TermID, err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Topic term added) id: {%s}, term: {%s}", topicID.Hex(), command.TermID)
+	if added {
+		return httpPkg.SuccessCtxResponse(c, http.StatusCreated, "Topic term added", topicID.Hex())
+	}
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic term already added", topicID.Hex())
 }
 
-func (p *topicHandlers) GetTopicByID4Gateway(c *fiber.Ctx) error {
-	ctx := c.Context()
+// RemoveTopicTerm
+// @Tags Topics
+// @Summary Remove Topic taxonomy term
+// @Description Remove a taxonomy term from the classification of a Topic
+// @Accept json
+// @Produce json
+// @Param id path string true "Topic ID"
+// @Param term path string true "taxonomy term ID"
+// @Success 200 {string} id ""
+// @Router /topics/{id}/terms/{term} [delete]
+func (p *topicHandlers) RemoveTopicTerm(c *fiber.Ctx) error {
+	ctx := c.UserContext()
 	param := c.Params(constants.ID)
-	p.log.Infof("(Handlers.GetByID) id: {%s}", param)
 
 	topicID, err := primitive.ObjectIDFromHex(param)
 	if err != nil {
-		p.log.Errorf("(Handlers.GetByID)(uuid.FromString) err: {%v}", err)
+		p.log.Errorf("(Handlers.RemoveTerm)(uuid.FromString) err: {%v}", err)
 		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
 	}
 
-	topicQuery := topicQueries.NewGetTopicByIDQuery(topicID.Hex())
-	err = p.val.DataValidation(topicQuery)
+	command := topicCommands.NewRemoveTopicTermCommand(topicID.Hex(), c.Params(constants.Term))
+	err = p.val.DataValidation(command)
 	if err != nil {
 		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
 	}
 
-	topic, err := p.ps.Queries.GetTopicByID.Handle4Gateway(ctx, topicQuery)
+	err = p.ps.Commands.RemoveTerm.Handle(ctx, command)
 	if err != nil {
-		p.log.Errorf("(Handlers.GetByID)(Handle) id: {%s}, err: {%v}", topicID.String(), err)
+		p.log.Errorf("(RemoveTerm.Handle) id: {%s}, term: {%s}, err: {%v}", topicID.Hex(), command.TermID, err)
 		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
 	}
 
-	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic found", topic)
+	p.log.Infof("(Topic term removed) id: {%s}, term: {%s}", topicID.Hex(), command.TermID)
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic term removed", topicID.Hex())
+}
+
+// ReorderTopics
+// @Tags topics
+// @Summary Reorder Topics
+// @Description Set the manual order of the Topics of a folder, or of the Topics outside any folder when folder_id is empty. Topics of the folder left out keep their relative order after the listed ones
+// @Accept json
+// @Produce json
+// @Param Topic body dto.ReorderTopicsReqDto true "reorder Topics"
+// @Success 200 {string} id ""
+// @Router /topics/reorder [put]
+func (p *topicHandlers) ReorderTopics(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var reqDto requests.ReorderTopicsReqDto
+	if err := c.BodyParser(&reqDto); err != nil {
+		p.log.Errorf("(Bind) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	command := topicCommands.NewReorderTopicsCommand(reqDto.FolderID, reqDto.IDs)
+	err := p.val.DataValidation(command)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	err = p.ps.Commands.ReorderTopics.Handle(ctx, command)
+	if err != nil {
+		p.log.Errorf("(Reorder.Handle) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Topics reordered) count: {%d}", len(command.IDs))
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topics reordered", command.IDs)
+}
+
+// GetAllTopic
+// @Tags topics
+// @Summary Get all Topics
+// @Description Get all Topics
+// @Accept json
+// @Produce json
+// @Success 200 {object} responses.GetAllTopicResponseDto
+// @Router /topics/ [get]
+func (p *topicHandlers) GetAllTopic(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	pq := utils.NewPaginationQuery(0, 0)
+
+	response, err := p.ps.Queries.GetAllTopic.Handle(ctx, pq)
+	if err != nil {
+		p.log.Errorf("(Create.Handle) Error fetching topics: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic found", response)
+}
+
+// GetTopicByID
+// @Tags Topics
+// @Summary Get Topic
+// @Description Get Topic by id
+// @Accept json
+// @Produce json
+// @Param id path string true "Topic ID"
+// @Success 200 {object} dto.TopicResponseDto
+// @Router /topics/{id} [get]
+func (p *topicHandlers) GetTopicByID(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+	p.log.Infof("(Handlers.GetByID) id: {%s}", param)
+
+	topicID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetByID)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	topicQuery := topicQueries.NewGetTopicByIDQuery(topicID.Hex())
+	err = p.val.DataValidation(topicQuery)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	topic, err := p.ps.Queries.GetTopicByID.Handle(ctx, topicQuery)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetByID)(Handle) id: {%s}, err: {%v}", topicID.String(), err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic found", topic)
+}
+
+// GetTopicRevisions
+// @Tags Topics
+// @Summary Get Topic revisions
+// @Description Get the revisions of a Topic, newest first. Each revision holds the Topic as it was before the change, who made the change and when
+// @Accept json
+// @Produce json
+// @Param id path string true "Topic ID"
+// @Success 200 {object} responses.GetAllRevisionResponseDto
+// @Router /topics/{id}/revisions [get]
+func (p *topicHandlers) GetTopicRevisions(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	topicID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetRevisions)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	topicQuery := topicQueries.NewGetTopicByIDQuery(topicID.Hex())
+	err = p.val.DataValidation(topicQuery)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	revisions, err := p.ps.Queries.GetRevisions.Handle(ctx, topicQuery)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetRevisions)(Handle) id: {%s}, err: {%v}", topicID.String(), err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic revisions found", revisions)
+}
+
+// GetTopicRevisionDiff
+// @Tags Topics
+// @Summary Diff Topic revisions
+// @Description Compare two revisions of a Topic field by field. to defaults to the current Topic
+// @Accept json
+// @Produce json
+// @Param id path string true "Topic ID"
+// @Param from query int true "revision number"
+// @Param to query int false "revision number, 0 for the current Topic"
+// @Success 200 {object} responses.RevisionDiffResponseDto
+// @Router /topics/{id}/revisions/diff [get]
+func (p *topicHandlers) GetTopicRevisionDiff(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	topicID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetRevisionDiff)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	var reqDto revisionRequests.RevisionDiffReqDto
+	if err := c.QueryParser(&reqDto); err != nil {
+		p.log.Errorf("(Bind) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	topicQuery := topicQueries.NewGetTopicRevisionDiffQuery(topicID.Hex(), reqDto.From, reqDto.To)
+	err = p.val.DataValidation(topicQuery)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	diff, err := p.ps.Queries.GetRevisionDiff.Handle(ctx, topicQuery)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetRevisionDiff)(Handle) id: {%s}, err: {%v}", topicID.String(), err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic revision diff found", diff)
+}
+
+// RestoreTopicRevision
+// @Tags Topics
+// @Summary Restore Topic revision
+// @Description Write an old revision back to the Topic. The replaced content is kept as a new revision
+// @Accept json
+// @Produce json
+// @Param id path string true "Topic ID"
+// @Param revision path int true "revision number"
+// @Success 200 {integer} revision ""
+// @Router /topics/{id}/revisions/{revision}/restore [post]
+func (p *topicHandlers) RestoreTopicRevision(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	topicID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.RestoreRevision)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	number, err := c.ParamsInt(constants.Revision)
+	if err != nil {
+		p.log.Errorf("(Handlers.RestoreRevision)(ParamsInt) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	command := topicCommands.NewRestoreTopicRevisionCommand(topicID.Hex(), int64(number))
+	err = p.val.DataValidation(command)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	revision, err := p.ps.Commands.RestoreRevision.Handle(ctx, command)
+	if err != nil {
+		p.log.Errorf("(RestoreRevision.Handle) id: {%s}, err: {%v}", topicID.Hex(), err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Topic revision restored) id: {%s}, revision: {%d}", topicID.Hex(), command.Revision)
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic revision restored", revision)
+}
+
+// SearchTopic
+// @Tags Topics
+// @Summary Search Topics
+// @Description Full text search by title and description
+// @Accept json
+// @Produce json
+// @Param search queries string false "search text"
+// @Param page queries string false "page number"
+// @Param size queries string false "number of elements"
+// @Success 200 {object} dto.TopicSearchResponseDto
+// @Router /topics/search [get]
+func (p *topicHandlers) SearchTopic(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	pq := utils.NewPaginationFromQueryParams(c.Query(constants.Size), c.Query(constants.Page))
+
+	var reqDto requests.SearchTopicFilterReqDto
+	if err := c.QueryParser(&reqDto); err != nil {
+		p.log.Errorf("(Bind) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+	err := p.val.DataValidation(reqDto)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	topicQuery := topicQueries.NewSearchTopicsQuery(
+		reqDto.Keyword,
+		tags.Split(reqDto.Terms),
+		pq,
+	)
+
+	searchRes, err := p.ps.Queries.SearchTopics.Handle(ctx, topicQuery)
+	if err != nil {
+		p.log.Errorf("(Handlers.Search)(Handle) query: {%v}, err: {%v}", reqDto, err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic found", searchRes)
+}
+
+// DeleteTopic
+// @Tags Topics
+// @Summary Delete Topic
+// @Description Move Topic to the trash by id
+// @Accept json
+// @Produce json
+// @Param id path string true "Topic ID"
+// @Success 200 {object} dto.TopicResponseDto
+// @Router /topics/{id} [post]
+func (p *topicHandlers) DeleteTopic(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+	p.log.Infof("(Handlers.Delete) id: {%s}", param)
+
+	topicID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.Delete)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	topicCommand := topicCommands.NewDeleteTopicCommand(topicID.Hex())
+	err = p.val.DataValidation(topicCommand)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	err = p.ps.Commands.DeleteTopic.Handle(ctx, topicCommand)
+	if err != nil {
+		p.log.Errorf("(Handlers.Delete)(Handle) id: {%s}, err: {%v}", topicID.String(), err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Handlers.Delete) topicID: {%s}", topicID.String())
+
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic deleted", topicID)
+}
+
+// GetTopicComponents
+// @Tags Topics
+// @Summary Get Topic components
+// @Description Get the enabled components in display order, each named in the language asked for with lang or Accept-Language
+// @Produce json
+// @Param lang query string false "language code"
+// @Param Accept-Language header string false "preferred languages"
+// @Success 200 {object} []dto.KeyValueResponseDto
+// @Router /topics/components [get]
+func (p *topicHandlers) GetTopicComponents(c *fiber.Ctx) error {
+	languages, err := language.Chain(c.Query(constants.Lang), c.Get(fiber.HeaderAcceptLanguage), p.cfg.Language.Fallback)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic components found", mappers.GetComponentOptions(languages))
+}
+
+// GetTopicLanguages
+// @Tags Topics
+// @Summary Get Topic languages
+// @Description Get the enabled languages in display order, each named in the language asked for with lang or Accept-Language
+// @Produce json
+// @Param lang query string false "language code"
+// @Param Accept-Language header string false "preferred languages"
+// @Success 200 {object} []dto.KeyValueResponseDto
+// @Router /topics/languages [get]
+func (p *topicHandlers) GetTopicLanguages(c *fiber.Ctx) error {
+	languages, err := language.Chain(c.Query(constants.Lang), c.Get(fiber.HeaderAcceptLanguage), p.cfg.Language.Fallback)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic languages found", mappers.GetLanguageOptions(languages))
+}
+
+// GetAllTopic4App
+// @Tags Topics
+// @Summary Get published Topics
+// @Description Get a page of the published Topics, each in the language asked for with lang or Accept-Language, following the configured fallback chain, with its title, description, cover image and media counts
+// @Accept json
+// @Produce json
+// @Param lang query string false "language code"
+// @Param Accept-Language header string false "preferred languages"
+// @Param sort query string false "position, topic_name, created_at or updated_at"
+// @Param order query string false "asc or desc"
+// @Param page query string false "page number"
+// @Param size query string false "number of elements"
+// @Success 200 {object} responses.GetPublishedTopicsResponseDto
+// @Router /user/gallery/topics [get]
+func (p *topicHandlers) GetAllTopic4App(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	topicQuery, err := p.publishedTopicsQuery(c)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	response, err := p.ps.Queries.GetAllTopic.Handle4App(ctx, topicQuery)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetAll)(Handle) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic found", response)
+}
+
+// GetTopicByID4App
+// @Tags Topics
+// @Summary Get Topic in one language
+// @Description Get Topic by id in the language asked for with lang or Accept-Language, following the configured fallback chain. The served language is in language and the Content-Language header
+// @Accept json
+// @Produce json
+// @Param id path string true "Topic ID"
+// @Param lang query string false "language code"
+// @Param Accept-Language header string false "preferred languages"
+// @Success 200 {object} responses.LocalizedTopicResponseDto
+// @Router /user/gallery/topics/{id} [get]
+func (p *topicHandlers) GetTopicByID4App(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+	p.log.Infof("(Handlers.GetByID) id: {%s}", param)
+
+	topicID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetByID)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	languages, err := language.Chain(c.Query(constants.Lang), c.Get(fiber.HeaderAcceptLanguage), p.cfg.Language.Fallback)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	topicQuery := topicQueries.NewGetLocalizedTopicQuery(topicID.Hex(), languages)
+	err = p.val.DataValidation(topicQuery)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	topic, err := p.ps.Queries.GetTopicByID.Handle4App(ctx, topicQuery)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetByID)(Handle) id: {%s}, err: {%v}", topicID.String(), err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	if code := registry.Code(topic.Language); code != "" {
+		c.Set(fiber.HeaderContentLanguage, code)
+	}
+
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic found", topic)
+}
+
+// GetAllTopic4Gateway
+// @Tags Topics
+// @Summary Get published Topics
+// @Description Get a page of the published Topics, each in the language asked for with lang or Accept-Language, following the configured fallback chain, with its title, description, cover image and media counts
+// @Accept json
+// @Produce json
+// @Param lang query string false "language code"
+// @Param Accept-Language header string false "preferred languages"
+// @Param sort query string false "position, topic_name, created_at or updated_at"
+// @Param order query string false "asc or desc"
+// @Param page query string false "page number"
+// @Param size query string false "number of elements"
+// @Success 200 {object} responses.GetPublishedTopicsResponseDto
+// @Router /gateway/gallery/topics [get]
+func (p *topicHandlers) GetAllTopic4Gateway(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	topicQuery, err := p.publishedTopicsQuery(c)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	response, err := p.ps.Queries.GetAllTopic.Handle4App(ctx, topicQuery)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetAll)(Handle) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic found", response)
+}
+
+// GetTopicByID4Gateway
+// @Tags Topics
+// @Summary Get Topic in one language
+// @Description Get Topic by id in the language asked for with lang or Accept-Language, following the configured fallback chain. The served language is in language and the Content-Language header
+// @Accept json
+// @Produce json
+// @Param id path string true "Topic ID"
+// @Param lang query string false "language code"
+// @Param Accept-Language header string false "preferred languages"
+// @Success 200 {object} responses.LocalizedTopicResponseDto
+// @Router /gateway/gallery/topics/{id} [get]
+func (p *topicHandlers) GetTopicByID4Gateway(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+	p.log.Infof("(Handlers.GetByID) id: {%s}", param)
+
+	topicID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetByID)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	languages, err := language.Chain(c.Query(constants.Lang), c.Get(fiber.HeaderAcceptLanguage), p.cfg.Language.Fallback)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	topicQuery := topicQueries.NewGetLocalizedTopicQuery(topicID.Hex(), languages)
+	err = p.val.DataValidation(topicQuery)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	topic, err := p.ps.Queries.GetTopicByID.Handle4App(ctx, topicQuery)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetByID)(Handle) id: {%s}, err: {%v}", topicID.String(), err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	if code := registry.Code(topic.Language); code != "" {
+		c.Set(fiber.HeaderContentLanguage, code)
+	}
+
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic found", topic)
+}
+
+// GetTopicWorkflow
+// @Tags Topics
+// @Summary Get Topic workflow
+// @Description Get the editorial status of a Topic and the transitions that got it there, with who made each one and when
+// @Accept json
+// @Produce json
+// @Param id path string true "Topic ID"
+// @Success 200 {object} responses.GetTopicWorkflowResponseDto
+// @Router /topics/{id}/workflow [get]
+func (p *topicHandlers) GetTopicWorkflow(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	topicID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetWorkflow)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	topicQuery := topicQueries.NewGetTopicByIDQuery(topicID.Hex())
+	err = p.val.DataValidation(topicQuery)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	workflow, err := p.ps.Queries.GetWorkflow.Handle(ctx, topicQuery)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetWorkflow)(Handle) id: {%s}, err: {%v}", topicID.Hex(), err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic workflow found", workflow)
+}
+
+// SubmitTopic
+// @Tags Topics
+// @Summary Submit Topic for review
+// @Description Send a draft Topic for review. Answers 409 when the Topic is not in the status the action starts from
+// @Accept json
+// @Produce json
+// @Param id path string true "Topic ID"
+// @Param Comment body dto.TransitionTopicReqDto false "comment"
+// @Success 200 {string} status ""
+// @Router /topics/{id}/submit [post]
+func (p *topicHandlers) SubmitTopic(c *fiber.Ctx) error {
+	return p.transitionTopic(c, constants2.TopicActionSubmit, "Topic submitted for review")
+}
+
+// ApproveTopic
+// @Tags Topics
+// @Summary Approve Topic
+// @Description Approve a Topic in review so it can be published. Answers 409 when the Topic is not in the status the action starts from
+// @Accept json
+// @Produce json
+// @Param id path string true "Topic ID"
+// @Param Comment body dto.TransitionTopicReqDto false "comment"
+// @Success 200 {string} status ""
+// @Router /topics/{id}/approve [post]
+func (p *topicHandlers) ApproveTopic(c *fiber.Ctx) error {
+	return p.transitionTopic(c, constants2.TopicActionApprove, "Topic approved")
+}
+
+// RejectTopic
+// @Tags Topics
+// @Summary Reject Topic
+// @Description Send a Topic in review back to draft. The comment saying why is required. Answers 409 when the Topic is not in the status the action starts from
+// @Accept json
+// @Produce json
+// @Param id path string true "Topic ID"
+// @Param Comment body dto.TransitionTopicReqDto false "comment"
+// @Success 200 {string} status ""
+// @Router /topics/{id}/reject [post]
+func (p *topicHandlers) RejectTopic(c *fiber.Ctx) error {
+	return p.transitionTopic(c, constants2.TopicActionReject, "Topic rejected")
+}
+
+// PublishTopic
+// @Tags Topics
+// @Summary Publish Topic
+// @Description Publish an approved Topic. Answers 409 when the Topic is not in the status the action starts from
+// @Accept json
+// @Produce json
+// @Param id path string true "Topic ID"
+// @Param Comment body dto.TransitionTopicReqDto false "comment"
+// @Success 200 {string} status ""
+// @Router /topics/{id}/publish [post]
+func (p *topicHandlers) PublishTopic(c *fiber.Ctx) error {
+	return p.transitionTopic(c, constants2.TopicActionPublish, "Topic published")
+}
+
+// ArchiveTopic
+// @Tags Topics
+// @Summary Archive Topic
+// @Description Withdraw a published Topic. Answers 409 when the Topic is not in the status the action starts from
+// @Accept json
+// @Produce json
+// @Param id path string true "Topic ID"
+// @Param Comment body dto.TransitionTopicReqDto false "comment"
+// @Success 200 {string} status ""
+// @Router /topics/{id}/archive [post]
+func (p *topicHandlers) ArchiveTopic(c *fiber.Ctx) error {
+	return p.transitionTopic(c, constants2.TopicActionArchive, "Topic archived")
+}
+
+func (p *topicHandlers) transitionTopic(c *fiber.Ctx, action constants2.TopicAction, message string) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	topicID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.Transition)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	var reqDto requests.TransitionTopicReqDto
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&reqDto); err != nil {
+			p.log.Errorf("(Bind) err: {%v}", err)
+			return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+		}
+	}
+
+	command := topicCommands.NewTransitionTopicCommand(topicID.Hex(), action, reqDto.Comment)
+	err = p.val.DataValidation(command)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	status, err := p.ps.Commands.Transition.Handle(ctx, command)
+	if err != nil {
+		p.log.Errorf("(Transition.Handle) id: {%s}, action: {%s}, err: {%v}", topicID.Hex(), action, err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Topic status changed) id: {%s}, status: {%s}", topicID.Hex(), status)
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, message, status)
+}
+
+// ScheduleTopic
+// @Tags topics
+// @Summary Schedule Topic
+// @Description Set when an approved Topic is published and when it is archived. A time left out is cleared
+// @Accept json
+// @Produce json
+// @Param id path string true "Topic ID"
+// @Param Topic body dto.ScheduleTopicReqDto true "schedule"
+// @Success 200 {string} id ""
+// @Router /topics/{id}/schedule [put]
+func (p *topicHandlers) ScheduleTopic(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	topicID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.Schedule)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	var reqDto requests.ScheduleTopicReqDto
+	if err := c.BodyParser(&reqDto); err != nil {
+		p.log.Errorf("(Bind) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	command := topicCommands.NewScheduleTopicCommand(topicID.Hex(), reqDto.PublishAt, reqDto.UnpublishAt)
+	err = p.val.DataValidation(command)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	if err := p.ps.Commands.Schedule.Handle(ctx, command); err != nil {
+		p.log.Errorf("(Schedule.Handle) id: {%s}, err: {%v}", topicID.Hex(), err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Topic scheduled) id: {%s}, publish at: {%v}, unpublish at: {%v}", topicID.Hex(), reqDto.PublishAt, reqDto.UnpublishAt)
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic scheduled", topicID.Hex())
+}
+
+// MoveTopic
+// @Tags Topics
+// @Summary Move Topic
+// @Description File a topic in a folder, or take it out of the folder tree with an empty folder_id
+// @Accept json
+// @Produce json
+// @Param id path string true "Topic ID"
+// @Param Topic body dto.MoveTopicReqDto true "folder"
+// @Success 200 {string} id ""
+// @Router /topics/{id}/folder [put]
+func (p *topicHandlers) MoveTopic(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	topicID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.Move)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	var reqDto requests.MoveTopicReqDto
+	if err := c.BodyParser(&reqDto); err != nil {
+		p.log.Errorf("(Bind) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	command := topicCommands.NewMoveTopicCommand(topicID.Hex(), reqDto.FolderID)
+	err = p.val.DataValidation(command)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	if err := p.ps.Commands.Move.Handle(ctx, command); err != nil {
+		p.log.Errorf("(Move.Handle) id: {%s}, err: {%v}", topicID.Hex(), err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Topic moved) id: {%s}, folder: {%s}", topicID.Hex(), reqDto.FolderID)
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Topic moved", topicID.Hex())
+}
+
+// publishedTopicsQuery reads the listing of the published topics that the user and the
+// gateway routes share from the query string and the Accept-Language header
+func (p *topicHandlers) publishedTopicsQuery(c *fiber.Ctx) (*topicQueries.GetPublishedTopicsQuery, error) {
+	pq := utils.NewPaginationFromQueryParams(c.Query(constants.Size), c.Query(constants.Page))
+
+	var reqDto requests.GetPublishedTopicsReqDto
+	if err := c.QueryParser(&reqDto); err != nil {
+		p.log.Errorf("(Bind) err: {%v}", err)
+		return nil, err
+	}
+
+	if err := p.val.DataValidation(reqDto); err != nil {
+		return nil, err
+	}
+
+	languages, err := language.Chain(c.Query(constants.Lang), c.Get(fiber.HeaderAcceptLanguage), p.cfg.Language.Fallback)
+	if err != nil {
+		return nil, err
+	}
+
+	topicQuery := topicQueries.NewGetPublishedTopicsQuery(languages, reqDto.Sort, reqDto.Order == "desc", pq)
+	if err := p.val.DataValidation(topicQuery); err != nil {
+		return nil, err
+	}
+
+	return topicQuery, nil
 }
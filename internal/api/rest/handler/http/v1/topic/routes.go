@@ -11,17 +11,41 @@ func (p *topicHandlers) MapRoutesAdmin() func(router fiber.Router) {
 	return func(router fiber.Router) {
 		topicRepository := repository.NewTopicRepository(p.log, p.cfg, p.mongoClient)
 		folderRepository := repository.NewFolderRepository(p.log, p.cfg, p.mongoClient)
+		revisionRepository := repository.NewRevisionRepository(p.log, p.cfg, p.mongoClient)
+		taxonomyRepository := repository.NewTaxonomyRepository(p.log, p.cfg, p.mongoClient)
+		txManager := repository.NewTransactionManager(p.log, p.mongoClient)
 
-		p.ps = service.NewTopicService(p.cfg.Kafka, p.log, topicRepository, folderRepository)
+		p.ps = service.NewTopicService(p.cfg.Kafka, p.log, p.val, topicRepository, folderRepository, revisionRepository, taxonomyRepository, txManager)
 		router.Get("", p.GetAllTopic)
 		router.Get("/search", p.SearchTopic)
 		router.Get("/components", p.GetTopicComponents)
 		router.Get("/languages", p.GetTopicLanguages)
 		router.Get("/:id", p.GetTopicByID)
+		router.Get("/:id/revisions", p.GetTopicRevisions)
+		router.Get("/:id/revisions/diff", p.GetTopicRevisionDiff)
+		router.Get("/:id/workflow", p.GetTopicWorkflow)
 
 		router.Post("", p.CreateTopic)
+		router.Post("/:id/languages/:code/:kind", p.AddTopicMedia)
+		router.Post("/:id/revisions/:revision/restore", p.RestoreTopicRevision)
+		router.Post("/:id/submit", p.SubmitTopic)
+		router.Post("/:id/approve", p.ApproveTopic)
+		router.Post("/:id/reject", p.RejectTopic)
+		router.Post("/:id/publish", p.PublishTopic)
+		router.Post("/:id/archive", p.ArchiveTopic)
 		router.Put("", p.UpdateTopic)
+		router.Put("/reorder", p.ReorderTopics)
+		router.Put("/:id/folder", p.MoveTopic)
+		router.Put("/:id/languages/:code", p.SetTopicLanguage)
+		router.Put("/:id/languages/:code/:kind/reorder", p.ReorderTopicMedia)
+		router.Put("/:id/languages/:code/:kind/:item", p.UpdateTopicMedia)
+		router.Put("/:id/schedule", p.ScheduleTopic)
+		router.Put("/:id/terms/:term", p.AddTopicTerm)
+		router.Patch("/:id", p.PatchTopic)
 		router.Delete("/:id", p.DeleteTopic)
+		router.Delete("/:id/languages/:code", p.RemoveTopicLanguage)
+		router.Delete("/:id/languages/:code/:kind/:item", p.RemoveTopicMedia)
+		router.Delete("/:id/terms/:term", p.RemoveTopicTerm)
 	}
 }
 
@@ -29,10 +53,13 @@ func (p *topicHandlers) MapRoutesUser() func(router fiber.Router) {
 	return func(router fiber.Router) {
 		topicRepository := repository.NewTopicRepository(p.log, p.cfg, p.mongoClient)
 		folderRepository := repository.NewFolderRepository(p.log, p.cfg, p.mongoClient)
+		revisionRepository := repository.NewRevisionRepository(p.log, p.cfg, p.mongoClient)
+		taxonomyRepository := repository.NewTaxonomyRepository(p.log, p.cfg, p.mongoClient)
+		txManager := repository.NewTransactionManager(p.log, p.mongoClient)
 
-		p.ps = service.NewTopicService(p.cfg.Kafka, p.log, topicRepository, folderRepository)
+		p.ps = service.NewTopicService(p.cfg.Kafka, p.log, p.val, topicRepository, folderRepository, revisionRepository, taxonomyRepository, txManager)
 		router.Get("", p.GetAllTopic4App)
-		router.Get("/:id", p.GetTopicByID)
+		router.Get("/:id", p.GetTopicByID4App)
 	}
 }
 
@@ -40,8 +67,11 @@ func (p *topicHandlers) MapRoutesGateway() func(router fiber.Router) {
 	return func(router fiber.Router) {
 		topicRepository := repository.NewTopicRepository(p.log, p.cfg, p.mongoClient)
 		folderRepository := repository.NewFolderRepository(p.log, p.cfg, p.mongoClient)
+		revisionRepository := repository.NewRevisionRepository(p.log, p.cfg, p.mongoClient)
+		taxonomyRepository := repository.NewTaxonomyRepository(p.log, p.cfg, p.mongoClient)
+		txManager := repository.NewTransactionManager(p.log, p.mongoClient)
 
-		p.ps = service.NewTopicService(p.cfg.Kafka, p.log, topicRepository, folderRepository)
+		p.ps = service.NewTopicService(p.cfg.Kafka, p.log, p.val, topicRepository, folderRepository, revisionRepository, taxonomyRepository, txManager)
 		router.Get("", p.GetAllTopic4Gateway)
 		router.Get("/:id", p.GetTopicByID4Gateway)
 	}
@@ -0,0 +1,144 @@
+package trash
+
+import (
+	"gallery-service/config"
+	"gallery-service/internal/api/rest/validator"
+	trashCommands "gallery-service/internal/application/commands/v1/trash"
+	requests "gallery-service/internal/application/dto/requests/trash"
+	trashQueries "gallery-service/internal/application/queries/trash"
+	"gallery-service/internal/domain/service"
+	"gallery-service/pkg/constants"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type trashHandlers struct {
+	log         zap.Logger
+	cfg         *config.Config
+	ps          *service.TrashService
+	val         *validator.Wrapper
+	mongoClient *mongo.Client
+}
+
+func NewTrashHandlers(
+	log zap.Logger,
+	cfg *config.Config,
+	mongoClient *mongo.Client,
+) *trashHandlers {
+	return &trashHandlers{
+		log:         log,
+		cfg:         cfg,
+		val:         validator.NewValidator(log, cfg),
+		mongoClient: mongoClient,
+	}
+}
+
+// GetAllTrash
+// @Tags Trash
+// @Summary Get Trash
+// @Description Get the deleted folders, clusters and topics the caller can restore, most recently deleted first
+// @Accept json
+// @Produce json
+// @Param type query string false "folder, cluster or topic"
+// @Success 200 {object} responses.GetAllTrashResponseDto
+// @Router /trash [get]
+func (p *trashHandlers) GetAllTrash(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var reqDto requests.GetTrashReqDto
+	if err := c.QueryParser(&reqDto); err != nil {
+		p.log.Errorf("(Bind) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	trashQuery := trashQueries.NewGetAllTrashQuery(reqDto.Type)
+	err := p.val.DataValidation(trashQuery)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	response, err := p.ps.Queries.GetAllTrash.Handle(ctx, trashQuery)
+	if err != nil {
+		p.log.Errorf("(Handlers.GetAllTrash)(Handle) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Trash found", response)
+}
+
+// RestoreTrashItem
+// @Tags Trash
+// @Summary Restore Trash item
+// @Description Bring a deleted item back in its old place. A folder comes back with the folders and clusters deleted with it
+// @Accept json
+// @Produce json
+// @Param type path string true "folder, cluster or topic"
+// @Param id path string true "item ID"
+// @Success 200 {string} id ""
+// @Router /trash/{type}/{id}/restore [post]
+func (p *trashHandlers) RestoreTrashItem(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	itemID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.RestoreTrashItem)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	command := trashCommands.NewRestoreTrashItemCommand(c.Params(constants.Type), itemID.Hex())
+	err = p.val.DataValidation(command)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	err = p.ps.Commands.RestoreItem.Handle(ctx, command)
+	if err != nil {
+		p.log.Errorf("(RestoreItem.Handle) type: {%s}, id: {%s}, err: {%v}", command.Type, itemID.Hex(), err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Trash item restored) type: {%s}, id: {%s}", command.Type, itemID.Hex())
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Trash item restored", itemID)
+}
+
+// PurgeTrashItem
+// @Tags Trash
+// @Summary Purge Trash item
+// @Description Delete an item in the trash for good. A folder takes the folders and clusters deleted with it along
+// @Accept json
+// @Produce json
+// @Param type path string true "folder, cluster or topic"
+// @Param id path string true "item ID"
+// @Success 200 {string} id ""
+// @Router /trash/{type}/{id} [delete]
+func (p *trashHandlers) PurgeTrashItem(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	param := c.Params(constants.ID)
+
+	itemID, err := primitive.ObjectIDFromHex(param)
+	if err != nil {
+		p.log.Errorf("(Handlers.PurgeTrashItem)(uuid.FromString) err: {%v}", err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	command := trashCommands.NewPurgeTrashItemCommand(c.Params(constants.Type), itemID.Hex())
+	err = p.val.DataValidation(command)
+	if err != nil {
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	err = p.ps.Commands.PurgeItem.Handle(ctx, command)
+	if err != nil {
+		p.log.Errorf("(PurgeItem.Handle) type: {%s}, id: {%s}, err: {%v}", command.Type, itemID.Hex(), err)
+		return httpPkg.ErrorCtxResponse(c, err, p.cfg.App.API.Rest.Setting.DebugErrorsResponse)
+	}
+
+	p.log.Infof("(Trash item purged) type: {%s}, id: {%s}", command.Type, itemID.Hex())
+	return httpPkg.SuccessCtxResponse(c, http.StatusOK, "Trash item purged", itemID)
+}
@@ -0,0 +1,23 @@
+package trash
+
+import (
+	"gallery-service/internal/domain/service"
+	"gallery-service/internal/infrastructure/database/mongo/repository"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func (p *trashHandlers) MapRoutes() func(router fiber.Router) {
+	return func(router fiber.Router) {
+		folderRepository := repository.NewFolderRepository(p.log, p.cfg, p.mongoClient)
+		clusterRepository := repository.NewClusterRepository(p.log, p.cfg, p.mongoClient)
+		topicRepository := repository.NewTopicRepository(p.log, p.cfg, p.mongoClient)
+		txManager := repository.NewTransactionManager(p.log, p.mongoClient)
+
+		p.ps = service.NewTrashService(p.cfg.Trash, p.log, folderRepository, clusterRepository, topicRepository, txManager)
+		router.Get("", p.GetAllTrash)
+
+		router.Post("/:type/:id/restore", p.RestoreTrashItem)
+		router.Delete("/:type/:id", p.PurgeTrashItem)
+	}
+}
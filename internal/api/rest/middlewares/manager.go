@@ -6,6 +6,7 @@ import (
 	"gallery-service/config"
 	"gallery-service/internal/pkg/apicall"
 	"gallery-service/internal/pkg/apicall/dto"
+	"gallery-service/internal/pkg/tenant"
 	httpPkg "gallery-service/pkg/http"
 	"gallery-service/pkg/zap"
 	"runtime/debug"
@@ -95,7 +96,7 @@ func (mw *middlewareManager) Auth(client *api.Client) fiber.Handler {
 		}
 
 		// Add a value to the context
-		userCtx = context.WithValue(userCtx, "current_user", user)
+		userCtx = context.WithValue(userCtx, tenant.CurrentUserKey, user)
 		userCtx = context.WithValue(userCtx, "current_token", authHeader)
 		// Set the updated context back to Fiber
 		c.SetUserContext(userCtx)
@@ -108,7 +109,7 @@ func (mw *middlewareManager) Auth(client *api.Client) fiber.Handler {
 func (mw *middlewareManager) ValidateSuperAdminRole() fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		// Get current_user from context
-		user, ok := c.UserContext().Value("current_user").(*dto.UserEntityResponse)
+		user, ok := c.UserContext().Value(tenant.CurrentUserKey).(*dto.UserEntityResponse)
 		if !ok || user == nil {
 			mw.log.Warn("current_user not found in context")
 			return httpPkg.ErrorCtxResponse(c, errors.New("unauthorized"), mw.cfg.App.API.Rest.Setting.DebugErrorsResponse)
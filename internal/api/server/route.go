@@ -3,7 +3,10 @@ package server
 import (
 	clusterV1 "gallery-service/internal/api/rest/handler/http/v1/cluster"
 	folderV1 "gallery-service/internal/api/rest/handler/http/v1/folder"
+	registryV1 "gallery-service/internal/api/rest/handler/http/v1/registry"
+	taxonomyV1 "gallery-service/internal/api/rest/handler/http/v1/taxonomy"
 	topicV1 "gallery-service/internal/api/rest/handler/http/v1/topic"
+	trashV1 "gallery-service/internal/api/rest/handler/http/v1/trash"
 
 	"github.com/gofiber/fiber/v2"
 )
@@ -13,6 +16,9 @@ func (s *server) routes() {
 	clusterHandlers := clusterV1.NewClusterHandlers(s.log, s.cfg, s.mongoClient)
 	folderHandlers := folderV1.NewFolderHandlers(s.log, s.cfg, s.mongoClient)
 	topicHandlers := topicV1.NewTopicHandlers(s.log, s.cfg, s.mongoClient)
+	trashHandlers := trashV1.NewTrashHandlers(s.log, s.cfg, s.mongoClient)
+	taxonomyHandlers := taxonomyV1.NewTaxonomyHandlers(s.log, s.cfg, s.mongoClient)
+	registryHandlers := registryV1.NewRegistryHandlers(s.log, s.cfg, s.mongoClient)
 
 	// ===== Admin Routes =====
 	adminAPI := s.fiber.Group("/api/v1/admin/gallery")
@@ -26,18 +32,32 @@ func (s *server) routes() {
 	topicGroup := adminAPI.Group("/topics", s.mw.Auth(s.consulClient), s.mw.ValidateSuperAdminRole())
 	topicGroup.Route("", topicHandlers.MapRoutesAdmin())
 
+	trashGroup := adminAPI.Group("/trash", s.mw.Auth(s.consulClient))
+	trashGroup.Route("", trashHandlers.MapRoutes())
+
+	// Every admin reads the taxonomy to classify content, only a SuperAdmin changes it
+	taxonomyGroup := adminAPI.Group("/taxonomy", s.mw.Auth(s.consulClient))
+	taxonomyGroup.Route("", taxonomyHandlers.MapRoutesAdmin())
+
+	// Every admin reads the languages and components, only a SuperAdmin changes them
+	registryGroup := adminAPI.Group("/registry", s.mw.Auth(s.consulClient))
+	registryGroup.Route("", registryHandlers.MapRoutesAdmin())
+
 	// ===== User Routes =====
 	userAPI := s.fiber.Group("/api/v1/user/gallery")
 
 	userClusterGroup := userAPI.Group("/clusters", s.mw.Auth(s.consulClient))
-	userClusterGroup.Route("", clusterHandlers.MapRoutes())
+	userClusterGroup.Route("", clusterHandlers.MapRoutesUser())
 
 	userFolderGroup := userAPI.Group("/folders", s.mw.Auth(s.consulClient))
-	userFolderGroup.Route("", folderHandlers.MapRoutes())
+	userFolderGroup.Route("", folderHandlers.MapRoutesUser())
 
 	userTopicGroup := userAPI.Group("/topics", s.mw.Auth(s.consulClient))
 	userTopicGroup.Route("", topicHandlers.MapRoutesUser())
 
+	userTaxonomyGroup := userAPI.Group("/taxonomy", s.mw.Auth(s.consulClient))
+	userTaxonomyGroup.Route("", taxonomyHandlers.MapRoutesUser())
+
 	// ===== Gateway Routes =====
 	gatewayAPI := s.fiber.Group("/api/v1/gateway/gallery")
 	gatewayTopicGroup := gatewayAPI.Group("/topics")
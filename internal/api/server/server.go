@@ -4,6 +4,8 @@ import (
 	"context"
 	"gallery-service/config"
 	"gallery-service/internal/api/rest/middlewares"
+	"gallery-service/internal/infrastructure/database/mongo/migrations"
+	"gallery-service/internal/pkg/jobs"
 	"gallery-service/pkg/consul"
 	httpPkg "gallery-service/pkg/http"
 	"gallery-service/pkg/mongodb"
@@ -72,6 +74,17 @@ func (s *server) StartServer() error {
 
 	s.mongoMigrationUp(ctx)
 
+	if updated, err := migrations.EnsureFolderAncestors(ctx, s.log, s.cfg, s.mongoClient); err != nil {
+		s.log.Errorf("(EnsureFolderAncestors) err: {%v}", err)
+	} else if updated > 0 {
+		s.log.Infof("(EnsureFolderAncestors) updated folders: {%d}", updated)
+	}
+
+	jobs.NewRegistryRefreshJob(s.cfg, s.log, s.mongoClient).Start(ctx)
+
+	jobs.NewTrashPurgeJob(s.cfg, s.log, s.mongoClient).Start(ctx)
+	jobs.NewPublishScheduleJob(s.cfg, s.log, s.mongoClient).Start(ctx)
+
 	consulConn := consul.NewConsulConn(s.log, s.cfg)
 	s.consulClient = consulConn.Connect()
 	defer consulConn.Deregister()
@@ -43,6 +43,38 @@ func (s *server) mongoMigrationUp(ctx context.Context) {
 		}
 	}
 
+	// Create the "revisions" collection
+	err = s.mongoClient.Database(s.cfg.Mongo.Db).CreateCollection(ctx, s.cfg.Mongo.Collections.Revision)
+	if err != nil {
+		if !utils.CheckErrMessages(err, serviceErrors.ErrMsgMongoCollectionAlreadyExists) {
+			s.log.Warnf("(CreateCollection) err: {%v}", err)
+		}
+	}
+
+	// Create the "taxonomy" collection
+	err = s.mongoClient.Database(s.cfg.Mongo.Db).CreateCollection(ctx, s.cfg.Mongo.Collections.Taxonomy)
+	if err != nil {
+		if !utils.CheckErrMessages(err, serviceErrors.ErrMsgMongoCollectionAlreadyExists) {
+			s.log.Warnf("(CreateCollection) err: {%v}", err)
+		}
+	}
+
+	// Create the "languages" collection
+	err = s.mongoClient.Database(s.cfg.Mongo.Db).CreateCollection(ctx, s.cfg.Mongo.Collections.Language)
+	if err != nil {
+		if !utils.CheckErrMessages(err, serviceErrors.ErrMsgMongoCollectionAlreadyExists) {
+			s.log.Warnf("(CreateCollection) err: {%v}", err)
+		}
+	}
+
+	// Create the "components" collection
+	err = s.mongoClient.Database(s.cfg.Mongo.Db).CreateCollection(ctx, s.cfg.Mongo.Collections.Component)
+	if err != nil {
+		if !utils.CheckErrMessages(err, serviceErrors.ErrMsgMongoCollectionAlreadyExists) {
+			s.log.Warnf("(CreateCollection) err: {%v}", err)
+		}
+	}
+
 	// Create indexes on the "cluster" collection
 	{
 		indexes, err := s.mongoClient.Database(s.cfg.Mongo.Db).Collection(s.cfg.Mongo.Collections.Cluster).Indexes().CreateMany(ctx, []mongo.IndexModel{
@@ -54,6 +86,42 @@ func (s *server) mongoMigrationUp(ctx context.Context) {
 				},
 				Options: options.Index().SetSparse(true).SetName(fmt.Sprintf("%s.text_index", s.cfg.Mongo.Collections.Cluster)),
 			},
+			{
+				Keys:    bson.D{{"folder_id", 1}},
+				Options: options.Index().SetName(fmt.Sprintf("%s.%s_index", s.cfg.Mongo.Collections.Cluster, "folder_id")),
+			},
+			{
+				Keys:    bson.D{{"folder_id", 1}, {"position", 1}},
+				Options: options.Index().SetName(fmt.Sprintf("%s.%s_index", s.cfg.Mongo.Collections.Cluster, "folder_id_position")),
+			},
+			{
+				Keys:    bson.D{{"organization_id", 1}},
+				Options: options.Index().SetName(fmt.Sprintf("%s.%s_index", s.cfg.Mongo.Collections.Cluster, "organization_id")),
+			},
+			{
+				Keys:    bson.D{{"tags", 1}},
+				Options: options.Index().SetName(fmt.Sprintf("%s.%s_index", s.cfg.Mongo.Collections.Cluster, "tags")),
+			},
+			{
+				Keys:    bson.D{{"taxonomy_terms", 1}},
+				Options: options.Index().SetName(fmt.Sprintf("%s.%s_index", s.cfg.Mongo.Collections.Cluster, "taxonomy_terms")),
+			},
+			{
+				Keys:    bson.D{{"deleted_at", 1}},
+				Options: options.Index().SetSparse(true).SetName(fmt.Sprintf("%s.%s_index", s.cfg.Mongo.Collections.Cluster, "deleted_at")),
+			},
+			{
+				Keys:    bson.D{{"trash_id", 1}},
+				Options: options.Index().SetSparse(true).SetName(fmt.Sprintf("%s.%s_index", s.cfg.Mongo.Collections.Cluster, "trash_id")),
+			},
+			{
+				Keys:    bson.D{{"publish_at", 1}},
+				Options: options.Index().SetSparse(true).SetName(fmt.Sprintf("%s.%s_index", s.cfg.Mongo.Collections.Cluster, "publish_at")),
+			},
+			{
+				Keys:    bson.D{{"unpublish_at", 1}},
+				Options: options.Index().SetSparse(true).SetName(fmt.Sprintf("%s.%s_index", s.cfg.Mongo.Collections.Cluster, "unpublish_at")),
+			},
 		})
 		if err != nil && !utils.CheckErrMessages(err, serviceErrors.ErrMsgAlreadyExists) {
 			s.log.Warnf("(CreateMany) err: {%v}", err)
@@ -63,20 +131,58 @@ func (s *server) mongoMigrationUp(ctx context.Context) {
 
 	// Create indexes on the "folders" collection
 	{
+		// A release created a plain index on the thumbnail key in place of the unique one,
+		// it is dropped so the unique index can be built on the same key again
+		_, err := s.mongoClient.Database(s.cfg.Mongo.Db).Collection(s.cfg.Mongo.Collections.Folder).Indexes().DropOne(ctx, fmt.Sprintf("%s.%s_index", s.cfg.Mongo.Collections.Folder, "folder_thumbnail_key"))
+		if err != nil && !utils.CheckErrMessages(err, serviceErrors.ErrMsgIndexNotFound, serviceErrors.ErrMsgNamespaceNotFound) {
+			s.log.Warnf("(DropOne) err: {%v}", err)
+		}
+
 		indexes, err := s.mongoClient.Database(s.cfg.Mongo.Db).Collection(s.cfg.Mongo.Collections.Folder).Indexes().CreateMany(ctx, []mongo.IndexModel{
 			{
 				Keys:    bson.D{{"folder_name", 1}},
 				Options: options.Index().SetSparse(true).SetName(fmt.Sprintf("%s.%s_index", s.cfg.Mongo.Collections.Folder, "folder_name")),
 			},
 			{
-				Keys:    bson.D{{"folder_thumbnail_key", 1}},
-				Options: options.Index().SetUnique(true).SetName(fmt.Sprintf("%s.%s_unique_index", s.cfg.Mongo.Collections.Folder, "folder_thumbnail_key")),
+				Keys:    bson.D{{"parent_id", 1}},
+				Options: options.Index().SetName(fmt.Sprintf("%s.%s_index", s.cfg.Mongo.Collections.Folder, "parent_id")),
+			},
+			{
+				Keys:    bson.D{{"parent_id", 1}, {"position", 1}},
+				Options: options.Index().SetName(fmt.Sprintf("%s.%s_index", s.cfg.Mongo.Collections.Folder, "parent_id_position")),
+			},
+			{
+				Keys:    bson.D{{"organization_id", 1}},
+				Options: options.Index().SetName(fmt.Sprintf("%s.%s_index", s.cfg.Mongo.Collections.Folder, "organization_id")),
+			},
+			{
+				Keys:    bson.D{{"deleted_at", 1}},
+				Options: options.Index().SetSparse(true).SetName(fmt.Sprintf("%s.%s_index", s.cfg.Mongo.Collections.Folder, "deleted_at")),
+			},
+			{
+				Keys:    bson.D{{"trash_id", 1}},
+				Options: options.Index().SetSparse(true).SetName(fmt.Sprintf("%s.%s_index", s.cfg.Mongo.Collections.Folder, "trash_id")),
+			},
+			{
+				Keys:    bson.D{{"ancestors", 1}},
+				Options: options.Index().SetName(fmt.Sprintf("%s.%s_index", s.cfg.Mongo.Collections.Folder, "ancestors")),
 			},
 		})
 		if err != nil && !utils.CheckErrMessages(err, serviceErrors.ErrMsgAlreadyExists) {
 			s.log.Warnf("(CreateMany) err: {%v}", err)
 		}
 		s.log.Infof("(CreatedIndexes) indexes: {%v}", indexes)
+
+		// Built on its own so that folders already sharing a thumbnail key, which keep it
+		// from being built until they are given their own, hold back no other index
+		index, err := s.mongoClient.Database(s.cfg.Mongo.Db).Collection(s.cfg.Mongo.Collections.Folder).Indexes().CreateOne(ctx, mongo.IndexModel{
+			Keys:    bson.D{{"folder_thumbnail_key", 1}},
+			Options: options.Index().SetUnique(true).SetName(fmt.Sprintf("%s.%s_unique_index", s.cfg.Mongo.Collections.Folder, "folder_thumbnail_key")),
+		})
+		if err != nil && !utils.CheckErrMessages(err, serviceErrors.ErrMsgAlreadyExists) {
+			s.log.Warnf("(CreateOne) folder_thumbnail_key unique index not built: {%v}", err)
+		}
+		s.log.Infof("(CreatedIndexes) index: {%v}", index)
 	}
 
 	// Create indexes on the "topic" collection
@@ -88,6 +194,110 @@ func (s *server) mongoMigrationUp(ctx context.Context) {
 				},
 				Options: options.Index().SetSparse(true).SetName(fmt.Sprintf("%s.text_index", s.cfg.Mongo.Collections.Topic)),
 			},
+			{
+				Keys:    bson.D{{"position", 1}},
+				Options: options.Index().SetName(fmt.Sprintf("%s.%s_index", s.cfg.Mongo.Collections.Topic, "position")),
+			},
+			{
+				Keys:    bson.D{{"organization_id", 1}},
+				Options: options.Index().SetName(fmt.Sprintf("%s.%s_index", s.cfg.Mongo.Collections.Topic, "organization_id")),
+			},
+			{
+				Keys:    bson.D{{"taxonomy_terms", 1}},
+				Options: options.Index().SetName(fmt.Sprintf("%s.%s_index", s.cfg.Mongo.Collections.Topic, "taxonomy_terms")),
+			},
+			{
+				Keys:    bson.D{{"deleted_at", 1}},
+				Options: options.Index().SetSparse(true).SetName(fmt.Sprintf("%s.%s_index", s.cfg.Mongo.Collections.Topic, "deleted_at")),
+			},
+			{
+				Keys:    bson.D{{"trash_id", 1}},
+				Options: options.Index().SetSparse(true).SetName(fmt.Sprintf("%s.%s_index", s.cfg.Mongo.Collections.Topic, "trash_id")),
+			},
+			{
+				Keys:    bson.D{{"status", 1}, {"position", 1}},
+				Options: options.Index().SetName(fmt.Sprintf("%s.%s_index", s.cfg.Mongo.Collections.Topic, "status_position")),
+			},
+			{
+				Keys:    bson.D{{"folder_id", 1}, {"position", 1}},
+				Options: options.Index().SetSparse(true).SetName(fmt.Sprintf("%s.%s_index", s.cfg.Mongo.Collections.Topic, "folder_id_position")),
+			},
+			{
+				Keys:    bson.D{{"publish_at", 1}},
+				Options: options.Index().SetSparse(true).SetName(fmt.Sprintf("%s.%s_index", s.cfg.Mongo.Collections.Topic, "publish_at")),
+			},
+			{
+				Keys:    bson.D{{"unpublish_at", 1}},
+				Options: options.Index().SetSparse(true).SetName(fmt.Sprintf("%s.%s_index", s.cfg.Mongo.Collections.Topic, "unpublish_at")),
+			},
+		})
+		if err != nil && !utils.CheckErrMessages(err, serviceErrors.ErrMsgAlreadyExists) {
+			s.log.Warnf("(CreateMany) err: {%v}", err)
+		}
+		s.log.Infof("(CreatedIndexes) indexes: {%v}", indexes)
+	}
+
+	// Create indexes on the "revisions" collection
+	{
+		indexes, err := s.mongoClient.Database(s.cfg.Mongo.Db).Collection(s.cfg.Mongo.Collections.Revision).Indexes().CreateMany(ctx, []mongo.IndexModel{
+			{
+				Keys:    bson.D{{"entity_type", 1}, {"entity_id", 1}, {"number", 1}},
+				Options: options.Index().SetUnique(true).SetName(fmt.Sprintf("%s.%s_unique_index", s.cfg.Mongo.Collections.Revision, "entity_number")),
+			},
+		})
+		if err != nil && !utils.CheckErrMessages(err, serviceErrors.ErrMsgAlreadyExists) {
+			s.log.Warnf("(CreateMany) err: {%v}", err)
+		}
+		s.log.Infof("(CreatedIndexes) indexes: {%v}", indexes)
+	}
+
+	// Create indexes on the "taxonomy" collection
+	{
+		indexes, err := s.mongoClient.Database(s.cfg.Mongo.Db).Collection(s.cfg.Mongo.Collections.Taxonomy).Indexes().CreateMany(ctx, []mongo.IndexModel{
+			{
+				Keys:    bson.D{{"parent_id", 1}, {"kind", 1}, {"position", 1}},
+				Options: options.Index().SetName(fmt.Sprintf("%s.%s_index", s.cfg.Mongo.Collections.Taxonomy, "parent_id_kind_position")),
+			},
+			{
+				Keys:    bson.D{{"ancestors", 1}},
+				Options: options.Index().SetName(fmt.Sprintf("%s.%s_index", s.cfg.Mongo.Collections.Taxonomy, "ancestors")),
+			},
+		})
+		if err != nil && !utils.CheckErrMessages(err, serviceErrors.ErrMsgAlreadyExists) {
+			s.log.Warnf("(CreateMany) err: {%v}", err)
+		}
+		s.log.Infof("(CreatedIndexes) indexes: {%v}", indexes)
+	}
+
+	// Create indexes on the "languages" collection
+	{
+		indexes, err := s.mongoClient.Database(s.cfg.Mongo.Db).Collection(s.cfg.Mongo.Collections.Language).Indexes().CreateMany(ctx, []mongo.IndexModel{
+			{
+				Keys:    bson.D{{"code", 1}},
+				Options: options.Index().SetUnique(true).SetName(fmt.Sprintf("%s.%s_unique_index", s.cfg.Mongo.Collections.Language, "code")),
+			},
+			{
+				Keys:    bson.D{{"name", 1}},
+				Options: options.Index().SetUnique(true).SetName(fmt.Sprintf("%s.%s_unique_index", s.cfg.Mongo.Collections.Language, "name")),
+			},
+		})
+		if err != nil && !utils.CheckErrMessages(err, serviceErrors.ErrMsgAlreadyExists) {
+			s.log.Warnf("(CreateMany) err: {%v}", err)
+		}
+		s.log.Infof("(CreatedIndexes) indexes: {%v}", indexes)
+	}
+
+	// Create indexes on the "components" collection
+	{
+		indexes, err := s.mongoClient.Database(s.cfg.Mongo.Db).Collection(s.cfg.Mongo.Collections.Component).Indexes().CreateMany(ctx, []mongo.IndexModel{
+			{
+				Keys:    bson.D{{"key", 1}},
+				Options: options.Index().SetUnique(true).SetName(fmt.Sprintf("%s.%s_unique_index", s.cfg.Mongo.Collections.Component, "key")),
+			},
+			{
+				Keys:    bson.D{{"name", 1}},
+				Options: options.Index().SetUnique(true).SetName(fmt.Sprintf("%s.%s_unique_index", s.cfg.Mongo.Collections.Component, "name")),
+			},
 		})
 		if err != nil && !utils.CheckErrMessages(err, serviceErrors.ErrMsgAlreadyExists) {
 			s.log.Warnf("(CreateMany) err: {%v}", err)
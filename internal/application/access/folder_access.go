@@ -0,0 +1,196 @@
+package access
+
+import (
+	"context"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/tenant"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// FolderAccess resolves the permission the current user holds on folders. Every folder
+// on the path from the root that carries grants narrows access to the users and roles
+// it names, so a folder is never more open than its parent. Folders without grants on
+// their path are open to the owning organization, and organization admins and
+// SuperAdmins always manage.
+type FolderAccess interface {
+	Permissions(ctx context.Context, folders []*models.Folder) (map[primitive.ObjectID]constants.FolderPermission, error)
+	Require(ctx context.Context, folder *models.Folder, permission constants.FolderPermission) error
+	Readable(ctx context.Context, folderIDs []primitive.ObjectID) (map[primitive.ObjectID]bool, error)
+	ReadableIDs(ctx context.Context) ([]primitive.ObjectID, bool, error)
+}
+
+type folderAccess struct {
+	log        zap.Logger
+	folderRepo repository.FolderRepository
+}
+
+func NewFolderAccess(log zap.Logger, folderRepo repository.FolderRepository) *folderAccess {
+	return &folderAccess{log: log, folderRepo: folderRepo}
+}
+
+func (a *folderAccess) Permissions(ctx context.Context, folders []*models.Folder) (map[primitive.ObjectID]constants.FolderPermission, error) {
+	scope := tenant.FromContext(ctx)
+	permissions := make(map[primitive.ObjectID]constants.FolderPermission, len(folders))
+	if scope.Unrestricted() {
+		for _, f := range folders {
+			permissions[f.ID] = constants.FolderPermissionManage
+		}
+		return permissions, nil
+	}
+
+	acls := make(map[primitive.ObjectID][]models.FolderGrant)
+	for _, f := range folders {
+		acls[f.ID] = f.ACL
+	}
+
+	missing := make([]primitive.ObjectID, 0)
+	for _, f := range folders {
+		for _, id := range f.Ancestors {
+			if _, ok := acls[id]; !ok {
+				acls[id] = nil
+				missing = append(missing, id)
+			}
+		}
+	}
+
+	if len(missing) > 0 {
+		ancestors, err := a.folderRepo.GetByIDs(ctx, missing)
+		if err != nil {
+			a.log.Errorf("(FolderAccess.Permissions) err: {%v}", err)
+			return nil, err
+		}
+
+		for _, f := range ancestors {
+			acls[f.ID] = f.ACL
+		}
+	}
+
+	for _, f := range folders {
+		permissions[f.ID] = resolve(scope, f, acls)
+	}
+
+	return permissions, nil
+}
+
+func (a *folderAccess) Require(ctx context.Context, folder *models.Folder, permission constants.FolderPermission) error {
+	permissions, err := a.Permissions(ctx, []*models.Folder{folder})
+	if err != nil {
+		return err
+	}
+
+	if !permissions[folder.ID].Includes(permission) {
+		return errors.Wrapf(httpPkg.Forbidden, "%s permission required on folder %s", permission, folder.ID.Hex())
+	}
+
+	return nil
+}
+
+// Readable reports which of the folders the current user can read. Folders outside
+// the user's organizations are not readable.
+func (a *folderAccess) Readable(ctx context.Context, folderIDs []primitive.ObjectID) (map[primitive.ObjectID]bool, error) {
+	readable := make(map[primitive.ObjectID]bool, len(folderIDs))
+	if len(folderIDs) == 0 {
+		return readable, nil
+	}
+
+	folders, err := a.folderRepo.GetByIDs(ctx, folderIDs)
+	if err != nil {
+		a.log.Errorf("(FolderAccess.Readable) err: {%v}", err)
+		return nil, err
+	}
+
+	permissions, err := a.Permissions(ctx, folders)
+	if err != nil {
+		return nil, err
+	}
+
+	for id, permission := range permissions {
+		readable[id] = permission.Includes(constants.FolderPermissionRead)
+	}
+
+	return readable, nil
+}
+
+// ReadableIDs returns the ids of the folders the current user can read, for listings to
+// filter on before paging. It reports false, and no ids, when grants hide none of the
+// folders of the user's organizations and there is nothing to filter on.
+func (a *folderAccess) ReadableIDs(ctx context.Context) ([]primitive.ObjectID, bool, error) {
+	if tenant.FromContext(ctx).Unrestricted() {
+		return nil, false, nil
+	}
+
+	folders, err := a.folderRepo.GetAllInScope(ctx)
+	if err != nil {
+		a.log.Errorf("(FolderAccess.ReadableIDs) err: {%v}", err)
+		return nil, false, err
+	}
+
+	permissions, err := a.Permissions(ctx, folders)
+	if err != nil {
+		return nil, false, err
+	}
+
+	ids := make([]primitive.ObjectID, 0, len(folders))
+	for _, f := range folders {
+		if permissions[f.ID].Includes(constants.FolderPermissionRead) {
+			ids = append(ids, f.ID)
+		}
+	}
+
+	if len(ids) == len(folders) {
+		return nil, false, nil
+	}
+
+	return ids, true, nil
+}
+
+func resolve(scope tenant.Scope, folder *models.Folder, acls map[primitive.ObjectID][]models.FolderGrant) constants.FolderPermission {
+	if scope.IsOrganizationAdmin(folder.OrganizationID) {
+		return constants.FolderPermissionManage
+	}
+
+	restricted := false
+	permission := constants.FolderPermissionManage
+	for _, id := range folder.PathFromRoot() {
+		acl := acls[id]
+		if len(acl) == 0 {
+			continue
+		}
+
+		restricted = true
+		if held := held(scope, acl); held.Level() < permission.Level() {
+			permission = held
+		}
+	}
+
+	if !restricted {
+		permission = constants.FolderPermissionWrite
+	}
+
+	// Content of another organization, or global content, is read only
+	if !scope.CanWrite(folder.OrganizationID) && permission.Includes(constants.FolderPermissionRead) {
+		permission = constants.FolderPermissionRead
+	}
+
+	return permission
+}
+
+// held returns the highest permission the ACL grants to the user or one of their roles
+func held(scope tenant.Scope, acl []models.FolderGrant) constants.FolderPermission {
+	var permission constants.FolderPermission
+	for _, grant := range acl {
+		matches := (grant.UserID != "" && grant.UserID == scope.UserID()) ||
+			(grant.Role != "" && scope.HasRole(grant.Role))
+		if matches && grant.Permission.Level() > permission.Level() {
+			permission = grant.Permission
+		}
+	}
+
+	return permission
+}
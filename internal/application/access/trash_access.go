@@ -0,0 +1,126 @@
+package access
+
+import (
+	"context"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/tenant"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// TrashAccess decides which trash items the current user can see, restore and purge. A
+// folder takes write permission on the folder itself, a cluster write permission on its
+// folder as long as that folder is not in the trash as well, and topics are left to the
+// SuperAdmin.
+type TrashAccess interface {
+	Folders(ctx context.Context, folders []*models.Folder) ([]*models.Folder, error)
+	Clusters(ctx context.Context, clusters []*models.Cluster) ([]*models.Cluster, error)
+	RequireFolder(ctx context.Context, folder *models.Folder) error
+	RequireCluster(ctx context.Context, cluster *models.Cluster) error
+	RequireTopic(ctx context.Context) error
+}
+
+type trashAccess struct {
+	log          zap.Logger
+	folderRepo   repository.FolderRepository
+	folderAccess FolderAccess
+}
+
+func NewTrashAccess(log zap.Logger, folderRepo repository.FolderRepository, folderAccess FolderAccess) *trashAccess {
+	return &trashAccess{log: log, folderRepo: folderRepo, folderAccess: folderAccess}
+}
+
+func (a *trashAccess) Folders(ctx context.Context, folders []*models.Folder) ([]*models.Folder, error) {
+	permissions, err := a.folderAccess.Permissions(ctx, folders)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := make([]*models.Folder, 0, len(folders))
+	for _, f := range folders {
+		if permissions[f.ID].Includes(constants.FolderPermissionWrite) {
+			allowed = append(allowed, f)
+		}
+	}
+
+	return allowed, nil
+}
+
+func (a *trashAccess) Clusters(ctx context.Context, clusters []*models.Cluster) ([]*models.Cluster, error) {
+	folderIDs := make([]primitive.ObjectID, 0, len(clusters))
+	for _, c := range clusters {
+		if !c.FolderID.IsZero() {
+			folderIDs = append(folderIDs, c.FolderID)
+		}
+	}
+
+	writable, err := a.writableFolders(ctx, folderIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := make([]*models.Cluster, 0, len(clusters))
+	for _, c := range clusters {
+		if canWrite, live := writable[c.FolderID]; canWrite || !live {
+			allowed = append(allowed, c)
+		}
+	}
+
+	return allowed, nil
+}
+
+func (a *trashAccess) RequireFolder(ctx context.Context, folder *models.Folder) error {
+	return a.folderAccess.Require(ctx, folder, constants.FolderPermissionWrite)
+}
+
+func (a *trashAccess) RequireCluster(ctx context.Context, cluster *models.Cluster) error {
+	if cluster.FolderID.IsZero() {
+		return nil
+	}
+
+	writable, err := a.writableFolders(ctx, []primitive.ObjectID{cluster.FolderID})
+	if err != nil {
+		return err
+	}
+
+	if canWrite, live := writable[cluster.FolderID]; live && !canWrite {
+		return errors.Wrapf(httpPkg.Forbidden, "write permission required on folder %s", cluster.FolderID.Hex())
+	}
+
+	return nil
+}
+
+func (a *trashAccess) RequireTopic(ctx context.Context) error {
+	if !tenant.FromContext(ctx).IsSuperAdmin() {
+		return errors.Wrap(httpPkg.Forbidden, "topics in the trash are managed by the SuperAdmin")
+	}
+
+	return nil
+}
+
+// writableFolders reports for each folder that is not in the trash whether the current
+// user can write to it. Folders in the trash are left out of the map.
+func (a *trashAccess) writableFolders(ctx context.Context, folderIDs []primitive.ObjectID) (map[primitive.ObjectID]bool, error) {
+	folders, err := a.folderRepo.GetByIDs(ctx, folderIDs)
+	if err != nil {
+		a.log.Errorf("(TrashAccess.writableFolders) err: {%v}", err)
+		return nil, err
+	}
+
+	permissions, err := a.folderAccess.Permissions(ctx, folders)
+	if err != nil {
+		return nil, err
+	}
+
+	writable := make(map[primitive.ObjectID]bool, len(folders))
+	for _, f := range folders {
+		writable[f.ID] = permissions[f.ID].Includes(constants.FolderPermissionWrite)
+	}
+
+	return writable, nil
+}
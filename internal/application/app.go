@@ -11,6 +11,7 @@ import (
 // App holds the application configuration and services
 type App struct {
 	logger zap.Logger
+	cfg    *config.Config
 	server server.Server
 }
 
@@ -38,6 +39,7 @@ func New(configPath string) (*App, error) {
 
 	return &App{
 		logger: logger,
+		cfg:    c,
 		server: s,
 	}, nil
 }
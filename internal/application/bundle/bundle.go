@@ -0,0 +1,90 @@
+package bundle
+
+import (
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/pkg/constants"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// Format tells a gallery bundle apart from any other JSON document
+	Format = "gallery-bundle"
+	// Version is bumped whenever the layout of a bundle changes in a way older readers
+	// cannot follow
+	Version = 1
+)
+
+// Manifest describes a bundle. The root folder is stored without a parent, the other
+// folders keep their place below it.
+type Manifest struct {
+	Format        string    `json:"format"`
+	Version       int       `json:"version"`
+	ExportedAt    time.Time `json:"exported_at"`
+	RootFolderID  string    `json:"root_folder_id"`
+	Folders       int       `json:"folders"`
+	Clusters      int       `json:"clusters"`
+	Topics        int       `json:"topics"`
+	TaxonomyTerms int       `json:"taxonomy_terms"`
+}
+
+// Bundle is a folder subtree with its clusters and the topics exported along with it.
+// Organization, access grants and trash state belong to the environment the content
+// came from and are left out.
+type Bundle struct {
+	Manifest      Manifest          `json:"manifest"`
+	Folders       []*models.Folder  `json:"folders"`
+	Clusters      []*models.Cluster `json:"clusters"`
+	Topics        []*models.Topic   `json:"topics"`
+	TaxonomyTerms []TermRef         `json:"taxonomy_terms"`
+}
+
+// TermRef names a taxonomy term the content is classified with. Ids differ between
+// environments, so an import falls back to the kind and the names from the root down.
+type TermRef struct {
+	ID   primitive.ObjectID     `json:"id"`
+	Kind constants.TaxonomyKind `json:"kind"`
+	Path []string               `json:"path"`
+}
+
+// New wraps the exported content in a bundle with its manifest
+func New(
+	rootFolderID primitive.ObjectID,
+	folders []*models.Folder,
+	clusters []*models.Cluster,
+	topics []*models.Topic,
+	terms []TermRef,
+) *Bundle {
+	return &Bundle{
+		Manifest: Manifest{
+			Format:        Format,
+			Version:       Version,
+			ExportedAt:    time.Now(),
+			RootFolderID:  rootFolderID.Hex(),
+			Folders:       len(folders),
+			Clusters:      len(clusters),
+			Topics:        len(topics),
+			TaxonomyTerms: len(terms),
+		},
+		Folders:       folders,
+		Clusters:      clusters,
+		Topics:        topics,
+		TaxonomyTerms: terms,
+	}
+}
+
+// Key identifies the term across environments
+func (t TermRef) Key() string {
+	return TermKey(t.Kind, t.Path)
+}
+
+// TermKey joins the kind and the path of a term into the key TermRef.Key matches against
+func TermKey(kind constants.TaxonomyKind, path []string) string {
+	key := kind.String()
+	for _, name := range path {
+		key += "\x00" + name
+	}
+
+	return key
+}
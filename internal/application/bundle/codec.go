@@ -0,0 +1,175 @@
+package bundle
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"gallery-service/internal/pkg/constants"
+	httpPkg "gallery-service/pkg/http"
+	"io"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// The files of a ZIP bundle
+const (
+	manifestFile      = "manifest.json"
+	foldersFile       = "folders.json"
+	clustersFile      = "clusters.json"
+	topicsFile        = "topics.json"
+	taxonomyTermsFile = "taxonomy_terms.json"
+)
+
+var zipSignature = []byte("PK\x03\x04")
+
+// Encode writes the bundle to w as a single JSON document or as a ZIP archive
+func Encode(w io.Writer, b *Bundle, encoding constants.BundleEncoding) error {
+	if encoding != constants.BundleEncodingZIP {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return errors.Wrap(enc.Encode(b), "json.Encode")
+	}
+
+	archive := zip.NewWriter(w)
+	files := []struct {
+		name    string
+		content interface{}
+	}{
+		{manifestFile, b.Manifest},
+		{foldersFile, b.Folders},
+		{clustersFile, b.Clusters},
+		{topicsFile, b.Topics},
+		{taxonomyTermsFile, b.TaxonomyTerms},
+	}
+	for _, f := range files {
+		fw, err := archive.Create(f.name)
+		if err != nil {
+			return errors.Wrap(err, "zip.Create")
+		}
+
+		enc := json.NewEncoder(fw)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(f.content); err != nil {
+			return errors.Wrapf(err, "json.Encode %s", f.name)
+		}
+	}
+
+	return errors.Wrap(archive.Close(), "zip.Close")
+}
+
+// Decode reads a bundle written by Encode in either encoding and checks that it holds
+// together: a known format and version, and every folder, cluster and filed topic placed
+// below the root folder
+func Decode(data []byte) (*Bundle, error) {
+	var b Bundle
+	if bytes.HasPrefix(data, zipSignature) {
+		if err := decodeZIP(data, &b); err != nil {
+			return nil, err
+		}
+	} else if err := json.Unmarshal(data, &b); err != nil {
+		return nil, errors.Wrapf(httpPkg.BadRequest, "invalid bundle: %v", err)
+	}
+
+	if err := b.validate(); err != nil {
+		return nil, err
+	}
+
+	return &b, nil
+}
+
+func decodeZIP(data []byte, b *Bundle) error {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return errors.Wrapf(httpPkg.BadRequest, "invalid bundle archive: %v", err)
+	}
+
+	targets := map[string]interface{}{
+		manifestFile:      &b.Manifest,
+		foldersFile:       &b.Folders,
+		clustersFile:      &b.Clusters,
+		topicsFile:        &b.Topics,
+		taxonomyTermsFile: &b.TaxonomyTerms,
+	}
+	for _, f := range archive.File {
+		target, ok := targets[f.Name]
+		if !ok {
+			continue
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return errors.Wrapf(httpPkg.BadRequest, "invalid bundle archive: %v", err)
+		}
+		err = json.NewDecoder(rc).Decode(target)
+		_ = rc.Close()
+		if err != nil {
+			return errors.Wrapf(httpPkg.BadRequest, "invalid %s in bundle: %v", f.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (b *Bundle) validate() error {
+	if b.Manifest.Format != Format {
+		return errors.Wrap(httpPkg.BadRequest, "not a gallery bundle")
+	}
+
+	if b.Manifest.Version < 1 || b.Manifest.Version > Version {
+		return errors.Wrapf(httpPkg.BadRequest, "unsupported bundle version %d", b.Manifest.Version)
+	}
+
+	rootID, err := primitive.ObjectIDFromHex(b.Manifest.RootFolderID)
+	if err != nil {
+		return errors.Wrap(httpPkg.BadRequest, "invalid root folder in bundle manifest")
+	}
+
+	parents := make(map[primitive.ObjectID]*primitive.ObjectID, len(b.Folders))
+	for _, f := range b.Folders {
+		if f == nil {
+			return errors.Wrap(httpPkg.BadRequest, "empty folder in bundle")
+		}
+		if _, ok := parents[f.ID]; ok {
+			return errors.Wrapf(httpPkg.BadRequest, "folder %s appears twice in the bundle", f.ID.Hex())
+		}
+		parents[f.ID] = f.ParentID
+	}
+
+	if _, ok := parents[rootID]; !ok {
+		return errors.Wrap(httpPkg.BadRequest, "root folder missing from bundle")
+	}
+
+	// Every folder must reach the root through folders of the bundle without going round
+	// in circles
+	for _, f := range b.Folders {
+		id := f.ID
+		for steps := 0; id != rootID; steps++ {
+			parentID, ok := parents[id]
+			if !ok || parentID == nil || steps == len(b.Folders) {
+				return errors.Wrapf(httpPkg.BadRequest, "folder %s of the bundle is not below its root folder", f.ID.Hex())
+			}
+			id = *parentID
+		}
+	}
+
+	for _, c := range b.Clusters {
+		if c == nil {
+			return errors.Wrap(httpPkg.BadRequest, "empty cluster in bundle")
+		}
+		if _, ok := parents[c.FolderID]; !ok {
+			return errors.Wrapf(httpPkg.BadRequest, "cluster %s of the bundle is not in one of its folders", c.ID.Hex())
+		}
+	}
+
+	for _, t := range b.Topics {
+		if t == nil {
+			return errors.Wrap(httpPkg.BadRequest, "empty topic in bundle")
+		}
+		if _, ok := parents[t.FolderID]; !ok && !t.FolderID.IsZero() {
+			return errors.Wrapf(httpPkg.BadRequest, "topic %s of the bundle is not in one of its folders", t.ID.Hex())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,221 @@
+package bundle
+
+import (
+	"bytes"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/pkg/constants"
+	httpPkg "gallery-service/pkg/http"
+	"testing"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// sample is a root folder with a subfolder, a cluster in each, a topic filed in the
+// subfolder and one outside the folder tree
+func sample() *Bundle {
+	root := &models.Folder{ID: primitive.NewObjectID(), FolderName: "root"}
+	child := &models.Folder{ID: primitive.NewObjectID(), FolderName: "child", ParentID: &root.ID, Position: 1}
+
+	return New(
+		root.ID,
+		[]*models.Folder{root, child},
+		[]*models.Cluster{
+			{ID: primitive.NewObjectID(), ClusterName: "in root", FolderID: root.ID},
+			{ID: primitive.NewObjectID(), ClusterName: "in child", FolderID: child.ID, Position: 2},
+		},
+		[]*models.Topic{
+			{ID: primitive.NewObjectID(), TopicName: "filed", FolderID: child.ID},
+			{ID: primitive.NewObjectID(), TopicName: "unfiled"},
+		},
+		[]TermRef{{ID: primitive.NewObjectID(), Kind: constants.TaxonomyKindSubject, Path: []string{"a", "b"}}},
+	)
+}
+
+func encode(t *testing.T, b *Bundle, encoding constants.BundleEncoding) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := Encode(&buf, b, encoding); err != nil {
+		t.Fatalf("Encode(%s) err: %v", encoding, err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestDecodeRejectsMalformedBundles(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(b *Bundle)
+	}{
+		{
+			name:   "wrong format",
+			change: func(b *Bundle) { b.Manifest.Format = "something-else" },
+		},
+		{
+			name:   "version too old",
+			change: func(b *Bundle) { b.Manifest.Version = 0 },
+		},
+		{
+			name:   "version too new",
+			change: func(b *Bundle) { b.Manifest.Version = Version + 1 },
+		},
+		{
+			name:   "invalid root id",
+			change: func(b *Bundle) { b.Manifest.RootFolderID = "root" },
+		},
+		{
+			name:   "missing root",
+			change: func(b *Bundle) { b.Folders = b.Folders[1:] },
+		},
+		{
+			name:   "root id not in folders",
+			change: func(b *Bundle) { b.Manifest.RootFolderID = primitive.NewObjectID().Hex() },
+		},
+		{
+			name:   "duplicate folder",
+			change: func(b *Bundle) { b.Folders = append(b.Folders, b.Folders[1]) },
+		},
+		{
+			name:   "empty folder",
+			change: func(b *Bundle) { b.Folders = append(b.Folders, nil) },
+		},
+		{
+			name: "parent cycle",
+			change: func(b *Bundle) {
+				a := &models.Folder{ID: primitive.NewObjectID()}
+				c := &models.Folder{ID: primitive.NewObjectID(), ParentID: &a.ID}
+				a.ParentID = &c.ID
+				b.Folders = append(b.Folders, a, c)
+			},
+		},
+		{
+			name: "folder pointing at itself",
+			change: func(b *Bundle) {
+				f := &models.Folder{ID: primitive.NewObjectID()}
+				f.ParentID = &f.ID
+				b.Folders = append(b.Folders, f)
+			},
+		},
+		{
+			name: "folder outside the bundle",
+			change: func(b *Bundle) {
+				parentID := primitive.NewObjectID()
+				b.Folders = append(b.Folders, &models.Folder{ID: primitive.NewObjectID(), ParentID: &parentID})
+			},
+		},
+		{
+			name:   "second root",
+			change: func(b *Bundle) { b.Folders = append(b.Folders, &models.Folder{ID: primitive.NewObjectID()}) },
+		},
+		{
+			name:   "orphaned cluster",
+			change: func(b *Bundle) { b.Clusters[1].FolderID = primitive.NewObjectID() },
+		},
+		{
+			name:   "cluster without folder",
+			change: func(b *Bundle) { b.Clusters[1].FolderID = primitive.NilObjectID },
+		},
+		{
+			name:   "empty cluster",
+			change: func(b *Bundle) { b.Clusters = append(b.Clusters, nil) },
+		},
+		{
+			name:   "orphaned topic",
+			change: func(b *Bundle) { b.Topics[0].FolderID = primitive.NewObjectID() },
+		},
+		{
+			name:   "empty topic",
+			change: func(b *Bundle) { b.Topics = append(b.Topics, nil) },
+		},
+	}
+
+	for _, tt := range tests {
+		for _, encoding := range []constants.BundleEncoding{constants.BundleEncodingJSON, constants.BundleEncodingZIP} {
+			t.Run(tt.name+"/"+encoding.String(), func(t *testing.T) {
+				b := sample()
+				tt.change(b)
+
+				got, err := Decode(encode(t, b, encoding))
+				if !errors.Is(err, httpPkg.BadRequest) {
+					t.Fatalf("Decode() = %+v, %v, want a bad request", got, err)
+				}
+			})
+		}
+	}
+}
+
+func TestDecodeRejectsUnreadableData(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "empty", data: nil},
+		{name: "not JSON", data: []byte("gallery")},
+		{name: "JSON of the wrong shape", data: []byte(`{"folders": {}}`)},
+		{name: "broken archive", data: append([]byte("PK\x03\x04"), "gallery"...)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Decode(tt.data)
+			if !errors.Is(err, httpPkg.BadRequest) {
+				t.Fatalf("Decode(%q) = %+v, %v, want a bad request", tt.data, got, err)
+			}
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, encoding := range []constants.BundleEncoding{constants.BundleEncodingJSON, constants.BundleEncodingZIP} {
+		t.Run(encoding.String(), func(t *testing.T) {
+			want := sample()
+
+			got, err := Decode(encode(t, want, encoding))
+			if err != nil {
+				t.Fatalf("Decode() err: %v", err)
+			}
+
+			if got.Manifest.RootFolderID != want.Manifest.RootFolderID || got.Manifest.Folders != 2 || got.Manifest.Clusters != 2 || got.Manifest.Topics != 2 {
+				t.Errorf("manifest = %+v, want %+v", got.Manifest, want.Manifest)
+			}
+
+			if len(got.Folders) != len(want.Folders) {
+				t.Fatalf("folders = %d, want %d", len(got.Folders), len(want.Folders))
+			}
+			for i, f := range got.Folders {
+				w := want.Folders[i]
+				if f.ID != w.ID || f.FolderName != w.FolderName || f.Position != w.Position || (f.ParentID == nil) != (w.ParentID == nil) {
+					t.Errorf("folder %d = %+v, want %+v", i, f, w)
+				}
+			}
+			if *got.Folders[1].ParentID != *want.Folders[1].ParentID {
+				t.Errorf("parent = %s, want %s", got.Folders[1].ParentID.Hex(), want.Folders[1].ParentID.Hex())
+			}
+
+			if len(got.Clusters) != len(want.Clusters) {
+				t.Fatalf("clusters = %d, want %d", len(got.Clusters), len(want.Clusters))
+			}
+			for i, c := range got.Clusters {
+				w := want.Clusters[i]
+				if c.ID != w.ID || c.ClusterName != w.ClusterName || c.FolderID != w.FolderID || c.Position != w.Position {
+					t.Errorf("cluster %d = %+v, want %+v", i, c, w)
+				}
+			}
+
+			if len(got.Topics) != len(want.Topics) {
+				t.Fatalf("topics = %d, want %d", len(got.Topics), len(want.Topics))
+			}
+			for i, topic := range got.Topics {
+				w := want.Topics[i]
+				if topic.ID != w.ID || topic.TopicName != w.TopicName || topic.FolderID != w.FolderID {
+					t.Errorf("topic %d = %+v, want %+v", i, topic, w)
+				}
+			}
+
+			if len(got.TaxonomyTerms) != 1 || got.TaxonomyTerms[0].Key() != want.TaxonomyTerms[0].Key() {
+				t.Errorf("terms = %+v, want %+v", got.TaxonomyTerms, want.TaxonomyTerms)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package bundle
+
+import (
+	"context"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// TermRefs describes the taxonomy terms with termIDs by their kind and path of names.
+// Terms that no longer exist are left out.
+func TermRefs(ctx context.Context, taxonomyRepo repository.TaxonomyRepository, termIDs []primitive.ObjectID) ([]TermRef, error) {
+	if len(termIDs) == 0 {
+		return []TermRef{}, nil
+	}
+
+	terms, err := taxonomyRepo.GetByIDs(ctx, termIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	ancestorIDs := make([]primitive.ObjectID, 0)
+	seen := make(map[primitive.ObjectID]bool)
+	for _, t := range terms {
+		for _, id := range t.Ancestors {
+			if !seen[id] {
+				seen[id] = true
+				ancestorIDs = append(ancestorIDs, id)
+			}
+		}
+	}
+
+	ancestors, err := taxonomyRepo.GetByIDs(ctx, ancestorIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	names := termNames(append(ancestors, terms...))
+
+	refs := make([]TermRef, 0, len(terms))
+	for _, t := range terms {
+		refs = append(refs, TermRef{ID: t.ID, Kind: t.Kind, Path: termPath(t, names)})
+	}
+
+	return refs, nil
+}
+
+// ResolveTerms maps the terms of a bundle onto the taxonomy of this environment. A term
+// keeps its id when that id names the same term here, otherwise it is looked up by kind
+// and path. The terms found neither way are returned as missing.
+func ResolveTerms(ctx context.Context, taxonomyRepo repository.TaxonomyRepository, refs []TermRef) (map[primitive.ObjectID]primitive.ObjectID, []TermRef, error) {
+	resolved := make(map[primitive.ObjectID]primitive.ObjectID, len(refs))
+	missing := make([]TermRef, 0)
+	if len(refs) == 0 {
+		return resolved, missing, nil
+	}
+
+	terms, err := taxonomyRepo.GetAll(ctx, "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	names := termNames(terms)
+	byID := make(map[primitive.ObjectID]string, len(terms))
+	byKey := make(map[string]primitive.ObjectID, len(terms))
+	for _, t := range terms {
+		key := TermKey(t.Kind, termPath(t, names))
+		byID[t.ID] = key
+		byKey[key] = t.ID
+	}
+
+	for _, ref := range refs {
+		if byID[ref.ID] == ref.Key() {
+			resolved[ref.ID] = ref.ID
+		} else if id, ok := byKey[ref.Key()]; ok {
+			resolved[ref.ID] = id
+		} else {
+			missing = append(missing, ref)
+		}
+	}
+
+	return resolved, missing, nil
+}
+
+func termNames(terms []*models.TaxonomyTerm) map[primitive.ObjectID]string {
+	names := make(map[primitive.ObjectID]string, len(terms))
+	for _, t := range terms {
+		names[t.ID] = t.Name
+	}
+
+	return names
+}
+
+func termPath(t *models.TaxonomyTerm, names map[primitive.ObjectID]string) []string {
+	path := make([]string, 0, len(t.Ancestors)+1)
+	for _, id := range t.Ancestors {
+		path = append(path, names[id])
+	}
+
+	return append(path, t.Name)
+}
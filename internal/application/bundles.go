@@ -0,0 +1,135 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/application/bundle"
+	folderCommands "gallery-service/internal/application/commands/v1/folder"
+	folderResponses "gallery-service/internal/application/dto/responses/folder"
+	"gallery-service/internal/application/queries/folder"
+	"gallery-service/internal/infrastructure/database/mongo/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/pkg/mongodb"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ExportFolder writes a folder subtree, its clusters and the given topics to a bundle
+// file, named after the folder when output is empty
+func (a *App) ExportFolder(folderID string, topicIDs []string, output string, encoding constants.BundleEncoding) error {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
+	defer cancel()
+
+	if encoding != constants.BundleEncodingJSON && encoding != constants.BundleEncodingZIP {
+		return errors.Errorf("unknown bundle format %s", encoding)
+	}
+
+	if _, err := primitive.ObjectIDFromHex(folderID); err != nil {
+		return errors.Wrap(err, "invalid folder id")
+	}
+
+	if output == "" {
+		output = fmt.Sprintf("folder-%s.%s", folderID, encoding)
+	}
+
+	mongoDBClient := mongodb.NewMongoDBConn(ctx, a.logger, a.cfg.Mongo)
+	defer mongoDBClient.Close()
+
+	db := mongoDBClient.GetClient()
+	folderRepo := repository.NewFolderRepository(a.logger, a.cfg, db)
+	handler := folder.NewExportFolderHandler(
+		a.logger,
+		folderRepo,
+		access.NewFolderAccess(a.logger, folderRepo),
+		repository.NewClusterRepository(a.logger, a.cfg, db),
+		repository.NewTopicRepository(a.logger, a.cfg, db),
+		repository.NewTaxonomyRepository(a.logger, a.cfg, db),
+	)
+
+	b, err := handler.Handle(ctx, folder.NewExportFolderQuery(folderID, topicIDs))
+	if err != nil {
+		a.logger.Errorf("(ExportFolder) err: {%v}", err)
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := bundle.Encode(&buf, b, encoding); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(output, buf.Bytes(), 0o644); err != nil {
+		return errors.Wrap(err, "os.WriteFile")
+	}
+
+	a.logger.Infof("(ExportFolder) output: {%s}, folders: {%d}, clusters: {%d}, topics: {%d}, taxonomy terms: {%d}",
+		output, b.Manifest.Folders, b.Manifest.Clusters, b.Manifest.Topics, b.Manifest.TaxonomyTerms)
+
+	return nil
+}
+
+// ImportFolder reads a bundle file written by ExportFolder into the gallery under
+// parentID, or reports what it would change when dryRun is set. The CLI runs without a
+// user, so the imported content is global.
+func (a *App) ImportFolder(input string, parentID string, onConflict constants.BundleConflict, dryRun bool) error {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
+	defer cancel()
+
+	if onConflict != constants.BundleConflictSkip && onConflict != constants.BundleConflictOverwrite {
+		return errors.Errorf("unknown conflict mode %s", onConflict)
+	}
+
+	data, err := os.ReadFile(input)
+	if err != nil {
+		return errors.Wrap(err, "os.ReadFile")
+	}
+
+	b, err := bundle.Decode(data)
+	if err != nil {
+		return err
+	}
+
+	mongoDBClient := mongodb.NewMongoDBConn(ctx, a.logger, a.cfg.Mongo)
+	defer mongoDBClient.Close()
+
+	db := mongoDBClient.GetClient()
+	folderRepo := repository.NewFolderRepository(a.logger, a.cfg, db)
+	handler := folderCommands.NewImportFolderHandler(
+		a.logger,
+		folderRepo,
+		access.NewFolderAccess(a.logger, folderRepo),
+		repository.NewClusterRepository(a.logger, a.cfg, db),
+		repository.NewTopicRepository(a.logger, a.cfg, db),
+		repository.NewTaxonomyRepository(a.logger, a.cfg, db),
+		repository.NewTransactionManager(a.logger, db),
+	)
+
+	res, err := handler.Handle(ctx, folderCommands.NewImportFolderCommand(b, parentID, onConflict, dryRun))
+	if err != nil {
+		a.logger.Errorf("(ImportFolder) err: {%v}", err)
+		return err
+	}
+
+	logEntries := func(kind string, entries []folderResponses.ImportEntryDto) {
+		for _, e := range entries {
+			a.logger.Infof("(ImportFolder) %s: {%s}, name: {%s}, action: {%s}, target: {%s}", kind, e.SourceID, e.Name, e.Action, e.TargetID)
+		}
+	}
+	logEntries("folder", res.Folders)
+	logEntries("cluster", res.Clusters)
+	logEntries("topic", res.Topics)
+
+	for _, w := range res.Warnings {
+		a.logger.Warnf("(ImportFolder) %s", w)
+	}
+
+	a.logger.Infof("(ImportFolder) dry run: {%t}, folder: {%s}, folders: {%d}, clusters: {%d}, topics: {%d}",
+		res.DryRun, res.FolderID, len(res.Folders), len(res.Clusters), len(res.Topics))
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package classification
+
+import (
+	"context"
+	"gallery-service/internal/domain/repository"
+	httpPkg "gallery-service/pkg/http"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Subtrees resolves the taxonomy terms a listing is filtered by into the ids each of them
+// stands for: the term itself and every term below it, so that filtering by Math also
+// returns the content classified under Math > Counting. Content matches the filter when
+// it is classified under every one of the returned subtrees.
+func Subtrees(ctx context.Context, taxonomyRepo repository.TaxonomyRepository, termIDs []string) ([][]primitive.ObjectID, error) {
+	if len(termIDs) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]primitive.ObjectID, 0, len(termIDs))
+	for _, termID := range termIDs {
+		id, err := primitive.ObjectIDFromHex(termID)
+		if err != nil {
+			return nil, errors.Wrapf(httpPkg.BadRequest, "invalid taxonomy term %s", termID)
+		}
+		ids = append(ids, id)
+	}
+
+	found, err := taxonomyRepo.GetSubtreeIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	subtrees := make([][]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		subtree, ok := found[id]
+		if !ok {
+			return nil, errors.Errorf("taxonomy term %s not found", id.Hex())
+		}
+		subtrees = append(subtrees, subtree)
+	}
+
+	return subtrees, nil
+}
@@ -0,0 +1,14 @@
+package cluster
+
+// AddClusterTermCommand classifies a cluster with a taxonomy term
+type AddClusterTermCommand struct {
+	ID     string `json:"id" validate:"required"`
+	TermID string `json:"term_id" validate:"required"`
+}
+
+func NewAddClusterTermCommand(id string, termID string) *AddClusterTermCommand {
+	return &AddClusterTermCommand{
+		ID:     id,
+		TermID: termID,
+	}
+}
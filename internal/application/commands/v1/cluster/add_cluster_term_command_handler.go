@@ -0,0 +1,57 @@
+package cluster
+
+import (
+	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+)
+
+type AddClusterTermCommandHandler interface {
+	Handle(ctx context.Context, command *AddClusterTermCommand) (bool, error)
+}
+
+type addClusterTermHandler struct {
+	log          zap.Logger
+	clusterRepo  repository.ClusterRepository
+	folderRepo   repository.FolderRepository
+	taxonomyRepo repository.TaxonomyRepository
+	folderAccess access.FolderAccess
+}
+
+func NewAddClusterTermHandler(
+	log zap.Logger,
+	clusterRepo repository.ClusterRepository,
+	folderRepo repository.FolderRepository,
+	taxonomyRepo repository.TaxonomyRepository,
+	folderAccess access.FolderAccess,
+) *addClusterTermHandler {
+	return &addClusterTermHandler{
+		log:          log,
+		clusterRepo:  clusterRepo,
+		folderRepo:   folderRepo,
+		taxonomyRepo: taxonomyRepo,
+		folderAccess: folderAccess,
+	}
+}
+
+// Handle reports whether the cluster was not classified with the term already
+func (u *addClusterTermHandler) Handle(ctx context.Context, command *AddClusterTermCommand) (bool, error) {
+	cluster, err := u.clusterRepo.GetByID(ctx, command.ID)
+	if err != nil {
+		return false, err
+	}
+
+	if err := requireClusterWrite(ctx, u.folderRepo, u.folderAccess, cluster); err != nil {
+		return false, err
+	}
+
+	term, err := u.taxonomyRepo.GetByID(ctx, command.TermID)
+	if err != nil {
+		return false, errors.Errorf("taxonomy term %s not found", command.TermID)
+	}
+
+	return u.clusterRepo.AddTaxonomyTerm(ctx, cluster.ID, term.ID)
+}
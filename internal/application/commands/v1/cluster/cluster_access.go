@@ -0,0 +1,25 @@
+package cluster
+
+import (
+	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+
+	"github.com/pkg/errors"
+)
+
+// requireClusterWrite checks that the current user can write to the folder of cluster
+func requireClusterWrite(ctx context.Context, folderRepo repository.FolderRepository, folderAccess access.FolderAccess, cluster *models.Cluster) error {
+	if cluster.FolderID.IsZero() {
+		return nil
+	}
+
+	folder, err := folderRepo.GetByID(ctx, cluster.FolderID.Hex())
+	if err != nil {
+		return errors.New("folder not found")
+	}
+
+	return folderAccess.Require(ctx, folder, constants.FolderPermissionWrite)
+}
@@ -0,0 +1,32 @@
+package cluster
+
+import (
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/pkg/timecode"
+	httpPkg "gallery-service/pkg/http"
+
+	"github.com/pkg/errors"
+)
+
+// validateTimecodes checks that every video and audio of the languages ends after it starts
+func validateTimecodes(configs []models.LanguageConfig) error {
+	for _, config := range configs {
+		if err := validateLanguageTimecodes(config); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateLanguageTimecodes(config models.LanguageConfig) error {
+	if err := timecode.CheckRange(config.Video.StartTime, config.Video.EndTime); err != nil {
+		return errors.Wrapf(httpPkg.BadRequest, "language %s video: %v", config.Language, err)
+	}
+
+	if err := timecode.CheckRange(config.Audio.StartTime, config.Audio.EndTime); err != nil {
+		return errors.Wrapf(httpPkg.BadRequest, "language %s audio: %v", config.Language, err)
+	}
+
+	return nil
+}
@@ -11,6 +11,7 @@ type CreateClusterCommand struct {
 	Image          models.ImageConfig
 	LanguageConfig []models.LanguageConfig
 	FolderID       string
+	Tags           []string
 }
 
 func NewCreateClusterCommand(
@@ -20,6 +21,7 @@ func NewCreateClusterCommand(
 	image models.ImageConfig,
 	languageConfig []models.LanguageConfig,
 	folderID string,
+	tags []string,
 ) *CreateClusterCommand {
 	return &CreateClusterCommand{
 		ClusterName:    clusterName,
@@ -28,5 +30,6 @@ func NewCreateClusterCommand(
 		Image:          image,
 		LanguageConfig: languageConfig,
 		FolderID:       folderID,
+		Tags:           tags,
 	}
 }
@@ -2,12 +2,16 @@ package cluster
 
 import (
 	"context"
+	"gallery-service/internal/application/access"
 	"gallery-service/internal/domain/models"
 	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/tags"
+	"gallery-service/internal/pkg/tenant"
+	httpPkg "gallery-service/pkg/http"
 	"gallery-service/pkg/kafka"
 	"gallery-service/pkg/zap"
 	"github.com/pkg/errors"
-	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"time"
 )
@@ -17,10 +21,11 @@ type CreateClusterCommandHandler interface {
 }
 
 type createClusterHandler struct {
-	cfg         kafka.Config
-	log         zap.Logger
-	clusterRepo repository.ClusterRepository
-	folderRepo  repository.FolderRepository
+	cfg          kafka.Config
+	log          zap.Logger
+	clusterRepo  repository.ClusterRepository
+	folderRepo   repository.FolderRepository
+	folderAccess access.FolderAccess
 }
 
 func NewCreateClusterHandler(
@@ -28,30 +33,45 @@ func NewCreateClusterHandler(
 	log zap.Logger,
 	clusterRepo repository.ClusterRepository,
 	folderRepo repository.FolderRepository,
+	folderAccess access.FolderAccess,
 ) *createClusterHandler {
 	return &createClusterHandler{
-		cfg:         cfg,
-		log:         log,
-		clusterRepo: clusterRepo,
-		folderRepo:  folderRepo,
+		cfg:          cfg,
+		log:          log,
+		clusterRepo:  clusterRepo,
+		folderRepo:   folderRepo,
+		folderAccess: folderAccess,
 	}
 }
 
 func (c *createClusterHandler) Handle(ctx context.Context, command *CreateClusterCommand) (*string, error) {
+	if err := validateTimecodes(command.LanguageConfig); err != nil {
+		return nil, err
+	}
+
 	id := primitive.NewObjectID()
 	folderID, err := primitive.ObjectIDFromHex(command.FolderID)
 	if err != nil {
 		return nil, errors.New("invalid folder id")
 	}
 
-	// check if folder exist
-	exist, err := c.folderRepo.Exists(ctx, bson.M{"_id": folderID})
+	folder, err := c.folderRepo.GetByID(ctx, folderID.Hex())
 	if err != nil {
+		return nil, errors.New("folder not found")
+	}
+
+	// Clusters are owned by the organization that creates them, so the folder must be its own
+	if !tenant.FromContext(ctx).CanWrite(folder.OrganizationID) {
+		return nil, errors.Wrap(httpPkg.Forbidden, "cannot add clusters to this folder")
+	}
+
+	if err := c.folderAccess.Require(ctx, folder, constants.FolderPermissionWrite); err != nil {
 		return nil, err
 	}
 
-	if !exist {
-		return nil, errors.New("folder not found")
+	position, err := c.clusterRepo.GetNextPosition(ctx, folderID)
+	if err != nil {
+		return nil, err
 	}
 
 	cluster := models.Cluster{
@@ -61,7 +81,9 @@ func (c *createClusterHandler) Handle(ctx context.Context, command *CreateCluste
 		Note:           command.Note,
 		Image:          command.Image,
 		LanguageConfig: command.LanguageConfig,
+		Tags:           tags.Normalize(command.Tags),
 		FolderID:       folderID,
+		Position:       position,
 		CreatedAt:      time.Now(),
 		UpdatedAt:      time.Now(),
 	}
@@ -2,11 +2,11 @@ package cluster
 
 import (
 	"context"
+	"gallery-service/internal/application/access"
 	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
 	"gallery-service/pkg/zap"
 	"github.com/pkg/errors"
-	"go.mongodb.org/mongo-driver/bson"
-	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 type DeleteClusterCommandHandler interface {
@@ -14,29 +14,41 @@ type DeleteClusterCommandHandler interface {
 }
 
 type deleteClusterHandler struct {
-	log         zap.Logger
-	clusterRepo repository.ClusterRepository
+	log          zap.Logger
+	clusterRepo  repository.ClusterRepository
+	folderRepo   repository.FolderRepository
+	folderAccess access.FolderAccess
 }
 
 func NewDeleteClusterHandler(
 	log zap.Logger,
 	clusterRepo repository.ClusterRepository,
+	folderRepo repository.FolderRepository,
+	folderAccess access.FolderAccess,
 ) *deleteClusterHandler {
 	return &deleteClusterHandler{
-		log:         log,
-		clusterRepo: clusterRepo,
+		log:          log,
+		clusterRepo:  clusterRepo,
+		folderRepo:   folderRepo,
+		folderAccess: folderAccess,
 	}
 }
 
 func (u *deleteClusterHandler) Handle(ctx context.Context, command *DeleteClusterCommand) error {
-	id, _ := primitive.ObjectIDFromHex(command.ID)
-	exist, err := u.clusterRepo.Exists(ctx, bson.M{"_id": id})
+	cluster, err := u.clusterRepo.GetByID(ctx, command.ID)
 	if err != nil {
-		return err
+		return errors.New("cluster not found")
 	}
 
-	if !exist {
-		return errors.New("cluster not found")
+	if !cluster.FolderID.IsZero() {
+		folder, err := u.folderRepo.GetByID(ctx, cluster.FolderID.Hex())
+		if err != nil {
+			return errors.New("folder not found")
+		}
+
+		if err := u.folderAccess.Require(ctx, folder, constants.FolderPermissionWrite); err != nil {
+			return err
+		}
 	}
 
 	if ok, err := u.clusterRepo.Delete(ctx, command.ID); !ok || err != nil {
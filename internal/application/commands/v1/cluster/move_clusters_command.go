@@ -0,0 +1,13 @@
+package cluster
+
+type MoveClustersCommand struct {
+	FolderID string   `json:"folder_id" validate:"required"`
+	IDs      []string `json:"ids" validate:"required,min=1"`
+}
+
+func NewMoveClustersCommand(folderID string, ids []string) *MoveClustersCommand {
+	return &MoveClustersCommand{
+		FolderID: folderID,
+		IDs:      ids,
+	}
+}
@@ -0,0 +1,174 @@
+package cluster
+
+import (
+	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/application/dto/responses/cluster"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type MoveClustersCommandHandler interface {
+	Handle(ctx context.Context, command *MoveClustersCommand) (*cluster.MoveClustersResponseDto, error)
+}
+
+type moveClustersHandler struct {
+	log          zap.Logger
+	clusterRepo  repository.ClusterRepository
+	folderRepo   repository.FolderRepository
+	folderAccess access.FolderAccess
+	txManager    repository.TransactionManager
+}
+
+func NewMoveClustersHandler(
+	log zap.Logger,
+	clusterRepo repository.ClusterRepository,
+	folderRepo repository.FolderRepository,
+	folderAccess access.FolderAccess,
+	txManager repository.TransactionManager,
+) *moveClustersHandler {
+	return &moveClustersHandler{
+		log:          log,
+		clusterRepo:  clusterRepo,
+		folderRepo:   folderRepo,
+		folderAccess: folderAccess,
+		txManager:    txManager,
+	}
+}
+
+// Handle moves the clusters into the folder, appended after its existing clusters in the
+// order they were requested. Clusters that cannot be moved are reported as failed and
+// left alone, the others are moved together in one transaction.
+func (m *moveClustersHandler) Handle(ctx context.Context, command *MoveClustersCommand) (*cluster.MoveClustersResponseDto, error) {
+	folderID, err := primitive.ObjectIDFromHex(command.FolderID)
+	if err != nil {
+		return nil, errors.Wrap(httpPkg.BadRequest, "invalid folder id")
+	}
+
+	folder, err := m.folderRepo.GetByID(ctx, folderID.Hex())
+	if err != nil {
+		return nil, errors.New("folder not found")
+	}
+
+	if err := m.folderAccess.Require(ctx, folder, constants.FolderPermissionWrite); err != nil {
+		return nil, err
+	}
+
+	results := make([]cluster.MoveClusterResultDto, len(command.IDs))
+	moves := make(map[int]primitive.ObjectID, len(command.IDs))
+	sources := make(map[primitive.ObjectID]error)
+	seen := make(map[primitive.ObjectID]struct{}, len(command.IDs))
+	for i, id := range command.IDs {
+		results[i] = cluster.MoveClusterResultDto{ID: id, Status: constants.ClusterMoveFailed.String()}
+
+		clusterID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			results[i].Error = "invalid cluster id"
+			continue
+		}
+
+		if _, ok := seen[clusterID]; ok {
+			results[i].Error = "duplicate cluster id"
+			continue
+		}
+		seen[clusterID] = struct{}{}
+
+		c, err := m.clusterRepo.GetByID(ctx, clusterID.Hex())
+		if err != nil {
+			results[i].Error = "cluster not found"
+			continue
+		}
+
+		if c.FolderID == folderID {
+			results[i].Status = constants.ClusterMoveUnchanged.String()
+			continue
+		}
+
+		if err := m.canLeave(ctx, c.FolderID, sources); err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+
+		moves[i] = clusterID
+	}
+
+	var moved int64
+	if len(moves) > 0 {
+		err = m.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+			// A retried transaction starts over, so does what an earlier attempt reported
+			moved = 0
+			for i := range moves {
+				results[i] = cluster.MoveClusterResultDto{ID: command.IDs[i], Status: constants.ClusterMoveFailed.String()}
+			}
+
+			position, err := m.clusterRepo.GetNextPosition(ctx, folderID)
+			if err != nil {
+				return err
+			}
+
+			for i := range results {
+				clusterID, ok := moves[i]
+				if !ok {
+					continue
+				}
+
+				ok, err := m.clusterRepo.Move(ctx, clusterID, folderID, position)
+				if err != nil {
+					return err
+				}
+
+				if !ok {
+					results[i].Status = constants.ClusterMoveFailed.String()
+					results[i].Error = errors.Wrap(httpPkg.Forbidden, "cannot move this cluster").Error()
+					continue
+				}
+
+				results[i].Status = constants.ClusterMoveMoved.String()
+				position++
+				moved++
+			}
+
+			return nil
+		})
+		if err != nil {
+			m.log.Errorf("(MoveClustersCommandHandler.Handle) err: {%v}", err)
+			return nil, err
+		}
+	}
+
+	return &cluster.MoveClustersResponseDto{
+		FolderID: folderID.Hex(),
+		Moved:    moved,
+		Results:  results,
+	}, nil
+}
+
+// canLeave checks that the current user may take clusters out of their folder, caching
+// the answer per folder
+func (m *moveClustersHandler) canLeave(ctx context.Context, folderID primitive.ObjectID, checked map[primitive.ObjectID]error) error {
+	if err, ok := checked[folderID]; ok {
+		return err
+	}
+
+	err := m.requireWrite(ctx, folderID)
+	checked[folderID] = err
+	return err
+}
+
+func (m *moveClustersHandler) requireWrite(ctx context.Context, folderID primitive.ObjectID) error {
+	if folderID.IsZero() {
+		return nil
+	}
+
+	folder, err := m.folderRepo.GetByID(ctx, folderID.Hex())
+	if err != nil {
+		return errors.New("folder not found")
+	}
+
+	return m.folderAccess.Require(ctx, folder, constants.FolderPermissionWrite)
+}
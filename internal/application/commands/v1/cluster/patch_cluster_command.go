@@ -0,0 +1,14 @@
+package cluster
+
+// PatchClusterCommand carries a JSON merge patch (RFC 7396) for a cluster
+type PatchClusterCommand struct {
+	ID    string `json:"id" validate:"required"`
+	Patch []byte `json:"patch" validate:"required"`
+}
+
+func NewPatchClusterCommand(id string, patch []byte) *PatchClusterCommand {
+	return &PatchClusterCommand{
+		ID:    id,
+		Patch: patch,
+	}
+}
@@ -0,0 +1,177 @@
+package cluster
+
+import (
+	"context"
+	"gallery-service/internal/application/access"
+	requests "gallery-service/internal/application/dto/requests/cluster"
+	"gallery-service/internal/application/patch"
+	"gallery-service/internal/application/revision"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/tags"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// clusterPatchFields are the stored fields a cluster patch can change
+var clusterPatchFields = []string{"cluster_name", "title", "note", "image", "language_config", "tags", "folder_id"}
+
+type PatchClusterCommandHandler interface {
+	Handle(ctx context.Context, command *PatchClusterCommand) error
+}
+
+type patchClusterHandler struct {
+	log          zap.Logger
+	val          patch.Validator
+	clusterRepo  repository.ClusterRepository
+	folderRepo   repository.FolderRepository
+	folderAccess access.FolderAccess
+	recorder     revision.Recorder
+	txManager    repository.TransactionManager
+}
+
+func NewPatchClusterHandler(
+	log zap.Logger,
+	val patch.Validator,
+	clusterRepo repository.ClusterRepository,
+	folderRepo repository.FolderRepository,
+	folderAccess access.FolderAccess,
+	recorder revision.Recorder,
+	txManager repository.TransactionManager,
+) *patchClusterHandler {
+	return &patchClusterHandler{
+		log:          log,
+		val:          val,
+		clusterRepo:  clusterRepo,
+		folderRepo:   folderRepo,
+		folderAccess: folderAccess,
+		recorder:     recorder,
+		txManager:    txManager,
+	}
+}
+
+// Handle merges the patch into the cluster as the update request would send it, checks
+// the result like an update and saves only the fields that changed
+func (u *patchClusterHandler) Handle(ctx context.Context, command *PatchClusterCommand) error {
+	cluster, err := u.clusterRepo.GetByID(ctx, command.ID)
+	if err != nil {
+		return err
+	}
+
+	if !cluster.FolderID.IsZero() {
+		current, err := u.folderRepo.GetByID(ctx, cluster.FolderID.Hex())
+		if err != nil {
+			return errors.New("folder not found")
+		}
+
+		if err := u.folderAccess.Require(ctx, current, constants.FolderPermissionWrite); err != nil {
+			return err
+		}
+	}
+
+	var merged requests.PatchClusterReqDto
+	if err := patch.Apply(clusterDocument(cluster), command.Patch, &merged); err != nil {
+		return err
+	}
+
+	if err := u.val.DataValidation(merged); err != nil {
+		return err
+	}
+
+	if err := validateTimecodes(merged.LanguageConfig); err != nil {
+		return err
+	}
+
+	if merged.ID != cluster.ID.Hex() {
+		return errors.Wrap(httpPkg.BadRequest, "id cannot be changed")
+	}
+
+	// A cluster without a folder keeps none until the patch chooses one
+	var folderID primitive.ObjectID
+	switch {
+	case merged.FolderID != "":
+		if folderID, err = primitive.ObjectIDFromHex(merged.FolderID); err != nil {
+			return errors.Wrap(httpPkg.BadRequest, "invalid folder id")
+		}
+	case !cluster.FolderID.IsZero():
+		return errors.Wrap(httpPkg.BadRequest, "folder_id cannot be removed, move the cluster to another folder instead")
+	}
+
+	patched := *cluster
+	patched.ClusterName = merged.ClusterName
+	patched.Title = merged.Title
+	patched.Note = merged.Note
+	patched.Image = merged.Image
+	patched.LanguageConfig = merged.LanguageConfig
+	patched.Tags = tags.Normalize(merged.Tags)
+	patched.FolderID = folderID
+
+	set, unset, err := patch.Changes(cluster, &patched, clusterPatchFields...)
+	if err != nil {
+		return err
+	}
+
+	if len(set) == 0 && len(unset) == 0 {
+		return nil
+	}
+
+	if folderID != cluster.FolderID {
+		target, err := u.folderRepo.GetByID(ctx, folderID.Hex())
+		if err != nil {
+			return errors.New("folder not found")
+		}
+
+		if err := u.folderAccess.Require(ctx, target, constants.FolderPermissionWrite); err != nil {
+			return err
+		}
+	}
+
+	return u.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		// A cluster moved by the patch goes after the clusters already in the folder
+		if folderID != cluster.FolderID {
+			position, err := u.clusterRepo.GetNextPosition(ctx, folderID)
+			if err != nil {
+				return err
+			}
+			set["position"] = position
+		}
+		set["updated_at"] = time.Now()
+
+		_, err := u.recorder.Record(ctx, revision.Entry{
+			EntityType:     constants.RevisionEntityCluster,
+			EntityID:       cluster.ID,
+			OrganizationID: cluster.OrganizationID,
+			Previous:       cluster,
+			Action:         constants.RevisionActionUpdate,
+		})
+		if err != nil {
+			return err
+		}
+
+		return u.clusterRepo.Patch(ctx, cluster.ID, set, unset)
+	})
+}
+
+// clusterDocument is the cluster in the shape of an update request, the document a
+// patch applies to. A cluster without a folder has an empty folder_id.
+func clusterDocument(cluster *models.Cluster) requests.PatchClusterReqDto {
+	document := requests.PatchClusterReqDto{
+		ID:             cluster.ID.Hex(),
+		ClusterName:    cluster.ClusterName,
+		Title:          cluster.Title,
+		Note:           cluster.Note,
+		Image:          cluster.Image,
+		LanguageConfig: cluster.LanguageConfig,
+		Tags:           cluster.Tags,
+	}
+	if !cluster.FolderID.IsZero() {
+		document.FolderID = cluster.FolderID.Hex()
+	}
+
+	return document
+}
@@ -0,0 +1,13 @@
+package cluster
+
+type RemoveClusterLanguageCommand struct {
+	ID   string `json:"id" validate:"required"`
+	Code string `json:"code" validate:"required"`
+}
+
+func NewRemoveClusterLanguageCommand(id string, code string) *RemoveClusterLanguageCommand {
+	return &RemoveClusterLanguageCommand{
+		ID:   id,
+		Code: code,
+	}
+}
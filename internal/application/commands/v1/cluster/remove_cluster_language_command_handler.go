@@ -0,0 +1,85 @@
+package cluster
+
+import (
+	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/application/revision"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/registry"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+)
+
+type RemoveClusterLanguageCommandHandler interface {
+	Handle(ctx context.Context, command *RemoveClusterLanguageCommand) error
+}
+
+type removeClusterLanguageHandler struct {
+	log          zap.Logger
+	clusterRepo  repository.ClusterRepository
+	folderRepo   repository.FolderRepository
+	folderAccess access.FolderAccess
+	recorder     revision.Recorder
+	txManager    repository.TransactionManager
+}
+
+func NewRemoveClusterLanguageHandler(
+	log zap.Logger,
+	clusterRepo repository.ClusterRepository,
+	folderRepo repository.FolderRepository,
+	folderAccess access.FolderAccess,
+	recorder revision.Recorder,
+	txManager repository.TransactionManager,
+) *removeClusterLanguageHandler {
+	return &removeClusterLanguageHandler{
+		log:          log,
+		clusterRepo:  clusterRepo,
+		folderRepo:   folderRepo,
+		folderAccess: folderAccess,
+		recorder:     recorder,
+		txManager:    txManager,
+	}
+}
+
+func (u *removeClusterLanguageHandler) Handle(ctx context.Context, command *RemoveClusterLanguageCommand) error {
+	language, ok := registry.LanguageByCode(command.Code)
+	if !ok {
+		return errors.Wrapf(httpPkg.BadRequest, "unsupported language %s", command.Code)
+	}
+
+	cluster, err := u.clusterRepo.GetByID(ctx, command.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := requireClusterWrite(ctx, u.folderRepo, u.folderAccess, cluster); err != nil {
+		return err
+	}
+
+	return u.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		_, err := u.recorder.Record(ctx, revision.Entry{
+			EntityType:     constants.RevisionEntityCluster,
+			EntityID:       cluster.ID,
+			OrganizationID: cluster.OrganizationID,
+			Previous:       cluster,
+			Action:         constants.RevisionActionUpdate,
+		})
+		if err != nil {
+			return err
+		}
+
+		removed, err := u.clusterRepo.RemoveLanguage(ctx, cluster.ID, language.Name)
+		if err != nil {
+			return err
+		}
+
+		if !removed {
+			return errors.Errorf("language %s not found", command.Code)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,13 @@
+package cluster
+
+type RemoveClusterTermCommand struct {
+	ID     string `json:"id" validate:"required"`
+	TermID string `json:"term_id" validate:"required"`
+}
+
+func NewRemoveClusterTermCommand(id string, termID string) *RemoveClusterTermCommand {
+	return &RemoveClusterTermCommand{
+		ID:     id,
+		TermID: termID,
+	}
+}
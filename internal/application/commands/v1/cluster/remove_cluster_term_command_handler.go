@@ -0,0 +1,64 @@
+package cluster
+
+import (
+	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/domain/repository"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type RemoveClusterTermCommandHandler interface {
+	Handle(ctx context.Context, command *RemoveClusterTermCommand) error
+}
+
+type removeClusterTermHandler struct {
+	log          zap.Logger
+	clusterRepo  repository.ClusterRepository
+	folderRepo   repository.FolderRepository
+	folderAccess access.FolderAccess
+}
+
+func NewRemoveClusterTermHandler(
+	log zap.Logger,
+	clusterRepo repository.ClusterRepository,
+	folderRepo repository.FolderRepository,
+	folderAccess access.FolderAccess,
+) *removeClusterTermHandler {
+	return &removeClusterTermHandler{
+		log:          log,
+		clusterRepo:  clusterRepo,
+		folderRepo:   folderRepo,
+		folderAccess: folderAccess,
+	}
+}
+
+func (u *removeClusterTermHandler) Handle(ctx context.Context, command *RemoveClusterTermCommand) error {
+	termID, err := primitive.ObjectIDFromHex(command.TermID)
+	if err != nil {
+		return errors.Wrapf(httpPkg.BadRequest, "invalid taxonomy term %s", command.TermID)
+	}
+
+	cluster, err := u.clusterRepo.GetByID(ctx, command.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := requireClusterWrite(ctx, u.folderRepo, u.folderAccess, cluster); err != nil {
+		return err
+	}
+
+	removed, err := u.clusterRepo.RemoveTaxonomyTerm(ctx, cluster.ID, termID)
+	if err != nil {
+		return err
+	}
+
+	if !removed {
+		return errors.Errorf("taxonomy term %s not found on the cluster", command.TermID)
+	}
+
+	return nil
+}
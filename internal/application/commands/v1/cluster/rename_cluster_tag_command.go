@@ -0,0 +1,15 @@
+package cluster
+
+// RenameClusterTagCommand renames Tag to Name on every cluster. Renaming to a tag that
+// already exists merges the two.
+type RenameClusterTagCommand struct {
+	Tag  string `json:"tag" validate:"required"`
+	Name string `json:"name" validate:"required,max=64"`
+}
+
+func NewRenameClusterTagCommand(tag string, name string) *RenameClusterTagCommand {
+	return &RenameClusterTagCommand{
+		Tag:  tag,
+		Name: name,
+	}
+}
@@ -0,0 +1,101 @@
+package cluster
+
+import (
+	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/application/dto/responses/cluster"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/tags"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type RenameClusterTagCommandHandler interface {
+	Handle(ctx context.Context, command *RenameClusterTagCommand) (*cluster.RenameClusterTagResponseDto, error)
+}
+
+type renameClusterTagHandler struct {
+	log          zap.Logger
+	clusterRepo  repository.ClusterRepository
+	folderRepo   repository.FolderRepository
+	folderAccess access.FolderAccess
+}
+
+func NewRenameClusterTagHandler(
+	log zap.Logger,
+	clusterRepo repository.ClusterRepository,
+	folderRepo repository.FolderRepository,
+	folderAccess access.FolderAccess,
+) *renameClusterTagHandler {
+	return &renameClusterTagHandler{
+		log:          log,
+		clusterRepo:  clusterRepo,
+		folderRepo:   folderRepo,
+		folderAccess: folderAccess,
+	}
+}
+
+// Handle renames the tag on the clusters the current user can write. Clusters in folders
+// they cannot write keep the tag and are counted as skipped.
+func (u *renameClusterTagHandler) Handle(ctx context.Context, command *RenameClusterTagCommand) (*cluster.RenameClusterTagResponseDto, error) {
+	names := tags.Normalize([]string{command.Tag, command.Name})
+	if len(names) == 0 {
+		return nil, errors.Wrap(httpPkg.BadRequest, "tag is required")
+	}
+
+	from := names[0]
+	if len(names) == 1 {
+		// Renaming a tag to itself, up to case and spaces, changes nothing
+		return &cluster.RenameClusterTagResponseDto{Tag: from, Name: from}, nil
+	}
+	to := names[1]
+
+	clusters, err := u.clusterRepo.GetByTag(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(clusters) == 0 {
+		return nil, errors.Errorf("tag %s not found", from)
+	}
+
+	seen := make(map[primitive.ObjectID]bool)
+	folderIDs := make([]primitive.ObjectID, 0)
+	for _, c := range clusters {
+		if !c.FolderID.IsZero() && !seen[c.FolderID] {
+			seen[c.FolderID] = true
+			folderIDs = append(folderIDs, c.FolderID)
+		}
+	}
+
+	folders, err := u.folderRepo.GetByIDs(ctx, folderIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := u.folderAccess.Permissions(ctx, folders)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &cluster.RenameClusterTagResponseDto{Tag: from, Name: to}
+	clusterIDs := make([]primitive.ObjectID, 0, len(clusters))
+	for _, c := range clusters {
+		if c.FolderID.IsZero() || permissions[c.FolderID].Includes(constants.FolderPermissionWrite) {
+			clusterIDs = append(clusterIDs, c.ID)
+		} else {
+			res.Skipped++
+		}
+	}
+
+	res.Renamed, err = u.clusterRepo.RenameTag(ctx, clusterIDs, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
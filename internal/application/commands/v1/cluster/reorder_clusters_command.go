@@ -0,0 +1,13 @@
+package cluster
+
+type ReorderClustersCommand struct {
+	FolderID string   `json:"folder_id" validate:"required"`
+	IDs      []string `json:"ids" validate:"required,min=1"`
+}
+
+func NewReorderClustersCommand(folderID string, ids []string) *ReorderClustersCommand {
+	return &ReorderClustersCommand{
+		FolderID: folderID,
+		IDs:      ids,
+	}
+}
@@ -0,0 +1,77 @@
+package cluster
+
+import (
+	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ReorderClustersCommandHandler interface {
+	Handle(ctx context.Context, command *ReorderClustersCommand) error
+}
+
+type reorderClustersHandler struct {
+	log          zap.Logger
+	clusterRepo  repository.ClusterRepository
+	folderRepo   repository.FolderRepository
+	folderAccess access.FolderAccess
+	txManager    repository.TransactionManager
+}
+
+func NewReorderClustersHandler(
+	log zap.Logger,
+	clusterRepo repository.ClusterRepository,
+	folderRepo repository.FolderRepository,
+	folderAccess access.FolderAccess,
+	txManager repository.TransactionManager,
+) *reorderClustersHandler {
+	return &reorderClustersHandler{
+		log:          log,
+		clusterRepo:  clusterRepo,
+		folderRepo:   folderRepo,
+		folderAccess: folderAccess,
+		txManager:    txManager,
+	}
+}
+
+func (r *reorderClustersHandler) Handle(ctx context.Context, command *ReorderClustersCommand) error {
+	folderID, err := primitive.ObjectIDFromHex(command.FolderID)
+	if err != nil {
+		return errors.Wrap(httpPkg.BadRequest, "invalid folder id")
+	}
+
+	folder, err := r.folderRepo.GetByID(ctx, folderID.Hex())
+	if err != nil {
+		return errors.New("folder not found")
+	}
+
+	if err := r.folderAccess.Require(ctx, folder, constants.FolderPermissionWrite); err != nil {
+		return err
+	}
+
+	seen := make(map[primitive.ObjectID]struct{}, len(command.IDs))
+	clusterIDs := make([]primitive.ObjectID, 0, len(command.IDs))
+	for _, id := range command.IDs {
+		clusterID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return errors.Wrapf(httpPkg.BadRequest, "invalid cluster id %s", id)
+		}
+
+		if _, ok := seen[clusterID]; ok {
+			return errors.Wrapf(httpPkg.BadRequest, "duplicate cluster id %s", id)
+		}
+
+		seen[clusterID] = struct{}{}
+		clusterIDs = append(clusterIDs, clusterID)
+	}
+
+	return r.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		return r.clusterRepo.Reorder(ctx, folderID, clusterIDs)
+	})
+}
@@ -0,0 +1,13 @@
+package cluster
+
+type RestoreClusterRevisionCommand struct {
+	ID       string `json:"id" validate:"required"`
+	Revision int64  `json:"revision" validate:"required,gt=0"`
+}
+
+func NewRestoreClusterRevisionCommand(id string, revision int64) *RestoreClusterRevisionCommand {
+	return &RestoreClusterRevisionCommand{
+		ID:       id,
+		Revision: revision,
+	}
+}
@@ -0,0 +1,113 @@
+package cluster
+
+import (
+	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/application/revision"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/pkg/zap"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type RestoreClusterRevisionCommandHandler interface {
+	Handle(ctx context.Context, command *RestoreClusterRevisionCommand) (int64, error)
+}
+
+type restoreClusterRevisionHandler struct {
+	log          zap.Logger
+	clusterRepo  repository.ClusterRepository
+	folderRepo   repository.FolderRepository
+	revisionRepo repository.RevisionRepository
+	folderAccess access.FolderAccess
+	recorder     revision.Recorder
+	txManager    repository.TransactionManager
+}
+
+func NewRestoreClusterRevisionHandler(
+	log zap.Logger,
+	clusterRepo repository.ClusterRepository,
+	folderRepo repository.FolderRepository,
+	revisionRepo repository.RevisionRepository,
+	folderAccess access.FolderAccess,
+	recorder revision.Recorder,
+	txManager repository.TransactionManager,
+) *restoreClusterRevisionHandler {
+	return &restoreClusterRevisionHandler{
+		log:          log,
+		clusterRepo:  clusterRepo,
+		folderRepo:   folderRepo,
+		revisionRepo: revisionRepo,
+		folderAccess: folderAccess,
+		recorder:     recorder,
+		txManager:    txManager,
+	}
+}
+
+// Handle writes the content of an old revision back to the cluster and records the
+// replaced content as a new revision. The cluster stays in its current folder and
+// position. It returns the number of the new revision.
+func (u *restoreClusterRevisionHandler) Handle(ctx context.Context, command *RestoreClusterRevisionCommand) (int64, error) {
+	cluster, err := u.clusterRepo.GetByID(ctx, command.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	folder, err := u.folderRepo.GetByID(ctx, cluster.FolderID.Hex())
+	if err != nil {
+		return 0, errors.New("folder not found")
+	}
+
+	if err := u.folderAccess.Require(ctx, folder, constants.FolderPermissionWrite); err != nil {
+		return 0, err
+	}
+
+	old, err := u.revisionRepo.GetByNumber(ctx, constants.RevisionEntityCluster, cluster.ID, command.Revision)
+	if err != nil {
+		return 0, err
+	}
+
+	var snapshot models.Cluster
+	if err := revision.Decode(old, &snapshot); err != nil {
+		return 0, err
+	}
+
+	t := models.Cluster{
+		ID:             cluster.ID,
+		ClusterName:    snapshot.ClusterName,
+		Title:          snapshot.Title,
+		Note:           snapshot.Note,
+		Image:          snapshot.Image,
+		LanguageConfig: snapshot.LanguageConfig,
+		Tags:           snapshot.Tags,
+		FolderID:       cluster.FolderID,
+		Position:       cluster.Position,
+		CreatedAt:      cluster.CreatedAt,
+		UpdatedAt:      time.Now(),
+	}
+
+	var number int64
+	err = u.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		number, err = u.recorder.Record(ctx, revision.Entry{
+			EntityType:     constants.RevisionEntityCluster,
+			EntityID:       cluster.ID,
+			OrganizationID: cluster.OrganizationID,
+			Previous:       cluster,
+			Action:         constants.RevisionActionRestore,
+			RestoredFrom:   &command.Revision,
+		})
+		if err != nil {
+			return err
+		}
+
+		return u.clusterRepo.Update(ctx, &t)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return number, nil
+}
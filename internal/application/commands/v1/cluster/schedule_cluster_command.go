@@ -0,0 +1,18 @@
+package cluster
+
+import "time"
+
+// ScheduleClusterCommand sets when a cluster is shown and hidden. A nil time clears it.
+type ScheduleClusterCommand struct {
+	ID          string     `json:"id" validate:"required"`
+	PublishAt   *time.Time `json:"publish_at"`
+	UnpublishAt *time.Time `json:"unpublish_at"`
+}
+
+func NewScheduleClusterCommand(id string, publishAt *time.Time, unpublishAt *time.Time) *ScheduleClusterCommand {
+	return &ScheduleClusterCommand{
+		ID:          id,
+		PublishAt:   publishAt,
+		UnpublishAt: unpublishAt,
+	}
+}
@@ -0,0 +1,67 @@
+package cluster
+
+import (
+	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/domain/repository"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type ScheduleClusterCommandHandler interface {
+	Handle(ctx context.Context, command *ScheduleClusterCommand) error
+}
+
+type scheduleClusterHandler struct {
+	log          zap.Logger
+	clusterRepo  repository.ClusterRepository
+	folderRepo   repository.FolderRepository
+	folderAccess access.FolderAccess
+}
+
+func NewScheduleClusterHandler(
+	log zap.Logger,
+	clusterRepo repository.ClusterRepository,
+	folderRepo repository.FolderRepository,
+	folderAccess access.FolderAccess,
+) *scheduleClusterHandler {
+	return &scheduleClusterHandler{
+		log:          log,
+		clusterRepo:  clusterRepo,
+		folderRepo:   folderRepo,
+		folderAccess: folderAccess,
+	}
+}
+
+// Handle replaces the schedule of the cluster. A cluster to be published later is hidden
+// until then, any other is shown until its unpublish time, if it has one.
+func (u *scheduleClusterHandler) Handle(ctx context.Context, command *ScheduleClusterCommand) error {
+	if command.PublishAt != nil && command.UnpublishAt != nil && !command.UnpublishAt.After(*command.PublishAt) {
+		return errors.Wrap(httpPkg.BadRequest, "unpublish_at must be after publish_at")
+	}
+
+	cluster, err := u.clusterRepo.GetByID(ctx, command.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := requireClusterWrite(ctx, u.folderRepo, u.folderAccess, cluster); err != nil {
+		return err
+	}
+
+	hidden := command.PublishAt != nil && command.PublishAt.After(time.Now())
+
+	updated, err := u.clusterRepo.SetSchedule(ctx, cluster.ID, command.PublishAt, command.UnpublishAt, hidden)
+	if err != nil {
+		return err
+	}
+
+	if !updated {
+		return errors.Errorf("cluster %s not found", command.ID)
+	}
+
+	return nil
+}
@@ -1,19 +1,49 @@
 package cluster
 
 type Commands struct {
-	CreateCluster CreateClusterCommandHandler
-	UpdateCluster UpdateClusterCommandHandler
-	DeleteCluster DeleteClusterCommandHandler
+	CreateCluster   CreateClusterCommandHandler
+	UpdateCluster   UpdateClusterCommandHandler
+	PatchCluster    PatchClusterCommandHandler
+	DeleteCluster   DeleteClusterCommandHandler
+	ReorderClusters ReorderClustersCommandHandler
+	MoveClusters    MoveClustersCommandHandler
+	RestoreRevision RestoreClusterRevisionCommandHandler
+	SetLanguage     SetClusterLanguageCommandHandler
+	RemoveLanguage  RemoveClusterLanguageCommandHandler
+	RenameTag       RenameClusterTagCommandHandler
+	AddTerm         AddClusterTermCommandHandler
+	RemoveTerm      RemoveClusterTermCommandHandler
+	Schedule        ScheduleClusterCommandHandler
 }
 
 func NewClusterCommands(
 	createCluster CreateClusterCommandHandler,
 	updateCluster UpdateClusterCommandHandler,
+	patchCluster PatchClusterCommandHandler,
 	deleteCluster DeleteClusterCommandHandler,
+	reorderClusters ReorderClustersCommandHandler,
+	moveClusters MoveClustersCommandHandler,
+	restoreRevision RestoreClusterRevisionCommandHandler,
+	setLanguage SetClusterLanguageCommandHandler,
+	removeLanguage RemoveClusterLanguageCommandHandler,
+	renameTag RenameClusterTagCommandHandler,
+	addTerm AddClusterTermCommandHandler,
+	removeTerm RemoveClusterTermCommandHandler,
+	schedule ScheduleClusterCommandHandler,
 ) *Commands {
 	return &Commands{
-		CreateCluster: createCluster,
-		UpdateCluster: updateCluster,
-		DeleteCluster: deleteCluster,
+		CreateCluster:   createCluster,
+		UpdateCluster:   updateCluster,
+		PatchCluster:    patchCluster,
+		DeleteCluster:   deleteCluster,
+		ReorderClusters: reorderClusters,
+		MoveClusters:    moveClusters,
+		RestoreRevision: restoreRevision,
+		SetLanguage:     setLanguage,
+		RemoveLanguage:  removeLanguage,
+		RenameTag:       renameTag,
+		AddTerm:         addTerm,
+		RemoveTerm:      removeTerm,
+		Schedule:        schedule,
 	}
 }
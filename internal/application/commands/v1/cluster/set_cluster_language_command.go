@@ -0,0 +1,21 @@
+package cluster
+
+import "gallery-service/internal/domain/models"
+
+// SetClusterLanguageCommand adds or replaces the entry of one language of a cluster.
+// Code is the code of an enabled language of the registry.
+type SetClusterLanguageCommand struct {
+	ID    string             `json:"id" validate:"required"`
+	Code  string             `json:"code" validate:"required"`
+	Video models.VideoConfig `json:"video"`
+	Audio models.AudioConfig `json:"audio"`
+}
+
+func NewSetClusterLanguageCommand(id string, code string, video models.VideoConfig, audio models.AudioConfig) *SetClusterLanguageCommand {
+	return &SetClusterLanguageCommand{
+		ID:    id,
+		Code:  code,
+		Video: video,
+		Audio: audio,
+	}
+}
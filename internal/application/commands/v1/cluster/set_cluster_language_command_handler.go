@@ -0,0 +1,94 @@
+package cluster
+
+import (
+	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/application/revision"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/registry"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+)
+
+type SetClusterLanguageCommandHandler interface {
+	Handle(ctx context.Context, command *SetClusterLanguageCommand) (bool, error)
+}
+
+type setClusterLanguageHandler struct {
+	log          zap.Logger
+	clusterRepo  repository.ClusterRepository
+	folderRepo   repository.FolderRepository
+	folderAccess access.FolderAccess
+	recorder     revision.Recorder
+	txManager    repository.TransactionManager
+}
+
+func NewSetClusterLanguageHandler(
+	log zap.Logger,
+	clusterRepo repository.ClusterRepository,
+	folderRepo repository.FolderRepository,
+	folderAccess access.FolderAccess,
+	recorder revision.Recorder,
+	txManager repository.TransactionManager,
+) *setClusterLanguageHandler {
+	return &setClusterLanguageHandler{
+		log:          log,
+		clusterRepo:  clusterRepo,
+		folderRepo:   folderRepo,
+		folderAccess: folderAccess,
+		recorder:     recorder,
+		txManager:    txManager,
+	}
+}
+
+// Handle reports whether the language was added rather than replaced
+func (u *setClusterLanguageHandler) Handle(ctx context.Context, command *SetClusterLanguageCommand) (bool, error) {
+	language, ok := registry.LanguageByCode(command.Code)
+	if !ok || !language.Enabled {
+		return false, errors.Wrapf(httpPkg.BadRequest, "unsupported language %s", command.Code)
+	}
+
+	config := models.LanguageConfig{
+		Language: language.Name,
+		Video:    command.Video,
+		Audio:    command.Audio,
+	}
+	if err := validateLanguageTimecodes(config); err != nil {
+		return false, err
+	}
+
+	cluster, err := u.clusterRepo.GetByID(ctx, command.ID)
+	if err != nil {
+		return false, err
+	}
+
+	if err := requireClusterWrite(ctx, u.folderRepo, u.folderAccess, cluster); err != nil {
+		return false, err
+	}
+
+	var added bool
+	err = u.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		_, err := u.recorder.Record(ctx, revision.Entry{
+			EntityType:     constants.RevisionEntityCluster,
+			EntityID:       cluster.ID,
+			OrganizationID: cluster.OrganizationID,
+			Previous:       cluster,
+			Action:         constants.RevisionActionUpdate,
+		})
+		if err != nil {
+			return err
+		}
+
+		added, err = u.clusterRepo.SetLanguage(ctx, cluster.ID, config)
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return added, nil
+}
@@ -10,6 +10,7 @@ type UpdateClusterCommand struct {
 	Image          models.ImageConfig
 	LanguageConfig []models.LanguageConfig
 	FolderID       string
+	Tags           []string
 }
 
 func NewUpdateClusterCommand(
@@ -20,6 +21,7 @@ func NewUpdateClusterCommand(
 	image models.ImageConfig,
 	languageConfig []models.LanguageConfig,
 	folderID string,
+	tags []string,
 ) *UpdateClusterCommand {
 	return &UpdateClusterCommand{
 		ID:             id,
@@ -29,5 +31,6 @@ func NewUpdateClusterCommand(
 		Image:          image,
 		LanguageConfig: languageConfig,
 		FolderID:       folderID,
+		Tags:           tags,
 	}
 }
@@ -2,11 +2,14 @@ package cluster
 
 import (
 	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/application/revision"
 	"gallery-service/internal/domain/models"
 	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/tags"
 	"gallery-service/pkg/zap"
 	"github.com/pkg/errors"
-	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"time"
 )
@@ -16,24 +19,37 @@ type UpdateClusterCommandHandler interface {
 }
 
 type updateClusterHandler struct {
-	log         zap.Logger
-	clusterRepo repository.ClusterRepository
-	folderRepo  repository.FolderRepository
+	log          zap.Logger
+	clusterRepo  repository.ClusterRepository
+	folderRepo   repository.FolderRepository
+	folderAccess access.FolderAccess
+	recorder     revision.Recorder
+	txManager    repository.TransactionManager
 }
 
 func NewUpdateClusterHandler(
 	log zap.Logger,
 	clusterRepo repository.ClusterRepository,
 	folderRepo repository.FolderRepository,
+	folderAccess access.FolderAccess,
+	recorder revision.Recorder,
+	txManager repository.TransactionManager,
 ) *updateClusterHandler {
 	return &updateClusterHandler{
-		log:         log,
-		clusterRepo: clusterRepo,
-		folderRepo:  folderRepo,
+		log:          log,
+		clusterRepo:  clusterRepo,
+		folderRepo:   folderRepo,
+		folderAccess: folderAccess,
+		recorder:     recorder,
+		txManager:    txManager,
 	}
 }
 
 func (u *updateClusterHandler) Handle(ctx context.Context, command *UpdateClusterCommand) error {
+	if err := validateTimecodes(command.LanguageConfig); err != nil {
+		return err
+	}
+
 	cluster, err := u.clusterRepo.GetByID(ctx, command.ID)
 	if err != nil {
 		return err
@@ -44,14 +60,24 @@ func (u *updateClusterHandler) Handle(ctx context.Context, command *UpdateCluste
 		return errors.New("invalid folder id")
 	}
 
-	// check if cluster exist
-	exist, err := u.folderRepo.Exists(ctx, bson.M{"_id": folderID})
+	current, err := u.folderRepo.GetByID(ctx, cluster.FolderID.Hex())
 	if err != nil {
+		return errors.New("folder not found")
+	}
+
+	if err := u.folderAccess.Require(ctx, current, constants.FolderPermissionWrite); err != nil {
 		return err
 	}
 
-	if !exist {
-		return errors.New("folder not found")
+	if folderID != cluster.FolderID {
+		target, err := u.folderRepo.GetByID(ctx, folderID.Hex())
+		if err != nil {
+			return errors.New("folder not found")
+		}
+
+		if err := u.folderAccess.Require(ctx, target, constants.FolderPermissionWrite); err != nil {
+			return err
+		}
 	}
 
 	t := models.Cluster{
@@ -61,11 +87,35 @@ func (u *updateClusterHandler) Handle(ctx context.Context, command *UpdateCluste
 		Note:           command.Note,
 		Image:          command.Image,
 		LanguageConfig: command.LanguageConfig,
+		Tags:           tags.Normalize(command.Tags),
 		FolderID:       folderID,
+		Position:       cluster.Position,
 		CreatedAt:      cluster.CreatedAt,
 		UpdatedAt:      time.Now(),
 	}
 
-	// Save to database
-	return u.clusterRepo.Update(ctx, &t)
+	// Keep the replaced document as a revision and save, both or neither
+	return u.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		// A cluster moved by the update goes after the clusters already in the folder
+		if folderID != cluster.FolderID {
+			position, err := u.clusterRepo.GetNextPosition(ctx, folderID)
+			if err != nil {
+				return err
+			}
+			t.Position = position
+		}
+
+		_, err := u.recorder.Record(ctx, revision.Entry{
+			EntityType:     constants.RevisionEntityCluster,
+			EntityID:       cluster.ID,
+			OrganizationID: cluster.OrganizationID,
+			Previous:       cluster,
+			Action:         constants.RevisionActionUpdate,
+		})
+		if err != nil {
+			return err
+		}
+
+		return u.clusterRepo.Update(ctx, &t)
+	})
 }
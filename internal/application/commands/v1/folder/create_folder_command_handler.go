@@ -2,10 +2,15 @@ package folder
 
 import (
 	"context"
+	"gallery-service/internal/application/access"
 	"gallery-service/internal/domain/models"
 	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/tenant"
+	httpPkg "gallery-service/pkg/http"
 	"gallery-service/pkg/kafka"
 	"gallery-service/pkg/zap"
+	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
@@ -14,40 +19,67 @@ type CreateFolderCommandHandler interface {
 }
 
 type createFolderHandler struct {
-	cfg        kafka.Config
-	log        zap.Logger
-	folderRepo repository.FolderRepository
+	cfg          kafka.Config
+	log          zap.Logger
+	folderRepo   repository.FolderRepository
+	folderAccess access.FolderAccess
 }
 
 func NewCreateFolderHandler(
 	cfg kafka.Config,
 	log zap.Logger,
 	folderRepo repository.FolderRepository,
+	folderAccess access.FolderAccess,
 ) *createFolderHandler {
 	return &createFolderHandler{
-		cfg:        cfg,
-		log:        log,
-		folderRepo: folderRepo,
+		cfg:          cfg,
+		log:          log,
+		folderRepo:   folderRepo,
+		folderAccess: folderAccess,
 	}
 }
 
 func (c *createFolderHandler) Handle(ctx context.Context, command *CreateFolderCommand) (*string, error) {
 	id := primitive.NewObjectID()
 	var parentID *primitive.ObjectID
+	ancestors := make([]primitive.ObjectID, 0)
 	if command.ParentID != nil {
 		pID, err := primitive.ObjectIDFromHex(*command.ParentID)
 		if err != nil {
 			return nil, err
 		}
 
+		parent, err := c.folderRepo.GetByID(ctx, pID.Hex())
+		if err != nil {
+			return nil, errors.New("parent folder not found")
+		}
+
+		if !tenant.FromContext(ctx).CanWrite(parent.OrganizationID) {
+			return nil, errors.Wrap(httpPkg.Forbidden, "cannot add folders to this parent")
+		}
+
+		if err := c.folderAccess.Require(ctx, parent, constants.FolderPermissionWrite); err != nil {
+			return nil, err
+		}
+
 		parentID = &pID
+		ancestors = parent.PathFromRoot()
+	}
+
+	position, err := c.folderRepo.GetNextPosition(ctx, parentID)
+	if err != nil {
+		return nil, err
 	}
+
 	folder := models.Folder{
 		ID:                 id,
 		FolderName:         command.FolderName,
 		FolderThumbnailKey: command.FolderThumbnailKey,
 		FolderThumbnailURL: command.FolderThumbnailURL,
 		ParentID:           parentID,
+		Ancestors:          ancestors,
+		Depth:              len(ancestors),
+		Position:           position,
 	}
 
 	// Save to database
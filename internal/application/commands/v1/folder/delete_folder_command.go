@@ -1,11 +1,19 @@
 package folder
 
+import "gallery-service/internal/pkg/constants"
+
 type DeleteFolderCommand struct {
-	ID string `json:"id" validate:"required"`
+	ID       string                         `json:"id" validate:"required"`
+	Strategy constants.FolderDeleteStrategy `json:"strategy" validate:"required,oneof=reject cascade reparent"`
 }
 
-func NewDeleteFolderCommand(id string) *DeleteFolderCommand {
+func NewDeleteFolderCommand(id string, strategy constants.FolderDeleteStrategy) *DeleteFolderCommand {
+	if strategy == "" {
+		strategy = constants.FolderDeleteReject
+	}
+
 	return &DeleteFolderCommand{
-		ID: id,
+		ID:       id,
+		Strategy: strategy,
 	}
 }
@@ -2,8 +2,13 @@ package folder
 
 import (
 	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/domain/models"
 	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	httpPkg "gallery-service/pkg/http"
 	"gallery-service/pkg/zap"
+
 	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -14,35 +19,195 @@ type DeleteFolderCommandHandler interface {
 }
 
 type deleteFolderHandler struct {
-	log        zap.Logger
-	folderRepo repository.FolderRepository
+	log          zap.Logger
+	folderRepo   repository.FolderRepository
+	folderAccess access.FolderAccess
+	clusterRepo  repository.ClusterRepository
+	topicRepo    repository.TopicRepository
+	txManager    repository.TransactionManager
 }
 
 func NewDeleteFolderHandler(
 	log zap.Logger,
 	folderRepo repository.FolderRepository,
+	folderAccess access.FolderAccess,
+	clusterRepo repository.ClusterRepository,
+	topicRepo repository.TopicRepository,
+	txManager repository.TransactionManager,
 ) *deleteFolderHandler {
 	return &deleteFolderHandler{
-		log:        log,
-		folderRepo: folderRepo,
+		log:          log,
+		folderRepo:   folderRepo,
+		folderAccess: folderAccess,
+		clusterRepo:  clusterRepo,
+		topicRepo:    topicRepo,
+		txManager:    txManager,
 	}
 }
 
 func (u *deleteFolderHandler) Handle(ctx context.Context, command *DeleteFolderCommand) error {
-	id, _ := primitive.ObjectIDFromHex(command.ID)
-	exist, err := u.folderRepo.Exists(ctx, bson.M{"_id": id})
+	folder, err := u.folderRepo.GetByID(ctx, command.ID)
 	if err != nil {
+		return errors.New("folder not found")
+	}
+
+	if err := u.folderAccess.Require(ctx, folder, constants.FolderPermissionWrite); err != nil {
 		return err
 	}
 
-	if !exist {
-		return errors.New("folder not found")
+	switch command.Strategy {
+	case constants.FolderDeleteCascade:
+		return u.deleteCascade(ctx, command.ID)
+	case constants.FolderDeleteReparent:
+		return u.deleteReparent(ctx, command.ID)
+	default:
+		return u.deleteReject(ctx, command.ID)
+	}
+}
+
+func (u *deleteFolderHandler) deleteReject(ctx context.Context, folderID string) error {
+	id, _ := primitive.ObjectIDFromHex(folderID)
+	hasChildren, err := u.folderRepo.Exists(ctx, bson.M{"parent_id": id})
+	if err != nil {
+		return err
+	}
+
+	if hasChildren {
+		return errors.Wrap(httpPkg.Conflict, "folder has child folders")
+	}
+
+	hasClusters, err := u.clusterRepo.Exists(ctx, bson.M{"folder_id": id})
+	if err != nil {
+		return err
 	}
 
-	if ok, err := u.folderRepo.Delete(ctx, command.ID); !ok || err != nil {
+	if hasClusters {
+		return errors.Wrap(httpPkg.Conflict, "folder has clusters")
+	}
+
+	hasTopics, err := u.topicRepo.Exists(ctx, bson.M{"folder_id": id})
+	if err != nil {
+		return err
+	}
+
+	if hasTopics {
+		return errors.Wrap(httpPkg.Conflict, "folder has topics")
+	}
+
+	if ok, err := u.folderRepo.Delete(ctx, folderID); !ok || err != nil {
 		u.log.Errorf("(DeleteFolderCommandHandler.Handle) err: {%v}", err)
 		return errors.New("failed to delete folder")
 	}
 
 	return nil
 }
+
+func (u *deleteFolderHandler) deleteCascade(ctx context.Context, folderID string) error {
+	id, _ := primitive.ObjectIDFromHex(folderID)
+	descendants, err := u.folderRepo.GetDescendants(ctx, folderID)
+	if err != nil {
+		return err
+	}
+
+	// Every folder that goes away with the subtree has to be writable by the caller
+	permissions, err := u.folderAccess.Permissions(ctx, descendants)
+	if err != nil {
+		return err
+	}
+
+	folderIDs := make([]primitive.ObjectID, 0, len(descendants)+1)
+	folderIDs = append(folderIDs, id)
+	for _, d := range descendants {
+		if !permissions[d.ID].Includes(constants.FolderPermissionWrite) {
+			return errors.Wrapf(httpPkg.Forbidden, "write permission required on folder %s", d.ID.Hex())
+		}
+		folderIDs = append(folderIDs, d.ID)
+	}
+
+	// The subtree with its clusters and topics goes to the trash as one unit, restored or
+	// purged together
+	return u.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if _, err := u.clusterRepo.DeleteByFolderIDs(ctx, folderIDs, id); err != nil {
+			u.log.Errorf("(DeleteFolderCommandHandler.deleteCascade) err: {%v}", err)
+			return errors.Wrap(err, "failed to delete clusters")
+		}
+
+		if _, err := u.topicRepo.DeleteByFolderIDs(ctx, folderIDs, id); err != nil {
+			u.log.Errorf("(DeleteFolderCommandHandler.deleteCascade) err: {%v}", err)
+			return errors.Wrap(err, "failed to delete topics")
+		}
+
+		if _, err := u.folderRepo.DeleteMany(ctx, folderIDs, id); err != nil {
+			u.log.Errorf("(DeleteFolderCommandHandler.deleteCascade) err: {%v}", err)
+			return errors.Wrap(err, "failed to delete folders")
+		}
+
+		return nil
+	})
+}
+
+func (u *deleteFolderHandler) deleteReparent(ctx context.Context, folderID string) error {
+	folder, err := u.folderRepo.GetByID(ctx, folderID)
+	if err != nil {
+		return err
+	}
+
+	if folder.ParentID == nil {
+		if err := u.ensureNoClusters(ctx, folder); err != nil {
+			return err
+		}
+	}
+
+	// The grants of the deleted folder stop restricting its children
+	if len(folder.ACL) > 0 {
+		if err := u.folderAccess.Require(ctx, folder, constants.FolderPermissionManage); err != nil {
+			return err
+		}
+	}
+
+	return u.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if _, err := u.folderRepo.ChangeParent(ctx, folder.ID, folder.ParentID); err != nil {
+			u.log.Errorf("(DeleteFolderCommandHandler.deleteReparent) err: {%v}", err)
+			return errors.Wrap(err, "failed to reparent child folders")
+		}
+
+		if _, err := u.folderRepo.RemoveAncestor(ctx, folder.ID); err != nil {
+			u.log.Errorf("(DeleteFolderCommandHandler.deleteReparent) err: {%v}", err)
+			return errors.Wrap(err, "failed to update descendant paths")
+		}
+
+		if folder.ParentID != nil {
+			if _, err := u.clusterRepo.ChangeFolder(ctx, folder.ID, *folder.ParentID); err != nil {
+				u.log.Errorf("(DeleteFolderCommandHandler.deleteReparent) err: {%v}", err)
+				return errors.Wrap(err, "failed to reparent clusters")
+			}
+		}
+
+		// Topics need no folder, those of a root folder are taken out of the tree
+		if _, err := u.topicRepo.ChangeFolder(ctx, folder.ID, folder.ParentID); err != nil {
+			u.log.Errorf("(DeleteFolderCommandHandler.deleteReparent) err: {%v}", err)
+			return errors.Wrap(err, "failed to reparent topics")
+		}
+
+		if ok, err := u.folderRepo.Delete(ctx, folderID); !ok || err != nil {
+			u.log.Errorf("(DeleteFolderCommandHandler.deleteReparent) err: {%v}", err)
+			return errors.New("failed to delete folder")
+		}
+
+		return nil
+	})
+}
+
+// ensureNoClusters guards reparenting a root folder, since clusters always need a folder
+func (u *deleteFolderHandler) ensureNoClusters(ctx context.Context, folder *models.Folder) error {
+	hasClusters, err := u.clusterRepo.Exists(ctx, bson.M{"folder_id": folder.ID})
+	if err != nil {
+		return err
+	}
+
+	if hasClusters {
+		return errors.Wrap(httpPkg.Conflict, "clusters of a root folder have no parent to move to")
+	}
+
+	return nil
+}
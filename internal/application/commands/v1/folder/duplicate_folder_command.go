@@ -0,0 +1,16 @@
+package folder
+
+type DuplicateFolderCommand struct {
+	ID         string `json:"id" validate:"required"`
+	FolderName *string
+	// ParentID places the copy: nil keeps the source's parent, an empty string means root
+	ParentID *string
+}
+
+func NewDuplicateFolderCommand(id string, folderName *string, parentID *string) *DuplicateFolderCommand {
+	return &DuplicateFolderCommand{
+		ID:         id,
+		FolderName: folderName,
+		ParentID:   parentID,
+	}
+}
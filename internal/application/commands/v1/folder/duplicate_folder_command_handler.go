@@ -0,0 +1,257 @@
+package folder
+
+import (
+	"context"
+	"gallery-service/internal/application/access"
+	folderResponses "gallery-service/internal/application/dto/responses/folder"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/tenant"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type DuplicateFolderCommandHandler interface {
+	Handle(ctx context.Context, command *DuplicateFolderCommand) (*folderResponses.DuplicateFolderResponseDto, error)
+}
+
+type duplicateFolderHandler struct {
+	log          zap.Logger
+	folderRepo   repository.FolderRepository
+	folderAccess access.FolderAccess
+	clusterRepo  repository.ClusterRepository
+	txManager    repository.TransactionManager
+}
+
+func NewDuplicateFolderHandler(
+	log zap.Logger,
+	folderRepo repository.FolderRepository,
+	folderAccess access.FolderAccess,
+	clusterRepo repository.ClusterRepository,
+	txManager repository.TransactionManager,
+) *duplicateFolderHandler {
+	return &duplicateFolderHandler{
+		log:          log,
+		folderRepo:   folderRepo,
+		folderAccess: folderAccess,
+		clusterRepo:  clusterRepo,
+		txManager:    txManager,
+	}
+}
+
+func (d *duplicateFolderHandler) Handle(ctx context.Context, command *DuplicateFolderCommand) (*folderResponses.DuplicateFolderResponseDto, error) {
+	source, err := d.folderRepo.GetByID(ctx, command.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.folderAccess.Require(ctx, source, constants.FolderPermissionRead); err != nil {
+		return nil, err
+	}
+
+	parentID := source.ParentID
+	ancestors := source.Ancestors
+	if command.ParentID == nil && parentID != nil {
+		if !tenant.FromContext(ctx).CanWrite(source.OrganizationID) {
+			return nil, errors.Wrap(httpPkg.Forbidden, "cannot copy folders into this parent, pick another parent_id")
+		}
+
+		parent, err := d.folderRepo.GetByID(ctx, parentID.Hex())
+		if err != nil {
+			return nil, errors.New("parent folder not found")
+		}
+
+		if err := d.folderAccess.Require(ctx, parent, constants.FolderPermissionWrite); err != nil {
+			return nil, err
+		}
+	}
+
+	if command.ParentID != nil {
+		parentID = nil
+		ancestors = make([]primitive.ObjectID, 0)
+
+		if *command.ParentID != "" {
+			pID, err := primitive.ObjectIDFromHex(*command.ParentID)
+			if err != nil {
+				return nil, errors.Wrap(httpPkg.BadRequest, "invalid parent id")
+			}
+
+			parent, err := d.folderRepo.GetByID(ctx, pID.Hex())
+			if err != nil {
+				return nil, errors.New("parent folder not found")
+			}
+
+			if !tenant.FromContext(ctx).CanWrite(parent.OrganizationID) {
+				return nil, errors.Wrap(httpPkg.Forbidden, "cannot copy folders into this parent")
+			}
+
+			if err := d.folderAccess.Require(ctx, parent, constants.FolderPermissionWrite); err != nil {
+				return nil, err
+			}
+
+			parentID = &parent.ID
+			ancestors = parent.PathFromRoot()
+		}
+	}
+
+	// The copy is appended after the existing children of its parent,
+	// the folders and clusters below it keep the positions of the originals
+	position, err := d.folderRepo.GetNextPosition(ctx, parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	descendants, err := d.readableDescendants(ctx, command.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Allocate every new id up front so paths can be remapped in a single pass
+	folderIDs := make(map[primitive.ObjectID]primitive.ObjectID, len(descendants)+1)
+	folderIDs[source.ID] = primitive.NewObjectID()
+	for _, f := range descendants {
+		folderIDs[f.ID] = primitive.NewObjectID()
+	}
+
+	root := *source
+	root.ID = folderIDs[source.ID]
+	root.ParentID = parentID
+	root.Ancestors = append(make([]primitive.ObjectID, 0, len(ancestors)), ancestors...)
+	root.Depth = len(root.Ancestors)
+	root.Position = position
+	root.FolderThumbnailKey = copyThumbnailKey(source.FolderThumbnailKey, root.ID)
+	if command.FolderName != nil && *command.FolderName != "" {
+		root.FolderName = *command.FolderName
+	}
+
+	folders := make([]*models.Folder, 0, len(descendants)+1)
+	folders = append(folders, &root)
+	for _, f := range descendants {
+		folders = append(folders, d.copyDescendant(f, source.ID, root, folderIDs))
+	}
+
+	oldFolderIDs := make([]primitive.ObjectID, 0, len(folderIDs))
+	for oldID := range folderIDs {
+		oldFolderIDs = append(oldFolderIDs, oldID)
+	}
+
+	sourceClusters, err := d.clusterRepo.GetByFolderIDs(ctx, oldFolderIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	clusterIDs := make(map[string]string, len(sourceClusters))
+	clusters := make([]*models.Cluster, 0, len(sourceClusters))
+	for _, c := range sourceClusters {
+		cluster := *c
+		cluster.ID = primitive.NewObjectID()
+		cluster.FolderID = folderIDs[c.FolderID]
+		cluster.CreatedAt = now
+		cluster.UpdatedAt = now
+
+		clusterIDs[c.ID.Hex()] = cluster.ID.Hex()
+		clusters = append(clusters, &cluster)
+	}
+
+	err = d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := d.folderRepo.InsertMany(ctx, folders); err != nil {
+			return errors.Wrap(err, "failed to copy folders")
+		}
+
+		if len(clusters) > 0 {
+			if err := d.clusterRepo.InsertMany(ctx, clusters); err != nil {
+				return errors.Wrap(err, "failed to copy clusters")
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		d.log.Errorf("(DuplicateFolderCommandHandler.Handle) id: {%s}, err: {%v}", command.ID, err)
+		return nil, err
+	}
+
+	res := &folderResponses.DuplicateFolderResponseDto{
+		FolderID:   root.ID.Hex(),
+		FolderIDs:  make(map[string]string, len(folderIDs)),
+		ClusterIDs: clusterIDs,
+	}
+	for oldID, newID := range folderIDs {
+		res.FolderIDs[oldID.Hex()] = newID.Hex()
+	}
+
+	return res, nil
+}
+
+// readableDescendants leaves out the folders the caller can't read. A folder is never
+// more open than its parent, so this drops whole subtrees.
+func (d *duplicateFolderHandler) readableDescendants(ctx context.Context, folderID string) ([]*models.Folder, error) {
+	descendants, err := d.folderRepo.GetDescendants(ctx, folderID)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := d.folderAccess.Permissions(ctx, descendants)
+	if err != nil {
+		return nil, err
+	}
+
+	readable := make([]*models.Folder, 0, len(descendants))
+	for _, f := range descendants {
+		if permissions[f.ID].Includes(constants.FolderPermissionRead) {
+			readable = append(readable, f)
+		}
+	}
+
+	return readable, nil
+}
+
+// copyDescendant copies a folder below the source, re-rooting the part of its path
+// under the source onto the copied root
+func (d *duplicateFolderHandler) copyDescendant(
+	f *models.Folder,
+	sourceID primitive.ObjectID,
+	root models.Folder,
+	folderIDs map[primitive.ObjectID]primitive.ObjectID,
+) *models.Folder {
+	folder := *f
+	folder.ID = folderIDs[f.ID]
+	folder.FolderThumbnailKey = copyThumbnailKey(f.FolderThumbnailKey, folder.ID)
+
+	ancestors := root.PathFromRoot()
+	below := false
+	for _, id := range f.Ancestors {
+		if below {
+			ancestors = append(ancestors, folderIDs[id])
+		}
+		if id == sourceID {
+			below = true
+		}
+	}
+
+	parentID := ancestors[len(ancestors)-1]
+	folder.ParentID = &parentID
+	folder.Ancestors = ancestors
+	folder.Depth = len(ancestors)
+
+	return &folder
+}
+
+// copyThumbnailKey gives a copied folder a thumbnail key of its own, as the key is unique
+// per folder. The copy shows the same image, so the key is the stored object's key, the
+// part before any #, followed by the id of the copy.
+func copyThumbnailKey(key string, folderID primitive.ObjectID) string {
+	if key == "" {
+		return ""
+	}
+
+	object, _, _ := strings.Cut(key, "#")
+	return object + "#" + folderID.Hex()
+}
@@ -0,0 +1,19 @@
+package folder
+
+import "gallery-service/internal/pkg/constants"
+
+type GrantFolderAccessCommand struct {
+	ID         string                     `json:"id" validate:"required"`
+	UserID     string                     `json:"user_id" validate:"required_without=Role,excluded_with=Role"`
+	Role       string                     `json:"role" validate:"required_without=UserID"`
+	Permission constants.FolderPermission `json:"permission" validate:"required,oneof=read write manage"`
+}
+
+func NewGrantFolderAccessCommand(id string, userID string, role string, permission constants.FolderPermission) *GrantFolderAccessCommand {
+	return &GrantFolderAccessCommand{
+		ID:         id,
+		UserID:     userID,
+		Role:       role,
+		Permission: permission,
+	}
+}
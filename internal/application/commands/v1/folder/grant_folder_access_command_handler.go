@@ -0,0 +1,50 @@
+package folder
+
+import (
+	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/pkg/zap"
+)
+
+type GrantFolderAccessCommandHandler interface {
+	Handle(ctx context.Context, command *GrantFolderAccessCommand) error
+}
+
+type grantFolderAccessHandler struct {
+	log          zap.Logger
+	folderRepo   repository.FolderRepository
+	folderAccess access.FolderAccess
+}
+
+func NewGrantFolderAccessHandler(
+	log zap.Logger,
+	folderRepo repository.FolderRepository,
+	folderAccess access.FolderAccess,
+) *grantFolderAccessHandler {
+	return &grantFolderAccessHandler{
+		log:          log,
+		folderRepo:   folderRepo,
+		folderAccess: folderAccess,
+	}
+}
+
+// Handle adds the grant to the folder ACL, replacing an earlier grant to the same user or role
+func (u *grantFolderAccessHandler) Handle(ctx context.Context, command *GrantFolderAccessCommand) error {
+	folder, err := u.folderRepo.GetByID(ctx, command.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := u.folderAccess.Require(ctx, folder, constants.FolderPermissionManage); err != nil {
+		return err
+	}
+
+	return u.folderRepo.SetGrant(ctx, folder.ID, models.FolderGrant{
+		UserID:     command.UserID,
+		Role:       command.Role,
+		Permission: command.Permission,
+	})
+}
@@ -0,0 +1,27 @@
+package folder
+
+import (
+	"gallery-service/internal/application/bundle"
+	"gallery-service/internal/pkg/constants"
+)
+
+type ImportFolderCommand struct {
+	Bundle *bundle.Bundle `json:"bundle" validate:"required"`
+	// ParentID is the folder the root folder of the bundle goes under, empty meaning root
+	ParentID   string
+	OnConflict constants.BundleConflict `json:"on_conflict" validate:"required,oneof=skip overwrite"`
+	DryRun     bool
+}
+
+func NewImportFolderCommand(b *bundle.Bundle, parentID string, onConflict constants.BundleConflict, dryRun bool) *ImportFolderCommand {
+	if onConflict == "" {
+		onConflict = constants.BundleConflictSkip
+	}
+
+	return &ImportFolderCommand{
+		Bundle:     b,
+		ParentID:   parentID,
+		OnConflict: onConflict,
+		DryRun:     dryRun,
+	}
+}
@@ -0,0 +1,542 @@
+package folder
+
+import (
+	"context"
+	"fmt"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/application/bundle"
+	folderResponses "gallery-service/internal/application/dto/responses/folder"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/tenant"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ImportFolderCommandHandler interface {
+	Handle(ctx context.Context, command *ImportFolderCommand) (*folderResponses.ImportFolderResponseDto, error)
+}
+
+type importFolderHandler struct {
+	log          zap.Logger
+	folderRepo   repository.FolderRepository
+	folderAccess access.FolderAccess
+	clusterRepo  repository.ClusterRepository
+	topicRepo    repository.TopicRepository
+	taxonomyRepo repository.TaxonomyRepository
+	txManager    repository.TransactionManager
+}
+
+func NewImportFolderHandler(
+	log zap.Logger,
+	folderRepo repository.FolderRepository,
+	folderAccess access.FolderAccess,
+	clusterRepo repository.ClusterRepository,
+	topicRepo repository.TopicRepository,
+	taxonomyRepo repository.TaxonomyRepository,
+	txManager repository.TransactionManager,
+) *importFolderHandler {
+	return &importFolderHandler{
+		log:          log,
+		folderRepo:   folderRepo,
+		folderAccess: folderAccess,
+		clusterRepo:  clusterRepo,
+		topicRepo:    topicRepo,
+		taxonomyRepo: taxonomyRepo,
+		txManager:    txManager,
+	}
+}
+
+// importPlan is what an import writes. Every entry of the bundle gets a new id, except
+// the folders merged into an existing folder of the same name and the clusters and
+// topics whose name is taken, which the conflict mode skips or overwrites.
+type importPlan struct {
+	now      time.Time
+	terms    map[primitive.ObjectID]primitive.ObjectID
+	placed   map[primitive.ObjectID]*models.Folder
+	merged   map[primitive.ObjectID]bool
+	folders  []*models.Folder
+	clusters []*models.Cluster
+	topics   []*models.Topic
+
+	clusterPatches map[primitive.ObjectID]map[string]interface{}
+	topicPatches   map[primitive.ObjectID]map[string]interface{}
+}
+
+func (h *importFolderHandler) Handle(ctx context.Context, command *ImportFolderCommand) (*folderResponses.ImportFolderResponseDto, error) {
+	b := command.Bundle
+
+	var parent *models.Folder
+	if command.ParentID != "" {
+		if _, err := primitive.ObjectIDFromHex(command.ParentID); err != nil {
+			return nil, errors.Wrap(httpPkg.BadRequest, "invalid parent id")
+		}
+
+		p, err := h.folderRepo.GetByID(ctx, command.ParentID)
+		if err != nil {
+			return nil, errors.New("parent folder not found")
+		}
+
+		if !tenant.FromContext(ctx).CanWrite(p.OrganizationID) {
+			return nil, errors.Wrap(httpPkg.Forbidden, "cannot import into this parent")
+		}
+
+		if err := h.folderAccess.Require(ctx, p, constants.FolderPermissionWrite); err != nil {
+			return nil, err
+		}
+		parent = p
+	}
+
+	terms, missing, err := bundle.ResolveTerms(ctx, h.taxonomyRepo, b.TaxonomyTerms)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &folderResponses.ImportFolderResponseDto{
+		DryRun:   command.DryRun,
+		Folders:  make([]folderResponses.ImportEntryDto, 0, len(b.Folders)),
+		Clusters: make([]folderResponses.ImportEntryDto, 0, len(b.Clusters)),
+		Topics:   make([]folderResponses.ImportEntryDto, 0, len(b.Topics)),
+		Warnings: make([]string, 0, len(missing)),
+	}
+	for _, ref := range missing {
+		res.Warnings = append(res.Warnings, fmt.Sprintf("taxonomy term %s > %s not found, left out", ref.Kind, strings.Join(ref.Path, " > ")))
+	}
+
+	plan := &importPlan{
+		now:            time.Now(),
+		terms:          terms,
+		placed:         make(map[primitive.ObjectID]*models.Folder, len(b.Folders)),
+		merged:         make(map[primitive.ObjectID]bool),
+		clusterPatches: make(map[primitive.ObjectID]map[string]interface{}),
+		topicPatches:   make(map[primitive.ObjectID]map[string]interface{}),
+	}
+
+	if err := h.planFolders(ctx, b, parent, plan, res); err != nil {
+		return nil, err
+	}
+
+	if err := h.planClusters(ctx, b, command.OnConflict, plan, res); err != nil {
+		return nil, err
+	}
+
+	if err := h.planTopics(ctx, b, command.OnConflict, plan, res); err != nil {
+		return nil, err
+	}
+
+	rootID, _ := primitive.ObjectIDFromHex(b.Manifest.RootFolderID)
+	if root := plan.placed[rootID]; root != nil && (!command.DryRun || plan.merged[rootID]) {
+		res.FolderID = root.ID.Hex()
+	}
+
+	if command.DryRun {
+		return res, nil
+	}
+
+	err = h.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		return h.write(ctx, plan)
+	})
+	if err != nil {
+		h.log.Errorf("(ImportFolderCommandHandler.Handle) root: {%s}, err: {%v}", b.Manifest.RootFolderID, err)
+		return nil, err
+	}
+
+	h.log.Infof("(ImportFolderCommandHandler.Handle) root: {%s}, folders: {%d}, clusters: {%d}, topics: {%d}", res.FolderID, len(plan.folders), len(plan.clusters), len(plan.topics))
+
+	return res, nil
+}
+
+// planFolders walks the bundle from its root down. A folder merges into the writable
+// folder of the same name under its target parent, the others are created and keep
+// their order. The root folder and the folders created in a merged one go after the
+// existing children.
+func (h *importFolderHandler) planFolders(
+	ctx context.Context,
+	b *bundle.Bundle,
+	parent *models.Folder,
+	plan *importPlan,
+	res *folderResponses.ImportFolderResponseDto,
+) error {
+	rootID, _ := primitive.ObjectIDFromHex(b.Manifest.RootFolderID)
+	children := make(map[primitive.ObjectID][]*models.Folder, len(b.Folders))
+	var root *models.Folder
+	for _, f := range b.Folders {
+		if f.ID == rootID {
+			root = f
+		} else {
+			children[*f.ParentID] = append(children[*f.ParentID], f)
+		}
+	}
+
+	nextPositions := make(map[primitive.ObjectID]int64)
+	queue := []*models.Folder{root}
+	for len(queue) > 0 {
+		f := queue[0]
+		queue = queue[1:]
+		queue = append(queue, children[f.ID]...)
+
+		target, intoExisting := parent, true
+		if f.ID != rootID {
+			target, intoExisting = plan.placed[*f.ParentID], plan.merged[*f.ParentID]
+		}
+
+		var targetID *primitive.ObjectID
+		ancestors := make([]primitive.ObjectID, 0)
+		if target != nil {
+			targetID = &target.ID
+			ancestors = target.PathFromRoot()
+		}
+
+		if intoExisting {
+			existing, err := h.writableChild(ctx, targetID, f.FolderName)
+			if err != nil {
+				return err
+			}
+
+			if existing != nil {
+				plan.placed[f.ID] = existing
+				plan.merged[f.ID] = true
+				res.Folders = append(res.Folders, importEntry(f.ID, existing.ID.Hex(), f.FolderName, constants.ImportActionMerge))
+				continue
+			}
+		}
+
+		position := f.Position
+		if intoExisting {
+			key := primitive.NilObjectID
+			if targetID != nil {
+				key = *targetID
+			}
+
+			next, ok := nextPositions[key]
+			if !ok {
+				var err error
+				if next, err = h.folderRepo.GetNextPosition(ctx, targetID); err != nil {
+					return err
+				}
+			}
+			position = next
+			nextPositions[key] = next + 1
+		}
+
+		// The bundle may come from this very gallery, where its folders keep their
+		// thumbnail keys
+		folderID := primitive.NewObjectID()
+		folder := &models.Folder{
+			ID:                 folderID,
+			FolderName:         f.FolderName,
+			FolderThumbnailKey: copyThumbnailKey(f.FolderThumbnailKey, folderID),
+			FolderThumbnailURL: f.FolderThumbnailURL,
+			ParentID:           targetID,
+			Ancestors:          ancestors,
+			Depth:              len(ancestors),
+			Position:           position,
+		}
+
+		plan.placed[f.ID] = folder
+		plan.folders = append(plan.folders, folder)
+		res.Folders = append(res.Folders, importEntry(f.ID, plan.createdID(res, folder.ID), f.FolderName, constants.ImportActionCreate))
+	}
+
+	return nil
+}
+
+// writableChild returns the folder named name under parentID the caller can write to
+func (h *importFolderHandler) writableChild(ctx context.Context, parentID *primitive.ObjectID, name string) (*models.Folder, error) {
+	siblings, err := h.folderRepo.GetChildren(ctx, parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	named := make([]*models.Folder, 0)
+	for _, s := range siblings {
+		if s.FolderName == name {
+			named = append(named, s)
+		}
+	}
+
+	if len(named) == 0 {
+		return nil, nil
+	}
+
+	permissions, err := h.folderAccess.Permissions(ctx, named)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range named {
+		if permissions[s.ID].Includes(constants.FolderPermissionWrite) {
+			return s, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// planClusters places every cluster in the folder its folder became. Only clusters
+// going into a merged folder can conflict with an existing cluster of the same name.
+func (h *importFolderHandler) planClusters(
+	ctx context.Context,
+	b *bundle.Bundle,
+	onConflict constants.BundleConflict,
+	plan *importPlan,
+	res *folderResponses.ImportFolderResponseDto,
+) error {
+	mergedIDs := make([]primitive.ObjectID, 0, len(plan.merged))
+	for sourceID := range plan.merged {
+		mergedIDs = append(mergedIDs, plan.placed[sourceID].ID)
+	}
+
+	existing := make(map[primitive.ObjectID]map[string]*models.Cluster)
+	if len(mergedIDs) > 0 {
+		clusters, err := h.clusterRepo.GetByFolderIDs(ctx, mergedIDs)
+		if err != nil {
+			return err
+		}
+
+		for _, c := range clusters {
+			if existing[c.FolderID] == nil {
+				existing[c.FolderID] = make(map[string]*models.Cluster)
+			}
+			if _, ok := existing[c.FolderID][c.ClusterName]; !ok {
+				existing[c.FolderID][c.ClusterName] = c
+			}
+		}
+	}
+
+	nextPositions := make(map[primitive.ObjectID]int64)
+	for _, c := range b.Clusters {
+		folderID := plan.placed[c.FolderID].ID
+		taxonomyTerms := plan.remapTerms(c.TaxonomyTerms)
+
+		if current, ok := existing[folderID][c.ClusterName]; ok {
+			action := constants.ImportActionSkip
+			if onConflict == constants.BundleConflictOverwrite {
+				action = constants.ImportActionOverwrite
+				plan.clusterPatches[current.ID] = map[string]interface{}{
+					"title":           c.Title,
+					"note":            c.Note,
+					"image":           c.Image,
+					"language_config": c.LanguageConfig,
+					"tags":            c.Tags,
+					"taxonomy_terms":  taxonomyTerms,
+					"updated_at":      plan.now,
+				}
+			}
+			res.Clusters = append(res.Clusters, importEntry(c.ID, current.ID.Hex(), c.ClusterName, action))
+			continue
+		}
+
+		position := c.Position
+		if plan.merged[c.FolderID] {
+			next, ok := nextPositions[folderID]
+			if !ok {
+				var err error
+				if next, err = h.clusterRepo.GetNextPosition(ctx, folderID); err != nil {
+					return err
+				}
+			}
+			position = next
+			nextPositions[folderID] = next + 1
+		}
+
+		cluster := &models.Cluster{
+			ID:             primitive.NewObjectID(),
+			ClusterName:    c.ClusterName,
+			Title:          c.Title,
+			Note:           c.Note,
+			Image:          c.Image,
+			LanguageConfig: c.LanguageConfig,
+			Tags:           c.Tags,
+			TaxonomyTerms:  taxonomyTerms,
+			FolderID:       folderID,
+			Position:       position,
+			Hidden:         c.Hidden,
+			CreatedAt:      plan.now,
+			UpdatedAt:      plan.now,
+		}
+
+		plan.clusters = append(plan.clusters, cluster)
+		res.Clusters = append(res.Clusters, importEntry(c.ID, plan.createdID(res, cluster.ID), c.ClusterName, constants.ImportActionCreate))
+	}
+
+	return nil
+}
+
+// planTopics adds the topics after the existing ones, filed in the imported copy of their
+// folder. A topic conflicts with a readable topic of the same name, and overwriting it
+// needs write access to it.
+func (h *importFolderHandler) planTopics(
+	ctx context.Context,
+	b *bundle.Bundle,
+	onConflict constants.BundleConflict,
+	plan *importPlan,
+	res *folderResponses.ImportFolderResponseDto,
+) error {
+	if len(b.Topics) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(b.Topics))
+	for _, t := range b.Topics {
+		names = append(names, t.TopicName)
+	}
+
+	topics, err := h.topicRepo.GetByNames(ctx, names)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]*models.Topic, len(topics))
+	for _, t := range topics {
+		if _, ok := existing[t.TopicName]; !ok {
+			existing[t.TopicName] = t
+		}
+	}
+
+	nextPositions := make(map[primitive.ObjectID]int64)
+	for _, t := range b.Topics {
+		taxonomyTerms := plan.remapTerms(t.TaxonomyTerms)
+
+		// Bundles exported before media items had an ID carry none
+		for i := range t.LanguageConfig {
+			t.LanguageConfig[i].EnsureMediaIDs()
+		}
+
+		if current, ok := existing[t.TopicName]; ok {
+			action := constants.ImportActionSkip
+			if onConflict == constants.BundleConflictOverwrite {
+				if !tenant.FromContext(ctx).CanWrite(current.OrganizationID) {
+					return errors.Wrapf(httpPkg.Forbidden, "cannot overwrite topic %s", t.TopicName)
+				}
+
+				action = constants.ImportActionOverwrite
+				plan.topicPatches[current.ID] = map[string]interface{}{
+					"language_config": t.LanguageConfig,
+					"taxonomy_terms":  taxonomyTerms,
+					"updated_at":      plan.now,
+				}
+			}
+			res.Topics = append(res.Topics, importEntry(t.ID, current.ID.Hex(), t.TopicName, action))
+			continue
+		}
+
+		var folderID primitive.ObjectID
+		if !t.FolderID.IsZero() {
+			folderID = plan.placed[t.FolderID].ID
+		}
+
+		// Topics keep their order in the folders the import creates and go after the
+		// topics already in a merged folder, or outside the folder tree
+		position := t.Position
+		if t.FolderID.IsZero() || plan.merged[t.FolderID] {
+			next, ok := nextPositions[folderID]
+			if !ok {
+				var folder *primitive.ObjectID
+				if !folderID.IsZero() {
+					folder = &folderID
+				}
+
+				var err error
+				if next, err = h.topicRepo.GetNextPosition(ctx, folder); err != nil {
+					return err
+				}
+			}
+			position = next
+			nextPositions[folderID] = next + 1
+		}
+
+		topic := &models.Topic{
+			ID:             primitive.NewObjectID(),
+			TopicName:      t.TopicName,
+			FolderID:       folderID,
+			Status:         t.WorkflowStatus(),
+			IsPublished:    t.WorkflowStatus() == constants.TopicStatusPublished,
+			LanguageConfig: t.LanguageConfig,
+			TaxonomyTerms:  taxonomyTerms,
+			Position:       position,
+			CreatedAt:      plan.now,
+			UpdatedAt:      plan.now,
+		}
+
+		plan.topics = append(plan.topics, topic)
+		res.Topics = append(res.Topics, importEntry(t.ID, plan.createdID(res, topic.ID), t.TopicName, constants.ImportActionCreate))
+	}
+
+	return nil
+}
+
+func (h *importFolderHandler) write(ctx context.Context, plan *importPlan) error {
+	if len(plan.folders) > 0 {
+		if err := h.folderRepo.InsertMany(ctx, plan.folders); err != nil {
+			return errors.Wrap(err, "failed to import folders")
+		}
+	}
+
+	if len(plan.clusters) > 0 {
+		if err := h.clusterRepo.InsertMany(ctx, plan.clusters); err != nil {
+			return errors.Wrap(err, "failed to import clusters")
+		}
+	}
+
+	for id, set := range plan.clusterPatches {
+		if err := h.clusterRepo.Patch(ctx, id, set, nil); err != nil {
+			return errors.Wrap(err, "failed to overwrite cluster")
+		}
+	}
+
+	if len(plan.topics) > 0 {
+		if err := h.topicRepo.InsertMany(ctx, plan.topics); err != nil {
+			return errors.Wrap(err, "failed to import topics")
+		}
+	}
+
+	for id, set := range plan.topicPatches {
+		if err := h.topicRepo.Patch(ctx, id, set, nil); err != nil {
+			return errors.Wrap(err, "failed to overwrite topic")
+		}
+	}
+
+	return nil
+}
+
+// remapTerms swaps the term ids of the bundle for the ones of this environment, leaving
+// out the terms that could not be resolved
+func (p *importPlan) remapTerms(ids []primitive.ObjectID) []primitive.ObjectID {
+	remapped := make([]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		if target, ok := p.terms[id]; ok {
+			remapped = append(remapped, target)
+		}
+	}
+
+	if len(remapped) == 0 {
+		return nil
+	}
+
+	return remapped
+}
+
+// createdID is the id reported for a created entry, none on a dry run
+func (p *importPlan) createdID(res *folderResponses.ImportFolderResponseDto, id primitive.ObjectID) string {
+	if res.DryRun {
+		return ""
+	}
+
+	return id.Hex()
+}
+
+func importEntry(sourceID primitive.ObjectID, targetID string, name string, action constants.ImportAction) folderResponses.ImportEntryDto {
+	return folderResponses.ImportEntryDto{
+		SourceID: sourceID.Hex(),
+		TargetID: targetID,
+		Name:     name,
+		Action:   string(action),
+	}
+}
@@ -0,0 +1,13 @@
+package folder
+
+type MoveFolderCommand struct {
+	ID       string `json:"id" validate:"required"`
+	ParentID *string
+}
+
+func NewMoveFolderCommand(id string, parentID *string) *MoveFolderCommand {
+	return &MoveFolderCommand{
+		ID:       id,
+		ParentID: parentID,
+	}
+}
@@ -0,0 +1,101 @@
+package folder
+
+import (
+	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/tenant"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type MoveFolderCommandHandler interface {
+	Handle(ctx context.Context, command *MoveFolderCommand) error
+}
+
+type moveFolderHandler struct {
+	log          zap.Logger
+	folderRepo   repository.FolderRepository
+	folderAccess access.FolderAccess
+	txManager    repository.TransactionManager
+}
+
+func NewMoveFolderHandler(
+	log zap.Logger,
+	folderRepo repository.FolderRepository,
+	folderAccess access.FolderAccess,
+	txManager repository.TransactionManager,
+) *moveFolderHandler {
+	return &moveFolderHandler{
+		log:          log,
+		folderRepo:   folderRepo,
+		folderAccess: folderAccess,
+		txManager:    txManager,
+	}
+}
+
+func (u *moveFolderHandler) Handle(ctx context.Context, command *MoveFolderCommand) error {
+	folder, err := u.folderRepo.GetByID(ctx, command.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := u.folderAccess.Require(ctx, folder, constants.FolderPermissionWrite); err != nil {
+		return err
+	}
+
+	// A nil or empty parent moves the folder to the root
+	if command.ParentID == nil || *command.ParentID == "" {
+		return u.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+			position, err := u.folderRepo.GetNextPosition(ctx, nil)
+			if err != nil {
+				return err
+			}
+
+			return u.folderRepo.UpdateParent(ctx, command.ID, nil, nil, position)
+		})
+	}
+
+	parentID, err := primitive.ObjectIDFromHex(*command.ParentID)
+	if err != nil {
+		return errors.Wrap(httpPkg.BadRequest, "invalid parent id")
+	}
+
+	if parentID == folder.ID {
+		return errors.Wrap(httpPkg.BadRequest, "a folder cannot be its own parent")
+	}
+
+	parent, err := u.folderRepo.GetByID(ctx, parentID.Hex())
+	if err != nil {
+		return errors.New("parent folder not found")
+	}
+
+	if !tenant.FromContext(ctx).CanWrite(parent.OrganizationID) {
+		return errors.Wrap(httpPkg.Forbidden, "cannot move folders into this parent")
+	}
+
+	if err := u.folderAccess.Require(ctx, parent, constants.FolderPermissionWrite); err != nil {
+		return err
+	}
+
+	// The new parent must not live inside the subtree being moved
+	for _, id := range parent.Ancestors {
+		if id == folder.ID {
+			return errors.Wrap(httpPkg.BadRequest, "cannot move a folder into its own subtree")
+		}
+	}
+
+	// The moved folder is appended after the existing children of its new parent
+	return u.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		position, err := u.folderRepo.GetNextPosition(ctx, &parent.ID)
+		if err != nil {
+			return err
+		}
+
+		return u.folderRepo.UpdateParent(ctx, command.ID, &parent.ID, parent.PathFromRoot(), position)
+	})
+}
@@ -0,0 +1,14 @@
+package folder
+
+// PatchFolderCommand carries a JSON merge patch (RFC 7396) for a folder
+type PatchFolderCommand struct {
+	ID    string `json:"id" validate:"required"`
+	Patch []byte `json:"patch" validate:"required"`
+}
+
+func NewPatchFolderCommand(id string, patch []byte) *PatchFolderCommand {
+	return &PatchFolderCommand{
+		ID:    id,
+		Patch: patch,
+	}
+}
@@ -0,0 +1,121 @@
+package folder
+
+import (
+	"context"
+	"gallery-service/internal/application/access"
+	requests "gallery-service/internal/application/dto/requests/folder"
+	"gallery-service/internal/application/patch"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+)
+
+// folderPatchFields are the stored fields a folder patch can change. The parent is
+// changed by moving the folder.
+var folderPatchFields = []string{"folder_name", "folder_thumbnail_key", "folder_thumbnail_url"}
+
+type PatchFolderCommandHandler interface {
+	Handle(ctx context.Context, command *PatchFolderCommand) error
+}
+
+type patchFolderHandler struct {
+	log          zap.Logger
+	val          patch.Validator
+	folderRepo   repository.FolderRepository
+	folderAccess access.FolderAccess
+}
+
+func NewPatchFolderHandler(
+	log zap.Logger,
+	val patch.Validator,
+	folderRepo repository.FolderRepository,
+	folderAccess access.FolderAccess,
+) *patchFolderHandler {
+	return &patchFolderHandler{
+		log:          log,
+		val:          val,
+		folderRepo:   folderRepo,
+		folderAccess: folderAccess,
+	}
+}
+
+// Handle merges the patch into the folder as the update request would send it, checks
+// the result like an update and saves only the fields that changed
+func (u *patchFolderHandler) Handle(ctx context.Context, command *PatchFolderCommand) error {
+	folder, err := u.folderRepo.GetByID(ctx, command.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := u.folderAccess.Require(ctx, folder, constants.FolderPermissionWrite); err != nil {
+		return err
+	}
+
+	document := folderDocument(folder)
+	var merged requests.UpdateFolderReqDto
+	if err := patch.Apply(document, command.Patch, &merged); err != nil {
+		return err
+	}
+
+	if err := u.val.DataValidation(merged); err != nil {
+		return err
+	}
+
+	if merged.ID != document.ID {
+		return errors.Wrap(httpPkg.BadRequest, "id cannot be changed")
+	}
+
+	if !sameParent(merged.ParentID, document.ParentID) {
+		return errors.Wrap(httpPkg.BadRequest, "parent_id is changed by moving the folder")
+	}
+
+	patched := *folder
+	patched.FolderName = merged.FolderName
+	patched.FolderThumbnailKey = merged.FolderThumbnailKey
+	patched.FolderThumbnailURL = merged.FolderThumbnailURL
+
+	set, unset, err := patch.Changes(folder, &patched, folderPatchFields...)
+	if err != nil {
+		return err
+	}
+
+	if len(set) == 0 && len(unset) == 0 {
+		return nil
+	}
+
+	return u.folderRepo.Patch(ctx, folder.ID, set, unset)
+}
+
+// folderDocument is the folder in the shape of an update request, the document a patch
+// applies to
+func folderDocument(folder *models.Folder) requests.UpdateFolderReqDto {
+	document := requests.UpdateFolderReqDto{
+		ID:                 folder.ID.Hex(),
+		FolderName:         folder.FolderName,
+		FolderThumbnailKey: folder.FolderThumbnailKey,
+		FolderThumbnailURL: folder.FolderThumbnailURL,
+	}
+	if folder.ParentID != nil {
+		parentID := folder.ParentID.Hex()
+		document.ParentID = &parentID
+	}
+
+	return document
+}
+
+// sameParent treats a missing and an empty parent id both as the root
+func sameParent(a *string, b *string) bool {
+	var x, y string
+	if a != nil {
+		x = *a
+	}
+	if b != nil {
+		y = *b
+	}
+
+	return x == y
+}
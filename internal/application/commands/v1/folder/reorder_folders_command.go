@@ -0,0 +1,13 @@
+package folder
+
+type ReorderFoldersCommand struct {
+	ParentID *string
+	IDs      []string `json:"ids" validate:"required,min=1"`
+}
+
+func NewReorderFoldersCommand(parentID *string, ids []string) *ReorderFoldersCommand {
+	return &ReorderFoldersCommand{
+		ParentID: parentID,
+		IDs:      ids,
+	}
+}
@@ -0,0 +1,80 @@
+package folder
+
+import (
+	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ReorderFoldersCommandHandler interface {
+	Handle(ctx context.Context, command *ReorderFoldersCommand) error
+}
+
+type reorderFoldersHandler struct {
+	log          zap.Logger
+	folderRepo   repository.FolderRepository
+	folderAccess access.FolderAccess
+	txManager    repository.TransactionManager
+}
+
+func NewReorderFoldersHandler(
+	log zap.Logger,
+	folderRepo repository.FolderRepository,
+	folderAccess access.FolderAccess,
+	txManager repository.TransactionManager,
+) *reorderFoldersHandler {
+	return &reorderFoldersHandler{
+		log:          log,
+		folderRepo:   folderRepo,
+		folderAccess: folderAccess,
+		txManager:    txManager,
+	}
+}
+
+func (r *reorderFoldersHandler) Handle(ctx context.Context, command *ReorderFoldersCommand) error {
+	// A nil or empty parent reorders the root folders
+	var parentID *primitive.ObjectID
+	if command.ParentID != nil && *command.ParentID != "" {
+		pID, err := primitive.ObjectIDFromHex(*command.ParentID)
+		if err != nil {
+			return errors.Wrap(httpPkg.BadRequest, "invalid parent id")
+		}
+
+		parent, err := r.folderRepo.GetByID(ctx, pID.Hex())
+		if err != nil {
+			return errors.New("parent folder not found")
+		}
+
+		if err := r.folderAccess.Require(ctx, parent, constants.FolderPermissionWrite); err != nil {
+			return err
+		}
+
+		parentID = &parent.ID
+	}
+
+	seen := make(map[primitive.ObjectID]struct{}, len(command.IDs))
+	folderIDs := make([]primitive.ObjectID, 0, len(command.IDs))
+	for _, id := range command.IDs {
+		folderID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return errors.Wrapf(httpPkg.BadRequest, "invalid folder id %s", id)
+		}
+
+		if _, ok := seen[folderID]; ok {
+			return errors.Wrapf(httpPkg.BadRequest, "duplicate folder id %s", id)
+		}
+
+		seen[folderID] = struct{}{}
+		folderIDs = append(folderIDs, folderID)
+	}
+
+	return r.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		return r.folderRepo.Reorder(ctx, parentID, folderIDs)
+	})
+}
@@ -0,0 +1,15 @@
+package folder
+
+type RevokeFolderAccessCommand struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"user_id" validate:"required_without=Role,excluded_with=Role"`
+	Role   string `json:"role" validate:"required_without=UserID"`
+}
+
+func NewRevokeFolderAccessCommand(id string, userID string, role string) *RevokeFolderAccessCommand {
+	return &RevokeFolderAccessCommand{
+		ID:     id,
+		UserID: userID,
+		Role:   role,
+	}
+}
@@ -0,0 +1,55 @@
+package folder
+
+import (
+	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+)
+
+type RevokeFolderAccessCommandHandler interface {
+	Handle(ctx context.Context, command *RevokeFolderAccessCommand) error
+}
+
+type revokeFolderAccessHandler struct {
+	log          zap.Logger
+	folderRepo   repository.FolderRepository
+	folderAccess access.FolderAccess
+}
+
+func NewRevokeFolderAccessHandler(
+	log zap.Logger,
+	folderRepo repository.FolderRepository,
+	folderAccess access.FolderAccess,
+) *revokeFolderAccessHandler {
+	return &revokeFolderAccessHandler{
+		log:          log,
+		folderRepo:   folderRepo,
+		folderAccess: folderAccess,
+	}
+}
+
+func (u *revokeFolderAccessHandler) Handle(ctx context.Context, command *RevokeFolderAccessCommand) error {
+	folder, err := u.folderRepo.GetByID(ctx, command.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := u.folderAccess.Require(ctx, folder, constants.FolderPermissionManage); err != nil {
+		return err
+	}
+
+	removed, err := u.folderRepo.RemoveGrant(ctx, folder.ID, command.UserID, command.Role)
+	if err != nil {
+		return err
+	}
+
+	if !removed {
+		return errors.New("grant not found")
+	}
+
+	return nil
+}
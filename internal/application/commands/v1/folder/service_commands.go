@@ -1,19 +1,40 @@
 package folder
 
 type Commands struct {
-	CreateFolder CreateFolderCommandHandler
-	UpdateFolder UpdateFolderCommandHandler
-	DeleteFolder DeleteFolderCommandHandler
+	CreateFolder    CreateFolderCommandHandler
+	UpdateFolder    UpdateFolderCommandHandler
+	PatchFolder     PatchFolderCommandHandler
+	DeleteFolder    DeleteFolderCommandHandler
+	MoveFolder      MoveFolderCommandHandler
+	DuplicateFolder DuplicateFolderCommandHandler
+	ReorderFolders  ReorderFoldersCommandHandler
+	GrantAccess     GrantFolderAccessCommandHandler
+	RevokeAccess    RevokeFolderAccessCommandHandler
+	ImportFolder    ImportFolderCommandHandler
 }
 
 func NewFolderCommands(
 	createFolder CreateFolderCommandHandler,
 	updateFolder UpdateFolderCommandHandler,
+	patchFolder PatchFolderCommandHandler,
 	deleteFolder DeleteFolderCommandHandler,
+	moveFolder MoveFolderCommandHandler,
+	duplicateFolder DuplicateFolderCommandHandler,
+	reorderFolders ReorderFoldersCommandHandler,
+	grantAccess GrantFolderAccessCommandHandler,
+	revokeAccess RevokeFolderAccessCommandHandler,
+	importFolder ImportFolderCommandHandler,
 ) *Commands {
 	return &Commands{
-		CreateFolder: createFolder,
-		UpdateFolder: updateFolder,
-		DeleteFolder: deleteFolder,
+		CreateFolder:    createFolder,
+		UpdateFolder:    updateFolder,
+		PatchFolder:     patchFolder,
+		DeleteFolder:    deleteFolder,
+		MoveFolder:      moveFolder,
+		DuplicateFolder: duplicateFolder,
+		ReorderFolders:  reorderFolders,
+		GrantAccess:     grantAccess,
+		RevokeAccess:    revokeAccess,
+		ImportFolder:    importFolder,
 	}
 }
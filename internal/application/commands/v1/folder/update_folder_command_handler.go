@@ -2,8 +2,10 @@ package folder
 
 import (
 	"context"
+	"gallery-service/internal/application/access"
 	"gallery-service/internal/domain/models"
 	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
 	"gallery-service/pkg/zap"
 )
 
@@ -12,17 +14,20 @@ type UpdateFolderCommandHandler interface {
 }
 
 type updateFolderHandler struct {
-	log        zap.Logger
-	folderRepo repository.FolderRepository
+	log          zap.Logger
+	folderRepo   repository.FolderRepository
+	folderAccess access.FolderAccess
 }
 
 func NewUpdateFolderHandler(
 	log zap.Logger,
 	folderRepo repository.FolderRepository,
+	folderAccess access.FolderAccess,
 ) *updateFolderHandler {
 	return &updateFolderHandler{
-		log:        log,
-		folderRepo: folderRepo,
+		log:          log,
+		folderRepo:   folderRepo,
+		folderAccess: folderAccess,
 	}
 }
 
@@ -32,6 +37,10 @@ func (u *updateFolderHandler) Handle(ctx context.Context, command *UpdateFolderC
 		return err
 	}
 
+	if err := u.folderAccess.Require(ctx, folder, constants.FolderPermissionWrite); err != nil {
+		return err
+	}
+
 	t := models.Folder{
 		ID:                 folder.ID,
 		FolderName:         command.FolderName,
@@ -0,0 +1,33 @@
+package registry
+
+import "gallery-service/internal/pkg/constants"
+
+// CreateComponentCommand adds an app component topic languages can be shown with. Media
+// is what a language entry needs for the component. It goes last in display order when
+// Position is nil.
+type CreateComponentCommand struct {
+	Key          string                     `json:"key" validate:"required"`
+	Name         string                     `json:"name" validate:"required"`
+	DisplayNames map[string]string          `json:"display_names"`
+	Media        constants.MediaRequirement `json:"media"`
+	Position     *int64                     `json:"position"`
+	Enabled      bool                       `json:"enabled"`
+}
+
+func NewCreateComponentCommand(
+	key string,
+	name string,
+	displayNames map[string]string,
+	media constants.MediaRequirement,
+	position *int64,
+	enabled bool,
+) *CreateComponentCommand {
+	return &CreateComponentCommand{
+		Key:          key,
+		Name:         name,
+		DisplayNames: displayNames,
+		Media:        media,
+		Position:     position,
+		Enabled:      enabled,
+	}
+}
@@ -0,0 +1,83 @@
+package registry
+
+import (
+	"context"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+)
+
+type CreateComponentCommandHandler interface {
+	Handle(ctx context.Context, command *CreateComponentCommand) (*string, error)
+}
+
+type createComponentHandler struct {
+	log          zap.Logger
+	registryRepo repository.RegistryRepository
+}
+
+func NewCreateComponentHandler(
+	log zap.Logger,
+	registryRepo repository.RegistryRepository,
+) *createComponentHandler {
+	return &createComponentHandler{
+		log:          log,
+		registryRepo: registryRepo,
+	}
+}
+
+func (c *createComponentHandler) Handle(ctx context.Context, command *CreateComponentCommand) (*string, error) {
+	if err := requireRegistryAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	components, err := c.registryRepo.GetComponents(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	positions := make([]int64, 0, len(components))
+	for _, component := range components {
+		if component.Key == command.Key {
+			return nil, errors.Wrapf(httpPkg.Conflict, "component %s already exists", command.Key)
+		}
+		if component.Name.String() == command.Name {
+			return nil, errors.Wrapf(httpPkg.Conflict, "component %s already exists", command.Name)
+		}
+		positions = append(positions, component.Position)
+	}
+
+	languages, err := c.registryRepo.GetLanguages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkDisplayNames(command.DisplayNames, languages, ""); err != nil {
+		return nil, err
+	}
+
+	component := &models.GalleryComponent{
+		Key:          command.Key,
+		Name:         constants.Component(command.Name),
+		DisplayNames: command.DisplayNames,
+		Media:        command.Media,
+		Position:     nextPosition(positions),
+		Enabled:      command.Enabled,
+	}
+	if command.Position != nil {
+		component.Position = *command.Position
+	}
+
+	id, err := c.registryRepo.InsertComponent(ctx, component)
+	if err != nil {
+		return nil, err
+	}
+
+	reload(ctx, c.log, c.registryRepo)
+
+	return &id, nil
+}
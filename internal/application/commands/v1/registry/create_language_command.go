@@ -0,0 +1,28 @@
+package registry
+
+// CreateLanguageCommand adds a language content can be written in. Name is what the
+// entries of the language are stored under. It goes last in display order when Position
+// is nil.
+type CreateLanguageCommand struct {
+	Code         string            `json:"code" validate:"required"`
+	Name         string            `json:"name" validate:"required"`
+	DisplayNames map[string]string `json:"display_names"`
+	Position     *int64            `json:"position"`
+	Enabled      bool              `json:"enabled"`
+}
+
+func NewCreateLanguageCommand(
+	code string,
+	name string,
+	displayNames map[string]string,
+	position *int64,
+	enabled bool,
+) *CreateLanguageCommand {
+	return &CreateLanguageCommand{
+		Code:         code,
+		Name:         name,
+		DisplayNames: displayNames,
+		Position:     position,
+		Enabled:      enabled,
+	}
+}
@@ -0,0 +1,77 @@
+package registry
+
+import (
+	"context"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+)
+
+type CreateLanguageCommandHandler interface {
+	Handle(ctx context.Context, command *CreateLanguageCommand) (*string, error)
+}
+
+type createLanguageHandler struct {
+	log          zap.Logger
+	registryRepo repository.RegistryRepository
+}
+
+func NewCreateLanguageHandler(
+	log zap.Logger,
+	registryRepo repository.RegistryRepository,
+) *createLanguageHandler {
+	return &createLanguageHandler{
+		log:          log,
+		registryRepo: registryRepo,
+	}
+}
+
+func (c *createLanguageHandler) Handle(ctx context.Context, command *CreateLanguageCommand) (*string, error) {
+	if err := requireRegistryAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	languages, err := c.registryRepo.GetLanguages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	positions := make([]int64, 0, len(languages))
+	for _, language := range languages {
+		if language.Code == command.Code {
+			return nil, errors.Wrapf(httpPkg.Conflict, "language %s already exists", command.Code)
+		}
+		if language.Name.String() == command.Name {
+			return nil, errors.Wrapf(httpPkg.Conflict, "language %s already exists", command.Name)
+		}
+		positions = append(positions, language.Position)
+	}
+
+	if err := checkDisplayNames(command.DisplayNames, languages, command.Code); err != nil {
+		return nil, err
+	}
+
+	language := &models.GalleryLanguage{
+		Code:         command.Code,
+		Name:         constants.Language(command.Name),
+		DisplayNames: command.DisplayNames,
+		Position:     nextPosition(positions),
+		Enabled:      command.Enabled,
+	}
+	if command.Position != nil {
+		language.Position = *command.Position
+	}
+
+	id, err := c.registryRepo.InsertLanguage(ctx, language)
+	if err != nil {
+		return nil, err
+	}
+
+	reload(ctx, c.log, c.registryRepo)
+
+	return &id, nil
+}
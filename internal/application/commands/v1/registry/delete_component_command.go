@@ -0,0 +1,9 @@
+package registry
+
+type DeleteComponentCommand struct {
+	Key string `json:"key" validate:"required"`
+}
+
+func NewDeleteComponentCommand(key string) *DeleteComponentCommand {
+	return &DeleteComponentCommand{Key: key}
+}
@@ -0,0 +1,61 @@
+package registry
+
+import (
+	"context"
+	"gallery-service/internal/domain/repository"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+)
+
+type DeleteComponentCommandHandler interface {
+	Handle(ctx context.Context, command *DeleteComponentCommand) error
+}
+
+type deleteComponentHandler struct {
+	log          zap.Logger
+	registryRepo repository.RegistryRepository
+	topicRepo    repository.TopicRepository
+}
+
+func NewDeleteComponentHandler(
+	log zap.Logger,
+	registryRepo repository.RegistryRepository,
+	topicRepo repository.TopicRepository,
+) *deleteComponentHandler {
+	return &deleteComponentHandler{
+		log:          log,
+		registryRepo: registryRepo,
+		topicRepo:    topicRepo,
+	}
+}
+
+// Handle refuses to delete a component that topics are shown with, those in the trash
+// included. Such a component can be disabled instead.
+func (d *deleteComponentHandler) Handle(ctx context.Context, command *DeleteComponentCommand) error {
+	if err := requireRegistryAdmin(ctx); err != nil {
+		return err
+	}
+
+	used, err := d.topicRepo.ExistsAnywhere(ctx, map[string]interface{}{"language_config.component": command.Key})
+	if err != nil {
+		return err
+	}
+	if used {
+		return errors.Wrapf(httpPkg.Conflict, "component %s is used by topics, disable it instead", command.Key)
+	}
+
+	deleted, err := d.registryRepo.DeleteComponent(ctx, command.Key)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return errors.Errorf("component %s not found", command.Key)
+	}
+
+	reload(ctx, d.log, d.registryRepo)
+
+	return nil
+}
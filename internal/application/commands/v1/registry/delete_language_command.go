@@ -0,0 +1,9 @@
+package registry
+
+type DeleteLanguageCommand struct {
+	Code string `json:"code" validate:"required"`
+}
+
+func NewDeleteLanguageCommand(code string) *DeleteLanguageCommand {
+	return &DeleteLanguageCommand{Code: code}
+}
@@ -0,0 +1,80 @@
+package registry
+
+import (
+	"context"
+	"gallery-service/internal/domain/repository"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+)
+
+type DeleteLanguageCommandHandler interface {
+	Handle(ctx context.Context, command *DeleteLanguageCommand) error
+}
+
+type deleteLanguageHandler struct {
+	log          zap.Logger
+	registryRepo repository.RegistryRepository
+	clusterRepo  repository.ClusterRepository
+	topicRepo    repository.TopicRepository
+}
+
+func NewDeleteLanguageHandler(
+	log zap.Logger,
+	registryRepo repository.RegistryRepository,
+	clusterRepo repository.ClusterRepository,
+	topicRepo repository.TopicRepository,
+) *deleteLanguageHandler {
+	return &deleteLanguageHandler{
+		log:          log,
+		registryRepo: registryRepo,
+		clusterRepo:  clusterRepo,
+		topicRepo:    topicRepo,
+	}
+}
+
+// Handle refuses to delete a language that clusters or topics are written in, those in
+// the trash included, since their entries could no longer be read or removed through it.
+// Such a language can be disabled instead.
+func (d *deleteLanguageHandler) Handle(ctx context.Context, command *DeleteLanguageCommand) error {
+	if err := requireRegistryAdmin(ctx); err != nil {
+		return err
+	}
+
+	language, err := d.registryRepo.GetLanguageByCode(ctx, command.Code)
+	if err != nil {
+		return err
+	}
+
+	query := map[string]interface{}{"language_config.language": language.Name}
+
+	used, err := d.clusterRepo.ExistsAnywhere(ctx, query)
+	if err != nil {
+		return err
+	}
+	if used {
+		return errors.Wrapf(httpPkg.Conflict, "language %s is used by clusters, disable it instead", command.Code)
+	}
+
+	used, err = d.topicRepo.ExistsAnywhere(ctx, query)
+	if err != nil {
+		return err
+	}
+	if used {
+		return errors.Wrapf(httpPkg.Conflict, "language %s is used by topics, disable it instead", command.Code)
+	}
+
+	deleted, err := d.registryRepo.DeleteLanguage(ctx, command.Code)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return errors.Errorf("language %s not found", command.Code)
+	}
+
+	reload(ctx, d.log, d.registryRepo)
+
+	return nil
+}
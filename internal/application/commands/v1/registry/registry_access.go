@@ -0,0 +1,64 @@
+package registry
+
+import (
+	"context"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	registryCache "gallery-service/internal/pkg/registry"
+	"gallery-service/internal/pkg/tenant"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+)
+
+// requireRegistryAdmin checks that the current user can change the languages and
+// components. They are shared by every organization, so only a SuperAdmin can.
+func requireRegistryAdmin(ctx context.Context) error {
+	if !tenant.FromContext(ctx).Unrestricted() {
+		return errors.Wrap(httpPkg.Forbidden, "only a SuperAdmin can change the languages and components")
+	}
+
+	return nil
+}
+
+// checkDisplayNames checks that every display name is written in a language of
+// languages, or in the language being created when code is set
+func checkDisplayNames(names map[string]string, languages []*models.GalleryLanguage, code string) error {
+	known := make(map[string]bool, len(languages)+1)
+	for _, language := range languages {
+		known[language.Code] = true
+	}
+	if code != "" {
+		known[code] = true
+	}
+
+	for nameCode := range names {
+		if !known[nameCode] {
+			return errors.Wrapf(httpPkg.BadRequest, "display name in unknown language %s", nameCode)
+		}
+	}
+
+	return nil
+}
+
+// nextPosition returns the position after the last of positions
+func nextPosition(positions []int64) int64 {
+	next := int64(0)
+	for _, position := range positions {
+		if position >= next {
+			next = position + 1
+		}
+	}
+
+	return next
+}
+
+// reload loads the change into the registry held by this replica. The change is saved
+// already when the load fails, and the refresh job picks it up later, so the failure is
+// only logged.
+func reload(ctx context.Context, log zap.Logger, registryRepo repository.RegistryRepository) {
+	if err := registryCache.Load(ctx, registryRepo); err != nil {
+		log.Errorf("(Registry.Load) err: {%v}", err)
+	}
+}
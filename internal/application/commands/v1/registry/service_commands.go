@@ -0,0 +1,28 @@
+package registry
+
+type Commands struct {
+	CreateLanguage  CreateLanguageCommandHandler
+	UpdateLanguage  UpdateLanguageCommandHandler
+	DeleteLanguage  DeleteLanguageCommandHandler
+	CreateComponent CreateComponentCommandHandler
+	UpdateComponent UpdateComponentCommandHandler
+	DeleteComponent DeleteComponentCommandHandler
+}
+
+func NewRegistryCommands(
+	createLanguage CreateLanguageCommandHandler,
+	updateLanguage UpdateLanguageCommandHandler,
+	deleteLanguage DeleteLanguageCommandHandler,
+	createComponent CreateComponentCommandHandler,
+	updateComponent UpdateComponentCommandHandler,
+	deleteComponent DeleteComponentCommandHandler,
+) *Commands {
+	return &Commands{
+		CreateLanguage:  createLanguage,
+		UpdateLanguage:  updateLanguage,
+		DeleteLanguage:  deleteLanguage,
+		CreateComponent: createComponent,
+		UpdateComponent: updateComponent,
+		DeleteComponent: deleteComponent,
+	}
+}
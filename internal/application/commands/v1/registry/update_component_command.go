@@ -0,0 +1,30 @@
+package registry
+
+import "gallery-service/internal/pkg/constants"
+
+// UpdateComponentCommand changes how a component is shown, the media it needs and whether
+// it is enabled. Only the fields that are set change, the key and name of a component
+// never do.
+type UpdateComponentCommand struct {
+	Key          string                      `json:"key" validate:"required"`
+	DisplayNames map[string]string           `json:"display_names"`
+	Media        *constants.MediaRequirement `json:"media"`
+	Position     *int64                      `json:"position"`
+	Enabled      *bool                       `json:"enabled"`
+}
+
+func NewUpdateComponentCommand(
+	key string,
+	displayNames map[string]string,
+	media *constants.MediaRequirement,
+	position *int64,
+	enabled *bool,
+) *UpdateComponentCommand {
+	return &UpdateComponentCommand{
+		Key:          key,
+		DisplayNames: displayNames,
+		Media:        media,
+		Position:     position,
+		Enabled:      enabled,
+	}
+}
@@ -0,0 +1,72 @@
+package registry
+
+import (
+	"context"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/pkg/zap"
+)
+
+type UpdateComponentCommandHandler interface {
+	Handle(ctx context.Context, command *UpdateComponentCommand) error
+}
+
+type updateComponentHandler struct {
+	log          zap.Logger
+	registryRepo repository.RegistryRepository
+}
+
+func NewUpdateComponentHandler(
+	log zap.Logger,
+	registryRepo repository.RegistryRepository,
+) *updateComponentHandler {
+	return &updateComponentHandler{
+		log:          log,
+		registryRepo: registryRepo,
+	}
+}
+
+// Handle does not check the topics against new media requirements, a topic that no
+// longer meets them is refused the next time it is saved
+func (u *updateComponentHandler) Handle(ctx context.Context, command *UpdateComponentCommand) error {
+	if err := requireRegistryAdmin(ctx); err != nil {
+		return err
+	}
+
+	component, err := u.registryRepo.GetComponentByKey(ctx, command.Key)
+	if err != nil {
+		return err
+	}
+
+	if command.DisplayNames != nil {
+		languages, err := u.registryRepo.GetLanguages(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := checkDisplayNames(command.DisplayNames, languages, ""); err != nil {
+			return err
+		}
+
+		component.DisplayNames = command.DisplayNames
+	}
+
+	if command.Media != nil {
+		component.Media = *command.Media
+	}
+
+	if command.Position != nil {
+		component.Position = *command.Position
+	}
+
+	if command.Enabled != nil {
+		component.Enabled = *command.Enabled
+	}
+
+	if err := u.registryRepo.UpdateComponent(ctx, component); err != nil {
+		return err
+	}
+
+	reload(ctx, u.log, u.registryRepo)
+
+	return nil
+}
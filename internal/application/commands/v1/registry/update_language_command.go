@@ -0,0 +1,24 @@
+package registry
+
+// UpdateLanguageCommand changes how a language is shown and whether it is enabled. Only
+// the fields that are set change, the code and name of a language never do.
+type UpdateLanguageCommand struct {
+	Code         string            `json:"code" validate:"required"`
+	DisplayNames map[string]string `json:"display_names"`
+	Position     *int64            `json:"position"`
+	Enabled      *bool             `json:"enabled"`
+}
+
+func NewUpdateLanguageCommand(
+	code string,
+	displayNames map[string]string,
+	position *int64,
+	enabled *bool,
+) *UpdateLanguageCommand {
+	return &UpdateLanguageCommand{
+		Code:         code,
+		DisplayNames: displayNames,
+		Position:     position,
+		Enabled:      enabled,
+	}
+}
@@ -0,0 +1,66 @@
+package registry
+
+import (
+	"context"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/pkg/zap"
+)
+
+type UpdateLanguageCommandHandler interface {
+	Handle(ctx context.Context, command *UpdateLanguageCommand) error
+}
+
+type updateLanguageHandler struct {
+	log          zap.Logger
+	registryRepo repository.RegistryRepository
+}
+
+func NewUpdateLanguageHandler(
+	log zap.Logger,
+	registryRepo repository.RegistryRepository,
+) *updateLanguageHandler {
+	return &updateLanguageHandler{
+		log:          log,
+		registryRepo: registryRepo,
+	}
+}
+
+func (u *updateLanguageHandler) Handle(ctx context.Context, command *UpdateLanguageCommand) error {
+	if err := requireRegistryAdmin(ctx); err != nil {
+		return err
+	}
+
+	language, err := u.registryRepo.GetLanguageByCode(ctx, command.Code)
+	if err != nil {
+		return err
+	}
+
+	if command.DisplayNames != nil {
+		languages, err := u.registryRepo.GetLanguages(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := checkDisplayNames(command.DisplayNames, languages, ""); err != nil {
+			return err
+		}
+
+		language.DisplayNames = command.DisplayNames
+	}
+
+	if command.Position != nil {
+		language.Position = *command.Position
+	}
+
+	if command.Enabled != nil {
+		language.Enabled = *command.Enabled
+	}
+
+	if err := u.registryRepo.UpdateLanguage(ctx, language); err != nil {
+		return err
+	}
+
+	reload(ctx, u.log, u.registryRepo)
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package taxonomy
+
+// CreateTaxonomyTermCommand adds a term under ParentID, or a root term of Kind when
+// ParentID is nil. A child term takes the kind of its parent when Kind is empty.
+type CreateTaxonomyTermCommand struct {
+	Name     string `json:"name" validate:"required"`
+	Kind     string `json:"kind"`
+	ParentID *string
+}
+
+func NewCreateTaxonomyTermCommand(name string, kind string, parentID *string) *CreateTaxonomyTermCommand {
+	return &CreateTaxonomyTermCommand{
+		Name:     name,
+		Kind:     kind,
+		ParentID: parentID,
+	}
+}
@@ -0,0 +1,94 @@
+package taxonomy
+
+import (
+	"context"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type CreateTaxonomyTermCommandHandler interface {
+	Handle(ctx context.Context, command *CreateTaxonomyTermCommand) (*string, error)
+}
+
+type createTaxonomyTermHandler struct {
+	log          zap.Logger
+	taxonomyRepo repository.TaxonomyRepository
+}
+
+func NewCreateTaxonomyTermHandler(
+	log zap.Logger,
+	taxonomyRepo repository.TaxonomyRepository,
+) *createTaxonomyTermHandler {
+	return &createTaxonomyTermHandler{
+		log:          log,
+		taxonomyRepo: taxonomyRepo,
+	}
+}
+
+func (c *createTaxonomyTermHandler) Handle(ctx context.Context, command *CreateTaxonomyTermCommand) (*string, error) {
+	if err := requireTaxonomyAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	kind := constants.TaxonomyKind(command.Kind)
+	var parentID *primitive.ObjectID
+	ancestors := make([]primitive.ObjectID, 0)
+	if command.ParentID != nil && *command.ParentID != "" {
+		pID, err := primitive.ObjectIDFromHex(*command.ParentID)
+		if err != nil {
+			return nil, errors.Wrap(httpPkg.BadRequest, "invalid parent id")
+		}
+
+		parent, err := c.taxonomyRepo.GetByID(ctx, pID.Hex())
+		if err != nil {
+			return nil, errors.New("parent taxonomy term not found")
+		}
+
+		if kind == "" {
+			kind = parent.Kind
+		}
+
+		if kind != parent.Kind {
+			return nil, errors.Wrapf(httpPkg.BadRequest, "a %s term cannot be placed under a %s term", kind, parent.Kind)
+		}
+
+		parentID = &pID
+		ancestors = parent.PathFromRoot()
+	}
+
+	if kind == "" {
+		return nil, errors.Wrap(httpPkg.BadRequest, "a root taxonomy term needs a kind")
+	}
+
+	if err := requireUniqueName(ctx, c.taxonomyRepo, parentID, kind, command.Name, primitive.NilObjectID); err != nil {
+		return nil, err
+	}
+
+	position, err := c.taxonomyRepo.GetNextPosition(ctx, parentID, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	term := models.TaxonomyTerm{
+		ID:        primitive.NewObjectID(),
+		Name:      command.Name,
+		Kind:      kind,
+		ParentID:  parentID,
+		Ancestors: ancestors,
+		Depth:     len(ancestors),
+		Position:  position,
+	}
+
+	termID, err := c.taxonomyRepo.Insert(ctx, &term)
+	if err != nil {
+		return nil, err
+	}
+
+	return &termID, nil
+}
@@ -0,0 +1,9 @@
+package taxonomy
+
+type DeleteTaxonomyTermCommand struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func NewDeleteTaxonomyTermCommand(id string) *DeleteTaxonomyTermCommand {
+	return &DeleteTaxonomyTermCommand{ID: id}
+}
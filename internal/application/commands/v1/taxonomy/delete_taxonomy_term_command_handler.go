@@ -0,0 +1,75 @@
+package taxonomy
+
+import (
+	"context"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/pkg/zap"
+)
+
+type DeleteTaxonomyTermCommandHandler interface {
+	Handle(ctx context.Context, command *DeleteTaxonomyTermCommand) (int64, error)
+}
+
+type deleteTaxonomyTermHandler struct {
+	log          zap.Logger
+	taxonomyRepo repository.TaxonomyRepository
+	clusterRepo  repository.ClusterRepository
+	topicRepo    repository.TopicRepository
+	txManager    repository.TransactionManager
+}
+
+func NewDeleteTaxonomyTermHandler(
+	log zap.Logger,
+	taxonomyRepo repository.TaxonomyRepository,
+	clusterRepo repository.ClusterRepository,
+	topicRepo repository.TopicRepository,
+	txManager repository.TransactionManager,
+) *deleteTaxonomyTermHandler {
+	return &deleteTaxonomyTermHandler{
+		log:          log,
+		taxonomyRepo: taxonomyRepo,
+		clusterRepo:  clusterRepo,
+		topicRepo:    topicRepo,
+		txManager:    txManager,
+	}
+}
+
+// Handle deletes the term with every term below it, and removes them from the clusters
+// and topics classified with them. It returns the number of terms deleted.
+func (d *deleteTaxonomyTermHandler) Handle(ctx context.Context, command *DeleteTaxonomyTermCommand) (int64, error) {
+	if err := requireTaxonomyAdmin(ctx); err != nil {
+		return 0, err
+	}
+
+	term, err := d.taxonomyRepo.GetByID(ctx, command.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	var deleted int64
+	err = d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		descendantIDs, err := d.taxonomyRepo.GetDescendantIDs(ctx, term.ID)
+		if err != nil {
+			return err
+		}
+		termIDs := append(descendantIDs, term.ID)
+
+		if _, err := d.clusterRepo.RemoveTaxonomyTerms(ctx, termIDs); err != nil {
+			return err
+		}
+
+		if _, err := d.topicRepo.RemoveTaxonomyTerms(ctx, termIDs); err != nil {
+			return err
+		}
+
+		deleted, err = d.taxonomyRepo.DeleteMany(ctx, termIDs)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	d.log.Infof("(DeleteTaxonomyTerm) id: {%s}, deleted terms: {%d}", term.ID.Hex(), deleted)
+
+	return deleted, nil
+}
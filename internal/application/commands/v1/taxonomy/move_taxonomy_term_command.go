@@ -0,0 +1,13 @@
+package taxonomy
+
+type MoveTaxonomyTermCommand struct {
+	ID       string `json:"id" validate:"required"`
+	ParentID *string
+}
+
+func NewMoveTaxonomyTermCommand(id string, parentID *string) *MoveTaxonomyTermCommand {
+	return &MoveTaxonomyTermCommand{
+		ID:       id,
+		ParentID: parentID,
+	}
+}
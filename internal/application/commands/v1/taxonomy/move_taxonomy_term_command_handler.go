@@ -0,0 +1,99 @@
+package taxonomy
+
+import (
+	"context"
+	"gallery-service/internal/domain/repository"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type MoveTaxonomyTermCommandHandler interface {
+	Handle(ctx context.Context, command *MoveTaxonomyTermCommand) error
+}
+
+type moveTaxonomyTermHandler struct {
+	log          zap.Logger
+	taxonomyRepo repository.TaxonomyRepository
+	txManager    repository.TransactionManager
+}
+
+func NewMoveTaxonomyTermHandler(
+	log zap.Logger,
+	taxonomyRepo repository.TaxonomyRepository,
+	txManager repository.TransactionManager,
+) *moveTaxonomyTermHandler {
+	return &moveTaxonomyTermHandler{
+		log:          log,
+		taxonomyRepo: taxonomyRepo,
+		txManager:    txManager,
+	}
+}
+
+func (u *moveTaxonomyTermHandler) Handle(ctx context.Context, command *MoveTaxonomyTermCommand) error {
+	if err := requireTaxonomyAdmin(ctx); err != nil {
+		return err
+	}
+
+	term, err := u.taxonomyRepo.GetByID(ctx, command.ID)
+	if err != nil {
+		return err
+	}
+
+	// A nil or empty parent moves the term to the root of its kind
+	if command.ParentID == nil || *command.ParentID == "" {
+		if err := requireUniqueName(ctx, u.taxonomyRepo, nil, term.Kind, term.Name, term.ID); err != nil {
+			return err
+		}
+
+		return u.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+			position, err := u.taxonomyRepo.GetNextPosition(ctx, nil, term.Kind)
+			if err != nil {
+				return err
+			}
+
+			return u.taxonomyRepo.UpdateParent(ctx, term.ID, nil, nil, position)
+		})
+	}
+
+	parentID, err := primitive.ObjectIDFromHex(*command.ParentID)
+	if err != nil {
+		return errors.Wrap(httpPkg.BadRequest, "invalid parent id")
+	}
+
+	if parentID == term.ID {
+		return errors.Wrap(httpPkg.BadRequest, "a taxonomy term cannot be its own parent")
+	}
+
+	parent, err := u.taxonomyRepo.GetByID(ctx, parentID.Hex())
+	if err != nil {
+		return errors.New("parent taxonomy term not found")
+	}
+
+	if parent.Kind != term.Kind {
+		return errors.Wrapf(httpPkg.BadRequest, "a %s term cannot be placed under a %s term", term.Kind, parent.Kind)
+	}
+
+	// The new parent must not live inside the subtree being moved
+	for _, id := range parent.Ancestors {
+		if id == term.ID {
+			return errors.Wrap(httpPkg.BadRequest, "cannot move a taxonomy term into its own subtree")
+		}
+	}
+
+	if err := requireUniqueName(ctx, u.taxonomyRepo, &parent.ID, term.Kind, term.Name, term.ID); err != nil {
+		return err
+	}
+
+	// The moved term is appended after the existing children of its new parent
+	return u.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		position, err := u.taxonomyRepo.GetNextPosition(ctx, &parent.ID, term.Kind)
+		if err != nil {
+			return err
+		}
+
+		return u.taxonomyRepo.UpdateParent(ctx, term.ID, &parent.ID, parent.PathFromRoot(), position)
+	})
+}
@@ -0,0 +1,22 @@
+package taxonomy
+
+type Commands struct {
+	CreateTerm CreateTaxonomyTermCommandHandler
+	UpdateTerm UpdateTaxonomyTermCommandHandler
+	MoveTerm   MoveTaxonomyTermCommandHandler
+	DeleteTerm DeleteTaxonomyTermCommandHandler
+}
+
+func NewTaxonomyCommands(
+	createTerm CreateTaxonomyTermCommandHandler,
+	updateTerm UpdateTaxonomyTermCommandHandler,
+	moveTerm MoveTaxonomyTermCommandHandler,
+	deleteTerm DeleteTaxonomyTermCommandHandler,
+) *Commands {
+	return &Commands{
+		CreateTerm: createTerm,
+		UpdateTerm: updateTerm,
+		MoveTerm:   moveTerm,
+		DeleteTerm: deleteTerm,
+	}
+}
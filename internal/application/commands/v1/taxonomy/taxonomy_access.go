@@ -0,0 +1,49 @@
+package taxonomy
+
+import (
+	"context"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/tenant"
+	httpPkg "gallery-service/pkg/http"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// requireTaxonomyAdmin checks that the current user can change the taxonomy. It is
+// shared by every organization, so only a SuperAdmin can.
+func requireTaxonomyAdmin(ctx context.Context) error {
+	if !tenant.FromContext(ctx).Unrestricted() {
+		return errors.Wrap(httpPkg.Forbidden, "only a SuperAdmin can change the taxonomy")
+	}
+
+	return nil
+}
+
+// requireUniqueName checks that no term other than except has name among the terms of
+// kind under parentID
+func requireUniqueName(
+	ctx context.Context,
+	taxonomyRepo repository.TaxonomyRepository,
+	parentID *primitive.ObjectID,
+	kind constants.TaxonomyKind,
+	name string,
+	except primitive.ObjectID,
+) error {
+	query := map[string]interface{}{"parent_id": parentID, "kind": kind, "name": name}
+	if !except.IsZero() {
+		query["_id"] = map[string]interface{}{"$ne": except}
+	}
+
+	taken, err := taxonomyRepo.Exists(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	if taken {
+		return errors.Wrapf(httpPkg.Conflict, "taxonomy term %s already exists", name)
+	}
+
+	return nil
+}
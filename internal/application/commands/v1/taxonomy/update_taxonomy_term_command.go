@@ -0,0 +1,13 @@
+package taxonomy
+
+type UpdateTaxonomyTermCommand struct {
+	ID   string `json:"id" validate:"required"`
+	Name string `json:"name" validate:"required"`
+}
+
+func NewUpdateTaxonomyTermCommand(id string, name string) *UpdateTaxonomyTermCommand {
+	return &UpdateTaxonomyTermCommand{
+		ID:   id,
+		Name: name,
+	}
+}
@@ -0,0 +1,45 @@
+package taxonomy
+
+import (
+	"context"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/pkg/zap"
+)
+
+type UpdateTaxonomyTermCommandHandler interface {
+	Handle(ctx context.Context, command *UpdateTaxonomyTermCommand) error
+}
+
+type updateTaxonomyTermHandler struct {
+	log          zap.Logger
+	taxonomyRepo repository.TaxonomyRepository
+}
+
+func NewUpdateTaxonomyTermHandler(
+	log zap.Logger,
+	taxonomyRepo repository.TaxonomyRepository,
+) *updateTaxonomyTermHandler {
+	return &updateTaxonomyTermHandler{
+		log:          log,
+		taxonomyRepo: taxonomyRepo,
+	}
+}
+
+func (u *updateTaxonomyTermHandler) Handle(ctx context.Context, command *UpdateTaxonomyTermCommand) error {
+	if err := requireTaxonomyAdmin(ctx); err != nil {
+		return err
+	}
+
+	term, err := u.taxonomyRepo.GetByID(ctx, command.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := requireUniqueName(ctx, u.taxonomyRepo, term.ParentID, term.Kind, command.Name, term.ID); err != nil {
+		return err
+	}
+
+	term.Name = command.Name
+
+	return u.taxonomyRepo.Update(ctx, term)
+}
@@ -0,0 +1,35 @@
+package topic
+
+import (
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/pkg/constants"
+)
+
+// AddTopicMediaCommand appends an item to the Kind media of one language of a topic.
+// Only the item of that kind, Image, Video or Audio, is used.
+type AddTopicMediaCommand struct {
+	ID    string                   `json:"id" validate:"required"`
+	Code  string                   `json:"code" validate:"required"`
+	Kind  constants.TopicMediaKind `json:"kind" validate:"required,oneof=images videos audios"`
+	Image models.TopicImageConfig  `json:"image"`
+	Video models.TopicVideoConfig  `json:"video"`
+	Audio models.TopicAudioConfig  `json:"audio"`
+}
+
+func NewAddTopicMediaCommand(
+	id string,
+	code string,
+	kind constants.TopicMediaKind,
+	image models.TopicImageConfig,
+	video models.TopicVideoConfig,
+	audio models.TopicAudioConfig,
+) *AddTopicMediaCommand {
+	return &AddTopicMediaCommand{
+		ID:    id,
+		Code:  code,
+		Kind:  kind,
+		Image: image,
+		Video: video,
+		Audio: audio,
+	}
+}
@@ -0,0 +1,60 @@
+package topic
+
+import (
+	"context"
+	"gallery-service/internal/application/revision"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/pkg/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type AddTopicMediaCommandHandler interface {
+	Handle(ctx context.Context, command *AddTopicMediaCommand) (string, error)
+}
+
+type addTopicMediaHandler struct {
+	log       zap.Logger
+	topicRepo repository.TopicRepository
+	recorder  revision.Recorder
+	txManager repository.TransactionManager
+}
+
+func NewAddTopicMediaHandler(
+	log zap.Logger,
+	topicRepo repository.TopicRepository,
+	recorder revision.Recorder,
+	txManager repository.TransactionManager,
+) *addTopicMediaHandler {
+	return &addTopicMediaHandler{
+		log:       log,
+		topicRepo: topicRepo,
+		recorder:  recorder,
+		txManager: txManager,
+	}
+}
+
+// Handle returns the ID the item was given
+func (u *addTopicMediaHandler) Handle(ctx context.Context, command *AddTopicMediaCommand) (string, error) {
+	var itemID primitive.ObjectID
+	err := editTopicLanguage(ctx, u.topicRepo, u.recorder, u.txManager, command.ID, command.Code, func(config *models.TopicLanguageConfig) error {
+		switch command.Kind {
+		case constants.TopicMediaImages:
+			config.Images, itemID = addMediaItem(config.Images, command.Image)
+		case constants.TopicMediaVideos:
+			config.Videos, itemID = addMediaItem(config.Videos, command.Video)
+		case constants.TopicMediaAudios:
+			config.Audios, itemID = addMediaItem(config.Audios, command.Audio)
+		}
+
+		return nil
+	})
+	if err != nil {
+		u.log.Errorf("(AddTopicMediaCommandHandler.Handle) err: {%v}", err)
+		return "", err
+	}
+
+	return itemID.Hex(), nil
+}
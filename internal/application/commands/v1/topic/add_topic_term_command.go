@@ -0,0 +1,14 @@
+package topic
+
+// AddTopicTermCommand classifies a topic with a taxonomy term
+type AddTopicTermCommand struct {
+	ID     string `json:"id" validate:"required"`
+	TermID string `json:"term_id" validate:"required"`
+}
+
+func NewAddTopicTermCommand(id string, termID string) *AddTopicTermCommand {
+	return &AddTopicTermCommand{
+		ID:     id,
+		TermID: termID,
+	}
+}
@@ -0,0 +1,46 @@
+package topic
+
+import (
+	"context"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+)
+
+type AddTopicTermCommandHandler interface {
+	Handle(ctx context.Context, command *AddTopicTermCommand) (bool, error)
+}
+
+type addTopicTermHandler struct {
+	log          zap.Logger
+	topicRepo    repository.TopicRepository
+	taxonomyRepo repository.TaxonomyRepository
+}
+
+func NewAddTopicTermHandler(
+	log zap.Logger,
+	topicRepo repository.TopicRepository,
+	taxonomyRepo repository.TaxonomyRepository,
+) *addTopicTermHandler {
+	return &addTopicTermHandler{
+		log:          log,
+		topicRepo:    topicRepo,
+		taxonomyRepo: taxonomyRepo,
+	}
+}
+
+// Handle reports whether the topic was not classified with the term already
+func (u *addTopicTermHandler) Handle(ctx context.Context, command *AddTopicTermCommand) (bool, error) {
+	topic, err := u.topicRepo.GetByID(ctx, command.ID)
+	if err != nil {
+		return false, err
+	}
+
+	term, err := u.taxonomyRepo.GetByID(ctx, command.TermID)
+	if err != nil {
+		return false, errors.Errorf("taxonomy term %s not found", command.TermID)
+	}
+
+	return u.topicRepo.AddTaxonomyTerm(ctx, topic.ID, term.ID)
+}
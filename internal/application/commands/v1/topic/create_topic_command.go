@@ -4,20 +4,21 @@ import (
 	"gallery-service/internal/domain/models"
 )
 
+// CreateTopicCommand creates a topic, filed in FolderID when it is set
 type CreateTopicCommand struct {
 	TopicName      string                       `json:"topic_name"`
-	IsPublished    bool                         `json:"is_published"`
+	FolderID       string                       `json:"folder_id"`
 	LanguageConfig []models.TopicLanguageConfig `json:"language_config"`
 }
 
 func NewCreateTopicCommand(
 	topicName string,
-	isPublished bool,
+	folderID string,
 	languageConfig []models.TopicLanguageConfig,
 ) *CreateTopicCommand {
 	return &CreateTopicCommand{
 		TopicName:      topicName,
-		IsPublished:    isPublished,
+		FolderID:       folderID,
 		LanguageConfig: languageConfig,
 	}
 }
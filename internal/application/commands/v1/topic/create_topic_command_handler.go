@@ -2,8 +2,10 @@ package topic
 
 import (
 	"context"
+	"gallery-service/internal/application/access"
 	"gallery-service/internal/domain/models"
 	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
 	"gallery-service/pkg/kafka"
 	"gallery-service/pkg/zap"
 	"time"
@@ -16,31 +18,65 @@ type CreateTopicCommandHandler interface {
 }
 
 type createTopicHandler struct {
-	cfg       kafka.Config
-	log       zap.Logger
-	topicRepo repository.TopicRepository
+	cfg          kafka.Config
+	log          zap.Logger
+	topicRepo    repository.TopicRepository
+	folderRepo   repository.FolderRepository
+	folderAccess access.FolderAccess
 }
 
 func NewCreateTopicHandler(
 	cfg kafka.Config,
 	log zap.Logger,
 	topicRepo repository.TopicRepository,
+	folderRepo repository.FolderRepository,
+	folderAccess access.FolderAccess,
 ) *createTopicHandler {
 	return &createTopicHandler{
-		cfg:       cfg,
-		log:       log,
-		topicRepo: topicRepo,
+		cfg:          cfg,
+		log:          log,
+		topicRepo:    topicRepo,
+		folderRepo:   folderRepo,
+		folderAccess: folderAccess,
 	}
 }
 
 func (c *createTopicHandler) Handle(ctx context.Context, command *CreateTopicCommand) (*string, error) {
+	if err := validateTimecodes(command.LanguageConfig); err != nil {
+		return nil, err
+	}
+
+	if err := prepareMedia(command.LanguageConfig); err != nil {
+		return nil, err
+	}
+
+	if err := validateComponents(command.LanguageConfig); err != nil {
+		return nil, err
+	}
+
+	folderID, err := parseFolderID(command.FolderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := requireFolderWrite(ctx, c.folderRepo, c.folderAccess, folderID); err != nil {
+		return nil, err
+	}
+
 	id := primitive.NewObjectID()
 
+	position, err := c.topicRepo.GetNextPosition(ctx, folderRef(folderID))
+	if err != nil {
+		return nil, err
+	}
+
 	topic := models.Topic{
 		ID:             id,
 		TopicName:      command.TopicName,
-		IsPublished:    command.IsPublished,
+		FolderID:       folderID,
+		Status:         constants.TopicStatusDraft,
 		LanguageConfig: command.LanguageConfig,
+		Position:       position,
 		CreatedAt:      time.Now(),
 		UpdatedAt:      time.Now(),
 	}
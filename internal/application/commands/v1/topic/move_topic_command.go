@@ -0,0 +1,15 @@
+package topic
+
+// MoveTopicCommand files a topic in FolderID, or takes it out of the folder tree when
+// FolderID is empty
+type MoveTopicCommand struct {
+	ID       string `json:"id" validate:"required"`
+	FolderID string `json:"folder_id"`
+}
+
+func NewMoveTopicCommand(id string, folderID string) *MoveTopicCommand {
+	return &MoveTopicCommand{
+		ID:       id,
+		FolderID: folderID,
+	}
+}
@@ -0,0 +1,86 @@
+package topic
+
+import (
+	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/tenant"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+)
+
+type MoveTopicCommandHandler interface {
+	Handle(ctx context.Context, command *MoveTopicCommand) error
+}
+
+type moveTopicHandler struct {
+	log          zap.Logger
+	topicRepo    repository.TopicRepository
+	folderRepo   repository.FolderRepository
+	folderAccess access.FolderAccess
+}
+
+func NewMoveTopicHandler(
+	log zap.Logger,
+	topicRepo repository.TopicRepository,
+	folderRepo repository.FolderRepository,
+	folderAccess access.FolderAccess,
+) *moveTopicHandler {
+	return &moveTopicHandler{
+		log:          log,
+		topicRepo:    topicRepo,
+		folderRepo:   folderRepo,
+		folderAccess: folderAccess,
+	}
+}
+
+// Handle files the topic in the folder of the command, after the topics already there.
+// The current user must be able to write to both the folder the topic leaves and the one
+// it goes to.
+func (m *moveTopicHandler) Handle(ctx context.Context, command *MoveTopicCommand) error {
+	folderID, err := parseFolderID(command.FolderID)
+	if err != nil {
+		return err
+	}
+
+	topic, err := m.topicRepo.GetByID(ctx, command.ID)
+	if err != nil {
+		return err
+	}
+
+	if !tenant.FromContext(ctx).CanWrite(topic.OrganizationID) {
+		return errors.Wrap(httpPkg.Forbidden, "cannot move this topic")
+	}
+
+	if topic.FolderID == folderID {
+		return nil
+	}
+
+	if err := requireFolderWrite(ctx, m.folderRepo, m.folderAccess, topic.FolderID); err != nil {
+		return err
+	}
+
+	if err := requireFolderWrite(ctx, m.folderRepo, m.folderAccess, folderID); err != nil {
+		return err
+	}
+
+	// The topic goes after the topics already in the folder
+	position, err := m.topicRepo.GetNextPosition(ctx, folderRef(folderID))
+	if err != nil {
+		return err
+	}
+
+	moved, err := m.topicRepo.Move(ctx, topic.ID, folderRef(folderID), position)
+	if err != nil {
+		m.log.Errorf("(MoveTopicCommandHandler.Handle) err: {%v}", err)
+		return err
+	}
+
+	if !moved {
+		return errors.Errorf("topic %s not found", command.ID)
+	}
+
+	return nil
+}
@@ -0,0 +1,14 @@
+package topic
+
+// PatchTopicCommand carries a JSON merge patch (RFC 7396) for a topic
+type PatchTopicCommand struct {
+	ID    string `json:"id" validate:"required"`
+	Patch []byte `json:"patch" validate:"required"`
+}
+
+func NewPatchTopicCommand(id string, patch []byte) *PatchTopicCommand {
+	return &PatchTopicCommand{
+		ID:    id,
+		Patch: patch,
+	}
+}
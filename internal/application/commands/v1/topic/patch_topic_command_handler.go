@@ -0,0 +1,120 @@
+package topic
+
+import (
+	"context"
+	requests "gallery-service/internal/application/dto/requests/topic"
+	"gallery-service/internal/application/patch"
+	"gallery-service/internal/application/revision"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// topicPatchFields are the stored fields a topic patch can change
+var topicPatchFields = []string{"topic_name", "language_config"}
+
+type PatchTopicCommandHandler interface {
+	Handle(ctx context.Context, command *PatchTopicCommand) error
+}
+
+type patchTopicHandler struct {
+	log       zap.Logger
+	val       patch.Validator
+	topicRepo repository.TopicRepository
+	recorder  revision.Recorder
+	txManager repository.TransactionManager
+}
+
+func NewPatchTopicHandler(
+	log zap.Logger,
+	val patch.Validator,
+	topicRepo repository.TopicRepository,
+	recorder revision.Recorder,
+	txManager repository.TransactionManager,
+) *patchTopicHandler {
+	return &patchTopicHandler{
+		log:       log,
+		val:       val,
+		topicRepo: topicRepo,
+		recorder:  recorder,
+		txManager: txManager,
+	}
+}
+
+// Handle merges the patch into the topic as the update request would send it, checks
+// the result like an update and saves only the fields that changed
+func (u *patchTopicHandler) Handle(ctx context.Context, command *PatchTopicCommand) error {
+	topic, err := u.topicRepo.GetByID(ctx, command.ID)
+	if err != nil {
+		return err
+	}
+
+	var merged requests.UpdateTopicReqDto
+	if err := patch.Apply(topicDocument(topic), command.Patch, &merged); err != nil {
+		return err
+	}
+
+	if err := u.val.DataValidation(merged); err != nil {
+		return err
+	}
+
+	if err := validateTimecodes(merged.LanguageConfig); err != nil {
+		return err
+	}
+
+	if err := prepareMedia(merged.LanguageConfig); err != nil {
+		return err
+	}
+
+	if err := validateComponents(merged.LanguageConfig); err != nil {
+		return err
+	}
+
+	if merged.ID != topic.ID.Hex() {
+		return errors.Wrap(httpPkg.BadRequest, "id cannot be changed")
+	}
+
+	patched := *topic
+	patched.TopicName = merged.FileName
+	patched.LanguageConfig = merged.LanguageConfig
+
+	set, unset, err := patch.Changes(topic, &patched, topicPatchFields...)
+	if err != nil {
+		return err
+	}
+
+	if len(set) == 0 && len(unset) == 0 {
+		return nil
+	}
+	set["updated_at"] = time.Now()
+
+	return u.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		_, err := u.recorder.Record(ctx, revision.Entry{
+			EntityType:     constants.RevisionEntityTopic,
+			EntityID:       topic.ID,
+			OrganizationID: topic.OrganizationID,
+			Previous:       topic,
+			Action:         constants.RevisionActionUpdate,
+		})
+		if err != nil {
+			return err
+		}
+
+		return u.topicRepo.Patch(ctx, topic.ID, set, unset)
+	})
+}
+
+// topicDocument is the topic in the shape of an update request, the document a patch
+// applies to
+func topicDocument(topic *models.Topic) requests.UpdateTopicReqDto {
+	return requests.UpdateTopicReqDto{
+		ID:             topic.ID.Hex(),
+		FileName:       topic.TopicName,
+		LanguageConfig: topic.LanguageConfig,
+	}
+}
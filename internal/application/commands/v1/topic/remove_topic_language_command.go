@@ -0,0 +1,13 @@
+package topic
+
+type RemoveTopicLanguageCommand struct {
+	ID   string `json:"id" validate:"required"`
+	Code string `json:"code" validate:"required"`
+}
+
+func NewRemoveTopicLanguageCommand(id string, code string) *RemoveTopicLanguageCommand {
+	return &RemoveTopicLanguageCommand{
+		ID:   id,
+		Code: code,
+	}
+}
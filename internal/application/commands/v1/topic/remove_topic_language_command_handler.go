@@ -0,0 +1,74 @@
+package topic
+
+import (
+	"context"
+	"gallery-service/internal/application/revision"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/registry"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+)
+
+type RemoveTopicLanguageCommandHandler interface {
+	Handle(ctx context.Context, command *RemoveTopicLanguageCommand) error
+}
+
+type removeTopicLanguageHandler struct {
+	log       zap.Logger
+	topicRepo repository.TopicRepository
+	recorder  revision.Recorder
+	txManager repository.TransactionManager
+}
+
+func NewRemoveTopicLanguageHandler(
+	log zap.Logger,
+	topicRepo repository.TopicRepository,
+	recorder revision.Recorder,
+	txManager repository.TransactionManager,
+) *removeTopicLanguageHandler {
+	return &removeTopicLanguageHandler{
+		log:       log,
+		topicRepo: topicRepo,
+		recorder:  recorder,
+		txManager: txManager,
+	}
+}
+
+func (u *removeTopicLanguageHandler) Handle(ctx context.Context, command *RemoveTopicLanguageCommand) error {
+	language, ok := registry.LanguageByCode(command.Code)
+	if !ok {
+		return errors.Wrapf(httpPkg.BadRequest, "unsupported language %s", command.Code)
+	}
+
+	topic, err := u.topicRepo.GetByID(ctx, command.ID)
+	if err != nil {
+		return err
+	}
+
+	return u.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		_, err := u.recorder.Record(ctx, revision.Entry{
+			EntityType:     constants.RevisionEntityTopic,
+			EntityID:       topic.ID,
+			OrganizationID: topic.OrganizationID,
+			Previous:       topic,
+			Action:         constants.RevisionActionUpdate,
+		})
+		if err != nil {
+			return err
+		}
+
+		removed, err := u.topicRepo.RemoveLanguage(ctx, topic.ID, language.Name)
+		if err != nil {
+			return err
+		}
+
+		if !removed {
+			return errors.Errorf("language %s not found", command.Code)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,21 @@
+package topic
+
+import "gallery-service/internal/pkg/constants"
+
+// RemoveTopicMediaCommand removes the item ItemID from the Kind media of one language of
+// a topic
+type RemoveTopicMediaCommand struct {
+	ID     string                   `json:"id" validate:"required"`
+	Code   string                   `json:"code" validate:"required"`
+	Kind   constants.TopicMediaKind `json:"kind" validate:"required,oneof=images videos audios"`
+	ItemID string                   `json:"item_id" validate:"required"`
+}
+
+func NewRemoveTopicMediaCommand(id string, code string, kind constants.TopicMediaKind, itemID string) *RemoveTopicMediaCommand {
+	return &RemoveTopicMediaCommand{
+		ID:     id,
+		Code:   code,
+		Kind:   kind,
+		ItemID: itemID,
+	}
+}
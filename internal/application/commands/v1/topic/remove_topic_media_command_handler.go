@@ -0,0 +1,65 @@
+package topic
+
+import (
+	"context"
+	"gallery-service/internal/application/revision"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type RemoveTopicMediaCommandHandler interface {
+	Handle(ctx context.Context, command *RemoveTopicMediaCommand) error
+}
+
+type removeTopicMediaHandler struct {
+	log       zap.Logger
+	topicRepo repository.TopicRepository
+	recorder  revision.Recorder
+	txManager repository.TransactionManager
+}
+
+func NewRemoveTopicMediaHandler(
+	log zap.Logger,
+	topicRepo repository.TopicRepository,
+	recorder revision.Recorder,
+	txManager repository.TransactionManager,
+) *removeTopicMediaHandler {
+	return &removeTopicMediaHandler{
+		log:       log,
+		topicRepo: topicRepo,
+		recorder:  recorder,
+		txManager: txManager,
+	}
+}
+
+func (u *removeTopicMediaHandler) Handle(ctx context.Context, command *RemoveTopicMediaCommand) error {
+	itemID, err := primitive.ObjectIDFromHex(command.ItemID)
+	if err != nil {
+		return errors.Wrap(httpPkg.BadRequest, "invalid item id")
+	}
+
+	err = editTopicLanguage(ctx, u.topicRepo, u.recorder, u.txManager, command.ID, command.Code, func(config *models.TopicLanguageConfig) (err error) {
+		switch command.Kind {
+		case constants.TopicMediaImages:
+			config.Images, err = removeMediaItem(config.Images, itemID)
+		case constants.TopicMediaVideos:
+			config.Videos, err = removeMediaItem(config.Videos, itemID)
+		case constants.TopicMediaAudios:
+			config.Audios, err = removeMediaItem(config.Audios, itemID)
+		}
+
+		return err
+	})
+	if err != nil {
+		u.log.Errorf("(RemoveTopicMediaCommandHandler.Handle) err: {%v}", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,13 @@
+package topic
+
+type RemoveTopicTermCommand struct {
+	ID     string `json:"id" validate:"required"`
+	TermID string `json:"term_id" validate:"required"`
+}
+
+func NewRemoveTopicTermCommand(id string, termID string) *RemoveTopicTermCommand {
+	return &RemoveTopicTermCommand{
+		ID:     id,
+		TermID: termID,
+	}
+}
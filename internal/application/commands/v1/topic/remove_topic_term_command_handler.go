@@ -0,0 +1,53 @@
+package topic
+
+import (
+	"context"
+	"gallery-service/internal/domain/repository"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type RemoveTopicTermCommandHandler interface {
+	Handle(ctx context.Context, command *RemoveTopicTermCommand) error
+}
+
+type removeTopicTermHandler struct {
+	log       zap.Logger
+	topicRepo repository.TopicRepository
+}
+
+func NewRemoveTopicTermHandler(
+	log zap.Logger,
+	topicRepo repository.TopicRepository,
+) *removeTopicTermHandler {
+	return &removeTopicTermHandler{
+		log:       log,
+		topicRepo: topicRepo,
+	}
+}
+
+func (u *removeTopicTermHandler) Handle(ctx context.Context, command *RemoveTopicTermCommand) error {
+	termID, err := primitive.ObjectIDFromHex(command.TermID)
+	if err != nil {
+		return errors.Wrapf(httpPkg.BadRequest, "invalid taxonomy term %s", command.TermID)
+	}
+
+	topic, err := u.topicRepo.GetByID(ctx, command.ID)
+	if err != nil {
+		return err
+	}
+
+	removed, err := u.topicRepo.RemoveTaxonomyTerm(ctx, topic.ID, termID)
+	if err != nil {
+		return err
+	}
+
+	if !removed {
+		return errors.Errorf("taxonomy term %s not found on the topic", command.TermID)
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package topic
+
+import "gallery-service/internal/pkg/constants"
+
+// ReorderTopicMediaCommand orders the Kind media of one language of a topic. The items of
+// ItemIDs come first in that order, the others follow in the order they were in.
+type ReorderTopicMediaCommand struct {
+	ID      string                   `json:"id" validate:"required"`
+	Code    string                   `json:"code" validate:"required"`
+	Kind    constants.TopicMediaKind `json:"kind" validate:"required,oneof=images videos audios"`
+	ItemIDs []string                 `json:"ids" validate:"required,min=1"`
+}
+
+func NewReorderTopicMediaCommand(id string, code string, kind constants.TopicMediaKind, itemIDs []string) *ReorderTopicMediaCommand {
+	return &ReorderTopicMediaCommand{
+		ID:      id,
+		Code:    code,
+		Kind:    kind,
+		ItemIDs: itemIDs,
+	}
+}
@@ -0,0 +1,69 @@
+package topic
+
+import (
+	"context"
+	"gallery-service/internal/application/revision"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ReorderTopicMediaCommandHandler interface {
+	Handle(ctx context.Context, command *ReorderTopicMediaCommand) error
+}
+
+type reorderTopicMediaHandler struct {
+	log       zap.Logger
+	topicRepo repository.TopicRepository
+	recorder  revision.Recorder
+	txManager repository.TransactionManager
+}
+
+func NewReorderTopicMediaHandler(
+	log zap.Logger,
+	topicRepo repository.TopicRepository,
+	recorder revision.Recorder,
+	txManager repository.TransactionManager,
+) *reorderTopicMediaHandler {
+	return &reorderTopicMediaHandler{
+		log:       log,
+		topicRepo: topicRepo,
+		recorder:  recorder,
+		txManager: txManager,
+	}
+}
+
+func (u *reorderTopicMediaHandler) Handle(ctx context.Context, command *ReorderTopicMediaCommand) error {
+	itemIDs := make([]primitive.ObjectID, 0, len(command.ItemIDs))
+	for _, id := range command.ItemIDs {
+		itemID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return errors.Wrapf(httpPkg.BadRequest, "invalid item id %s", id)
+		}
+		itemIDs = append(itemIDs, itemID)
+	}
+
+	err := editTopicLanguage(ctx, u.topicRepo, u.recorder, u.txManager, command.ID, command.Code, func(config *models.TopicLanguageConfig) (err error) {
+		switch command.Kind {
+		case constants.TopicMediaImages:
+			config.Images, err = reorderMediaItems(config.Images, itemIDs)
+		case constants.TopicMediaVideos:
+			config.Videos, err = reorderMediaItems(config.Videos, itemIDs)
+		case constants.TopicMediaAudios:
+			config.Audios, err = reorderMediaItems(config.Audios, itemIDs)
+		}
+
+		return err
+	})
+	if err != nil {
+		u.log.Errorf("(ReorderTopicMediaCommandHandler.Handle) err: {%v}", err)
+		return err
+	}
+
+	return nil
+}
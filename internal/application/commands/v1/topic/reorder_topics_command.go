@@ -0,0 +1,13 @@
+package topic
+
+type ReorderTopicsCommand struct {
+	FolderID string   `json:"folder_id"`
+	IDs      []string `json:"ids" validate:"required,min=1"`
+}
+
+func NewReorderTopicsCommand(folderID string, ids []string) *ReorderTopicsCommand {
+	return &ReorderTopicsCommand{
+		FolderID: folderID,
+		IDs:      ids,
+	}
+}
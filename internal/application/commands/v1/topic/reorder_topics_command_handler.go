@@ -0,0 +1,73 @@
+package topic
+
+import (
+	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/domain/repository"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ReorderTopicsCommandHandler interface {
+	Handle(ctx context.Context, command *ReorderTopicsCommand) error
+}
+
+type reorderTopicsHandler struct {
+	log          zap.Logger
+	topicRepo    repository.TopicRepository
+	folderRepo   repository.FolderRepository
+	folderAccess access.FolderAccess
+	txManager    repository.TransactionManager
+}
+
+func NewReorderTopicsHandler(
+	log zap.Logger,
+	topicRepo repository.TopicRepository,
+	folderRepo repository.FolderRepository,
+	folderAccess access.FolderAccess,
+	txManager repository.TransactionManager,
+) *reorderTopicsHandler {
+	return &reorderTopicsHandler{
+		log:          log,
+		topicRepo:    topicRepo,
+		folderRepo:   folderRepo,
+		folderAccess: folderAccess,
+		txManager:    txManager,
+	}
+}
+
+// Handle sets the order of the topics of the folder of the command, or of the topics
+// outside the folder tree when it has none
+func (r *reorderTopicsHandler) Handle(ctx context.Context, command *ReorderTopicsCommand) error {
+	folderID, err := parseFolderID(command.FolderID)
+	if err != nil {
+		return err
+	}
+
+	if err := requireFolderWrite(ctx, r.folderRepo, r.folderAccess, folderID); err != nil {
+		return err
+	}
+
+	seen := make(map[primitive.ObjectID]struct{}, len(command.IDs))
+	topicIDs := make([]primitive.ObjectID, 0, len(command.IDs))
+	for _, id := range command.IDs {
+		topicID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return errors.Wrapf(httpPkg.BadRequest, "invalid topic id %s", id)
+		}
+
+		if _, ok := seen[topicID]; ok {
+			return errors.Wrapf(httpPkg.BadRequest, "duplicate topic id %s", id)
+		}
+
+		seen[topicID] = struct{}{}
+		topicIDs = append(topicIDs, topicID)
+	}
+
+	return r.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		return r.topicRepo.Reorder(ctx, folderRef(folderID), topicIDs)
+	})
+}
@@ -0,0 +1,13 @@
+package topic
+
+type RestoreTopicRevisionCommand struct {
+	ID       string `json:"id" validate:"required"`
+	Revision int64  `json:"revision" validate:"required,gt=0"`
+}
+
+func NewRestoreTopicRevisionCommand(id string, revision int64) *RestoreTopicRevisionCommand {
+	return &RestoreTopicRevisionCommand{
+		ID:       id,
+		Revision: revision,
+	}
+}
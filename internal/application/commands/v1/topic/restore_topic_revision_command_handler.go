@@ -0,0 +1,94 @@
+package topic
+
+import (
+	"context"
+	"gallery-service/internal/application/revision"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/pkg/zap"
+	"time"
+)
+
+type RestoreTopicRevisionCommandHandler interface {
+	Handle(ctx context.Context, command *RestoreTopicRevisionCommand) (int64, error)
+}
+
+type restoreTopicRevisionHandler struct {
+	log          zap.Logger
+	topicRepo    repository.TopicRepository
+	revisionRepo repository.RevisionRepository
+	recorder     revision.Recorder
+	txManager    repository.TransactionManager
+}
+
+func NewRestoreTopicRevisionHandler(
+	log zap.Logger,
+	topicRepo repository.TopicRepository,
+	revisionRepo repository.RevisionRepository,
+	recorder revision.Recorder,
+	txManager repository.TransactionManager,
+) *restoreTopicRevisionHandler {
+	return &restoreTopicRevisionHandler{
+		log:          log,
+		topicRepo:    topicRepo,
+		revisionRepo: revisionRepo,
+		recorder:     recorder,
+		txManager:    txManager,
+	}
+}
+
+// Handle writes the content of an old revision back to the topic and records the
+// replaced content as a new revision. The workflow status is left as it is. It returns
+// the number of the new revision.
+func (u *restoreTopicRevisionHandler) Handle(ctx context.Context, command *RestoreTopicRevisionCommand) (int64, error) {
+	topic, err := u.topicRepo.GetByID(ctx, command.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	old, err := u.revisionRepo.GetByNumber(ctx, constants.RevisionEntityTopic, topic.ID, command.Revision)
+	if err != nil {
+		return 0, err
+	}
+
+	var snapshot models.Topic
+	if err := revision.Decode(old, &snapshot); err != nil {
+		return 0, err
+	}
+
+	// Revisions recorded before media items had an ID carry none
+	for i := range snapshot.LanguageConfig {
+		snapshot.LanguageConfig[i].EnsureMediaIDs()
+	}
+
+	t := models.Topic{
+		ID:             topic.ID,
+		TopicName:      snapshot.TopicName,
+		LanguageConfig: snapshot.LanguageConfig,
+		CreatedAt:      topic.CreatedAt,
+		UpdatedAt:      time.Now(),
+	}
+
+	var number int64
+	err = u.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		number, err = u.recorder.Record(ctx, revision.Entry{
+			EntityType:     constants.RevisionEntityTopic,
+			EntityID:       topic.ID,
+			OrganizationID: topic.OrganizationID,
+			Previous:       topic,
+			Action:         constants.RevisionActionRestore,
+			RestoredFrom:   &command.Revision,
+		})
+		if err != nil {
+			return err
+		}
+
+		return u.topicRepo.Update(ctx, &t)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return number, nil
+}
@@ -0,0 +1,18 @@
+package topic
+
+import "time"
+
+// ScheduleTopicCommand sets when a topic is published and archived. A nil time clears it.
+type ScheduleTopicCommand struct {
+	ID          string     `json:"id" validate:"required"`
+	PublishAt   *time.Time `json:"publish_at"`
+	UnpublishAt *time.Time `json:"unpublish_at"`
+}
+
+func NewScheduleTopicCommand(id string, publishAt *time.Time, unpublishAt *time.Time) *ScheduleTopicCommand {
+	return &ScheduleTopicCommand{
+		ID:          id,
+		PublishAt:   publishAt,
+		UnpublishAt: unpublishAt,
+	}
+}
@@ -0,0 +1,58 @@
+package topic
+
+import (
+	"context"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/tenant"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+)
+
+type ScheduleTopicCommandHandler interface {
+	Handle(ctx context.Context, command *ScheduleTopicCommand) error
+}
+
+type scheduleTopicHandler struct {
+	log       zap.Logger
+	topicRepo repository.TopicRepository
+}
+
+func NewScheduleTopicHandler(log zap.Logger, topicRepo repository.TopicRepository) *scheduleTopicHandler {
+	return &scheduleTopicHandler{log: log, topicRepo: topicRepo}
+}
+
+// Handle replaces the schedule of the topic. The schedule only publishes approved topics
+// and archives published ones, so a topic still in review is published once it is
+// approved if its publish time has come by then.
+func (u *scheduleTopicHandler) Handle(ctx context.Context, command *ScheduleTopicCommand) error {
+	if command.PublishAt != nil && command.UnpublishAt != nil && !command.UnpublishAt.After(*command.PublishAt) {
+		return errors.Wrap(httpPkg.BadRequest, "unpublish_at must be after publish_at")
+	}
+
+	topic, err := u.topicRepo.GetByID(ctx, command.ID)
+	if err != nil {
+		return err
+	}
+
+	if !tenant.FromContext(ctx).CanWrite(topic.OrganizationID) {
+		return errors.Wrap(httpPkg.Forbidden, "cannot schedule this topic")
+	}
+
+	if topic.WorkflowStatus() == constants.TopicStatusArchived {
+		return errors.Wrap(httpPkg.Conflict, "cannot schedule an archived topic")
+	}
+
+	updated, err := u.topicRepo.SetSchedule(ctx, topic.ID, command.PublishAt, command.UnpublishAt)
+	if err != nil {
+		return err
+	}
+
+	if !updated {
+		return errors.Errorf("topic %s not found", command.ID)
+	}
+
+	return nil
+}
@@ -1,19 +1,61 @@
 package topic
 
 type Commands struct {
-	CreateTopic CreateTopicCommandHandler
-	UpdateTopic UpdateTopicCommandHandler
-	DeleteTopic DeleteTopicCommandHandler
+	CreateTopic     CreateTopicCommandHandler
+	UpdateTopic     UpdateTopicCommandHandler
+	PatchTopic      PatchTopicCommandHandler
+	DeleteTopic     DeleteTopicCommandHandler
+	ReorderTopics   ReorderTopicsCommandHandler
+	RestoreRevision RestoreTopicRevisionCommandHandler
+	SetLanguage     SetTopicLanguageCommandHandler
+	RemoveLanguage  RemoveTopicLanguageCommandHandler
+	AddTerm         AddTopicTermCommandHandler
+	RemoveTerm      RemoveTopicTermCommandHandler
+	Transition      TransitionTopicCommandHandler
+	Schedule        ScheduleTopicCommandHandler
+	Move            MoveTopicCommandHandler
+	AddMedia        AddTopicMediaCommandHandler
+	UpdateMedia     UpdateTopicMediaCommandHandler
+	RemoveMedia     RemoveTopicMediaCommandHandler
+	ReorderMedia    ReorderTopicMediaCommandHandler
 }
 
 func NewTopicCommands(
 	createTopic CreateTopicCommandHandler,
 	updateTopic UpdateTopicCommandHandler,
+	patchTopic PatchTopicCommandHandler,
 	deleteTopic DeleteTopicCommandHandler,
+	reorderTopics ReorderTopicsCommandHandler,
+	restoreRevision RestoreTopicRevisionCommandHandler,
+	setLanguage SetTopicLanguageCommandHandler,
+	removeLanguage RemoveTopicLanguageCommandHandler,
+	addTerm AddTopicTermCommandHandler,
+	removeTerm RemoveTopicTermCommandHandler,
+	transition TransitionTopicCommandHandler,
+	schedule ScheduleTopicCommandHandler,
+	move MoveTopicCommandHandler,
+	addMedia AddTopicMediaCommandHandler,
+	updateMedia UpdateTopicMediaCommandHandler,
+	removeMedia RemoveTopicMediaCommandHandler,
+	reorderMedia ReorderTopicMediaCommandHandler,
 ) *Commands {
 	return &Commands{
-		CreateTopic: createTopic,
-		UpdateTopic: updateTopic,
-		DeleteTopic: deleteTopic,
+		CreateTopic:     createTopic,
+		UpdateTopic:     updateTopic,
+		PatchTopic:      patchTopic,
+		DeleteTopic:     deleteTopic,
+		ReorderTopics:   reorderTopics,
+		RestoreRevision: restoreRevision,
+		SetLanguage:     setLanguage,
+		RemoveLanguage:  removeLanguage,
+		AddTerm:         addTerm,
+		RemoveTerm:      removeTerm,
+		Transition:      transition,
+		Schedule:        schedule,
+		Move:            move,
+		AddMedia:        addMedia,
+		UpdateMedia:     updateMedia,
+		RemoveMedia:     removeMedia,
+		ReorderMedia:    reorderMedia,
 	}
 }
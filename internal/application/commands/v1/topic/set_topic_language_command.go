@@ -0,0 +1,41 @@
+package topic
+
+import "gallery-service/internal/domain/models"
+
+// SetTopicLanguageCommand adds or replaces the entry of one language of a topic. Code is
+// the code of an enabled language of the registry.
+type SetTopicLanguageCommand struct {
+	ID          string                    `json:"id" validate:"required"`
+	Code        string                    `json:"code" validate:"required"`
+	Component   string                    `json:"component"`
+	Title       string                    `json:"title"`
+	Note        string                    `json:"note"`
+	Description string                    `json:"description"`
+	Images      []models.TopicImageConfig `json:"images"`
+	Videos      []models.TopicVideoConfig `json:"videos"`
+	Audios      []models.TopicAudioConfig `json:"audios"`
+}
+
+func NewSetTopicLanguageCommand(
+	id string,
+	code string,
+	component string,
+	title string,
+	note string,
+	description string,
+	images []models.TopicImageConfig,
+	videos []models.TopicVideoConfig,
+	audios []models.TopicAudioConfig,
+) *SetTopicLanguageCommand {
+	return &SetTopicLanguageCommand{
+		ID:          id,
+		Code:        code,
+		Component:   component,
+		Title:       title,
+		Note:        note,
+		Description: description,
+		Images:      images,
+		Videos:      videos,
+		Audios:      audios,
+	}
+}
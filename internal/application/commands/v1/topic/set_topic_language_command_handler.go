@@ -0,0 +1,97 @@
+package topic
+
+import (
+	"context"
+	"gallery-service/internal/application/revision"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/registry"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+)
+
+type SetTopicLanguageCommandHandler interface {
+	Handle(ctx context.Context, command *SetTopicLanguageCommand) (bool, error)
+}
+
+type setTopicLanguageHandler struct {
+	log       zap.Logger
+	topicRepo repository.TopicRepository
+	recorder  revision.Recorder
+	txManager repository.TransactionManager
+}
+
+func NewSetTopicLanguageHandler(
+	log zap.Logger,
+	topicRepo repository.TopicRepository,
+	recorder revision.Recorder,
+	txManager repository.TransactionManager,
+) *setTopicLanguageHandler {
+	return &setTopicLanguageHandler{
+		log:       log,
+		topicRepo: topicRepo,
+		recorder:  recorder,
+		txManager: txManager,
+	}
+}
+
+// Handle reports whether the language was added rather than replaced
+func (u *setTopicLanguageHandler) Handle(ctx context.Context, command *SetTopicLanguageCommand) (bool, error) {
+	language, ok := registry.LanguageByCode(command.Code)
+	if !ok || !language.Enabled {
+		return false, errors.Wrapf(httpPkg.BadRequest, "unsupported language %s", command.Code)
+	}
+
+	config := models.TopicLanguageConfig{
+		Language:    language.Name,
+		Component:   command.Component,
+		Title:       command.Title,
+		Note:        command.Note,
+		Description: command.Description,
+		Images:      command.Images,
+		Videos:      command.Videos,
+		Audios:      command.Audios,
+	}
+	if err := validateLanguageTimecodes(config); err != nil {
+		return false, err
+	}
+
+	config.EnsureMediaIDs()
+	if err := checkMedia(config); err != nil {
+		return false, err
+	}
+
+	if err := validateLanguageComponent(config); err != nil {
+		return false, err
+	}
+
+	topic, err := u.topicRepo.GetByID(ctx, command.ID)
+	if err != nil {
+		return false, err
+	}
+
+	var added bool
+	err = u.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		_, err := u.recorder.Record(ctx, revision.Entry{
+			EntityType:     constants.RevisionEntityTopic,
+			EntityID:       topic.ID,
+			OrganizationID: topic.OrganizationID,
+			Previous:       topic,
+			Action:         constants.RevisionActionUpdate,
+		})
+		if err != nil {
+			return err
+		}
+
+		added, err = u.topicRepo.SetLanguage(ctx, topic.ID, config)
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return added, nil
+}
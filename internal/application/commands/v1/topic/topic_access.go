@@ -0,0 +1,56 @@
+package topic
+
+import (
+	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/tenant"
+	httpPkg "gallery-service/pkg/http"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// requireFolderWrite checks that the current user can file topics in the folder and take
+// them out of it. Topics outside of any folder carry no ACL.
+func requireFolderWrite(ctx context.Context, folderRepo repository.FolderRepository, folderAccess access.FolderAccess, folderID primitive.ObjectID) error {
+	if folderID.IsZero() {
+		return nil
+	}
+
+	folder, err := folderRepo.GetByID(ctx, folderID.Hex())
+	if err != nil {
+		return errors.New("folder not found")
+	}
+
+	// Topics are owned by the organization that files them, so the folder must be its own
+	if !tenant.FromContext(ctx).CanWrite(folder.OrganizationID) {
+		return errors.Wrap(httpPkg.Forbidden, "cannot file topics in this folder")
+	}
+
+	return folderAccess.Require(ctx, folder, constants.FolderPermissionWrite)
+}
+
+// parseFolderID reads an optional folder id, the empty string being no folder
+func parseFolderID(folderID string) (primitive.ObjectID, error) {
+	if folderID == "" {
+		return primitive.NilObjectID, nil
+	}
+
+	id, err := primitive.ObjectIDFromHex(folderID)
+	if err != nil {
+		return primitive.NilObjectID, errors.Wrap(httpPkg.BadRequest, "invalid folder id")
+	}
+
+	return id, nil
+}
+
+// folderRef is the folder of a topic as the repository takes it, nil for no folder
+func folderRef(folderID primitive.ObjectID) *primitive.ObjectID {
+	if folderID.IsZero() {
+		return nil
+	}
+
+	return &folderID
+}
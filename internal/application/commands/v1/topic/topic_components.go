@@ -0,0 +1,82 @@
+package topic
+
+import (
+	"fmt"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/registry"
+	httpPkg "gallery-service/pkg/http"
+)
+
+// validateComponents checks the component of every language against the enabled
+// components of the registry and the media that component needs. All the languages at
+// fault are reported at once, each under the path of its entry in language_config.
+func validateComponents(configs []models.TopicLanguageConfig) error {
+	var fieldErrors httpPkg.FieldErrors
+	for i, config := range configs {
+		fieldErrors = append(fieldErrors, componentErrors(fmt.Sprintf("language_config[%d].", i), config)...)
+	}
+
+	if len(fieldErrors) > 0 {
+		return fieldErrors
+	}
+
+	return nil
+}
+
+// validateLanguageComponent is validateComponents for a request on a single language, whose
+// fields are at the top of the request
+func validateLanguageComponent(config models.TopicLanguageConfig) error {
+	if fieldErrors := componentErrors("", config); len(fieldErrors) > 0 {
+		return fieldErrors
+	}
+
+	return nil
+}
+
+// componentErrors leaves out languages without a component, which stay valid until one
+// is chosen
+func componentErrors(path string, config models.TopicLanguageConfig) httpPkg.FieldErrors {
+	if config.Component == "" {
+		return nil
+	}
+
+	component, ok := registry.ComponentByKey(config.Component)
+	if !ok {
+		return httpPkg.FieldErrors{{
+			Field:   path + "component",
+			Message: fmt.Sprintf("language %s: unknown component %s", config.Language, config.Component),
+		}}
+	}
+
+	if !component.Enabled {
+		return httpPkg.FieldErrors{{
+			Field:   path + "component",
+			Message: fmt.Sprintf("language %s: component %s is disabled", config.Language, config.Component),
+		}}
+	}
+
+	need := component.Media
+	media := []struct {
+		kind  constants.TopicMediaKind
+		count int
+		min   int
+	}{
+		{constants.TopicMediaImages, len(config.Images), need.MinImages},
+		{constants.TopicMediaVideos, len(config.Videos), need.MinVideos},
+		{constants.TopicMediaAudios, len(config.Audios), need.MinAudios},
+	}
+
+	var fieldErrors httpPkg.FieldErrors
+	for _, m := range media {
+		if m.count < m.min {
+			fieldErrors = append(fieldErrors, httpPkg.FieldError{
+				Field: path + m.kind.String(),
+				Message: fmt.Sprintf("language %s: component %s needs at least %d %s, got %d",
+					config.Language, config.Component, m.min, m.kind, m.count),
+			})
+		}
+	}
+
+	return fieldErrors
+}
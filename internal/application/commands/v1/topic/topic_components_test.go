@@ -0,0 +1,204 @@
+package topic
+
+import (
+	"context"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/registry"
+	httpPkg "gallery-service/pkg/http"
+	"reflect"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	en = constants.EnglishLanguageConfig
+	vi = constants.VietnameseLanguageConfig
+)
+
+// seededRegistry serves the seed of the constants, with the given component keys disabled
+type seededRegistry struct {
+	repository.RegistryRepository
+	disabled []string
+}
+
+func (r seededRegistry) GetLanguages(_ context.Context) ([]*models.GalleryLanguage, error) {
+	languages, _ := registry.Seed()
+	return languages, nil
+}
+
+func (r seededRegistry) GetComponents(_ context.Context) ([]*models.GalleryComponent, error) {
+	_, components := registry.Seed()
+	for _, component := range components {
+		for _, key := range r.disabled {
+			if component.Key == key {
+				component.Enabled = false
+			}
+		}
+	}
+
+	return components, nil
+}
+
+func loadRegistry(t *testing.T, disabled ...string) {
+	t.Helper()
+
+	if err := registry.Load(context.Background(), seededRegistry{disabled: disabled}); err != nil {
+		t.Fatalf("registry.Load err: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = registry.Load(context.Background(), seededRegistry{})
+	})
+}
+
+func TestValidateComponents(t *testing.T) {
+	video := []models.TopicVideoConfig{{VideoName: "video"}}
+	image := []models.TopicImageConfig{{PicName: "image"}}
+
+	tests := []struct {
+		name       string
+		configs    []models.TopicLanguageConfig
+		wantFields []string
+	}{
+		{
+			name:    "no language",
+			configs: nil,
+		},
+		{
+			name:    "language without a component",
+			configs: []models.TopicLanguageConfig{{Language: en}},
+		},
+		{
+			name:    "component without media needs",
+			configs: []models.TopicLanguageConfig{{Language: en, Component: "schedule"}},
+		},
+		{
+			name: "media needs met",
+			configs: []models.TopicLanguageConfig{
+				{Language: en, Component: "video-player", Videos: video},
+				{Language: vi, Component: "album", Images: image},
+			},
+		},
+		{
+			name:       "unknown component",
+			configs:    []models.TopicLanguageConfig{{Language: en, Component: "unknown"}},
+			wantFields: []string{"language_config[0].component"},
+		},
+		{
+			name:       "disabled component",
+			configs:    []models.TopicLanguageConfig{{Language: en, Component: "chat"}},
+			wantFields: []string{"language_config[0].component"},
+		},
+		{
+			name:       "too few videos",
+			configs:    []models.TopicLanguageConfig{{Language: en, Component: "video-player", Images: image}},
+			wantFields: []string{"language_config[0].videos"},
+		},
+		{
+			name:       "too few images",
+			configs:    []models.TopicLanguageConfig{{Language: en, Component: "album", Videos: video}},
+			wantFields: []string{"language_config[0].images"},
+		},
+		{
+			name: "every language at fault reported under its index",
+			configs: []models.TopicLanguageConfig{
+				{Language: en, Component: "album", Images: image},
+				{Language: vi, Component: "video-player"},
+				{Language: constants.FrenchLanguageConfig, Component: "unknown"},
+				{Language: constants.SpanishLanguageConfig, Component: "album"},
+			},
+			wantFields: []string{
+				"language_config[1].videos",
+				"language_config[2].component",
+				"language_config[3].images",
+			},
+		},
+	}
+
+	loadRegistry(t, "chat")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateComponents(tt.configs)
+			if len(tt.wantFields) == 0 {
+				if err != nil {
+					t.Fatalf("validateComponents err: %v", err)
+				}
+				return
+			}
+
+			if got := fieldsOf(t, err); !reflect.DeepEqual(got, tt.wantFields) {
+				t.Errorf("validateComponents fields = %v, want %v", got, tt.wantFields)
+			}
+		})
+	}
+}
+
+func TestValidateLanguageComponent(t *testing.T) {
+	tests := []struct {
+		name       string
+		config     models.TopicLanguageConfig
+		wantFields []string
+	}{
+		{
+			name:   "language without a component",
+			config: models.TopicLanguageConfig{Language: en},
+		},
+		{
+			name:       "unknown component",
+			config:     models.TopicLanguageConfig{Language: en, Component: "unknown"},
+			wantFields: []string{"component"},
+		},
+		{
+			name:       "disabled component",
+			config:     models.TopicLanguageConfig{Language: en, Component: "chat"},
+			wantFields: []string{"component"},
+		},
+		{
+			name:       "too few videos",
+			config:     models.TopicLanguageConfig{Language: en, Component: "video-player"},
+			wantFields: []string{"videos"},
+		},
+	}
+
+	loadRegistry(t, "chat")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateLanguageComponent(tt.config)
+			if len(tt.wantFields) == 0 {
+				if err != nil {
+					t.Fatalf("validateLanguageComponent err: %v", err)
+				}
+				return
+			}
+
+			if got := fieldsOf(t, err); !reflect.DeepEqual(got, tt.wantFields) {
+				t.Errorf("validateLanguageComponent fields = %v, want %v", got, tt.wantFields)
+			}
+		})
+	}
+}
+
+// fieldsOf returns the fields of a bad request listing the fields at fault
+func fieldsOf(t *testing.T, err error) []string {
+	t.Helper()
+
+	if !errors.Is(err, httpPkg.BadRequest) {
+		t.Fatalf("err: %v, want a bad request", err)
+	}
+
+	var fieldErrors httpPkg.FieldErrors
+	if !errors.As(err, &fieldErrors) {
+		t.Fatalf("err: %v, want field errors", err)
+	}
+
+	fields := make([]string, 0, len(fieldErrors))
+	for _, fieldErr := range fieldErrors {
+		fields = append(fields, fieldErr.Field)
+	}
+
+	return fields
+}
@@ -0,0 +1,231 @@
+package topic
+
+import (
+	"context"
+	"gallery-service/internal/application/revision"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/registry"
+	httpPkg "gallery-service/pkg/http"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// mediaItem is a pointer to a TopicImageConfig, TopicVideoConfig or TopicAudioConfig,
+// which all embed models.TopicMediaItem
+type mediaItem[T any] interface {
+	*T
+	Media() *models.TopicMediaItem
+}
+
+// prepareMedia gives the media of the languages that have no ID one and checks that
+// each array has at most one primary item and no ID twice
+func prepareMedia(configs []models.TopicLanguageConfig) error {
+	for i := range configs {
+		configs[i].EnsureMediaIDs()
+
+		if err := checkMedia(configs[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkMedia(config models.TopicLanguageConfig) error {
+	if err := checkMediaItems(config.Language, constants.TopicMediaImages, config.Images); err != nil {
+		return err
+	}
+
+	if err := checkMediaItems(config.Language, constants.TopicMediaVideos, config.Videos); err != nil {
+		return err
+	}
+
+	return checkMediaItems(config.Language, constants.TopicMediaAudios, config.Audios)
+}
+
+func checkMediaItems[T any, P mediaItem[T]](language constants.Language, kind constants.TopicMediaKind, items []T) error {
+	seen := make(map[primitive.ObjectID]bool, len(items))
+	primary := false
+	for i := range items {
+		item := P(&items[i]).Media()
+		if seen[item.ID] {
+			return errors.Wrapf(httpPkg.BadRequest, "language %s %s: duplicate id %s", language, kind, item.ID.Hex())
+		}
+		seen[item.ID] = true
+
+		if item.Primary {
+			if primary {
+				return errors.Wrapf(httpPkg.BadRequest, "language %s %s: more than one primary item", language, kind)
+			}
+			primary = true
+		}
+	}
+
+	return nil
+}
+
+// addMediaItem appends item to items under a new ID, making it the only primary item
+// when it is primary
+func addMediaItem[T any, P mediaItem[T]](items []T, item T) ([]T, primitive.ObjectID) {
+	added := P(&item).Media()
+	added.ID = primitive.NewObjectID()
+	if added.Primary {
+		clearPrimary[T, P](items)
+	}
+
+	return append(items, item), added.ID
+}
+
+// updateMediaItem replaces the item with the given ID, which it keeps
+func updateMediaItem[T any, P mediaItem[T]](items []T, id primitive.ObjectID, item T) error {
+	i, err := findMediaItem[T, P](items, id)
+	if err != nil {
+		return err
+	}
+
+	updated := P(&item).Media()
+	updated.ID = id
+	if updated.Primary {
+		clearPrimary[T, P](items)
+	}
+	items[i] = item
+
+	return nil
+}
+
+func removeMediaItem[T any, P mediaItem[T]](items []T, id primitive.ObjectID) ([]T, error) {
+	i, err := findMediaItem[T, P](items, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(items[:i], items[i+1:]...), nil
+}
+
+// reorderMediaItems puts the listed items first, in the order of ids, followed by the
+// others in the order they were in
+func reorderMediaItems[T any, P mediaItem[T]](items []T, ids []primitive.ObjectID) ([]T, error) {
+	ordered := make([]T, 0, len(items))
+	listed := make(map[primitive.ObjectID]bool, len(ids))
+	for _, id := range ids {
+		i, err := findMediaItem[T, P](items, id)
+		if err != nil {
+			return nil, err
+		}
+		if listed[id] {
+			continue
+		}
+
+		listed[id] = true
+		ordered = append(ordered, items[i])
+	}
+
+	for i := range items {
+		if !listed[P(&items[i]).Media().ID] {
+			ordered = append(ordered, items[i])
+		}
+	}
+
+	return ordered, nil
+}
+
+func findMediaItem[T any, P mediaItem[T]](items []T, id primitive.ObjectID) (int, error) {
+	for i := range items {
+		if P(&items[i]).Media().ID == id {
+			return i, nil
+		}
+	}
+
+	return 0, errors.Errorf("media item %s not found", id.Hex())
+}
+
+func clearPrimary[T any, P mediaItem[T]](items []T) {
+	for i := range items {
+		P(&items[i]).Media().Primary = false
+	}
+}
+
+// editTopicLanguage applies edit to the entry of one language of a topic and saves it.
+// Media stored before items had an ID get one first. The entry is only saved if the
+// topic has not changed since it was read, so that two edits of the same language do not
+// undo each other.
+func editTopicLanguage(
+	ctx context.Context,
+	topicRepo repository.TopicRepository,
+	recorder revision.Recorder,
+	txManager repository.TransactionManager,
+	topicID string,
+	code string,
+	edit func(config *models.TopicLanguageConfig) error,
+) error {
+	language, ok := registry.LanguageByCode(code)
+	if !ok {
+		return errors.Wrapf(httpPkg.BadRequest, "unsupported language %s", code)
+	}
+
+	topic, err := topicRepo.GetByID(ctx, topicID)
+	if err != nil {
+		return err
+	}
+
+	index := -1
+	for i, config := range topic.LanguageConfig {
+		if config.Language == language.Name {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return errors.Errorf("language %s of topic %s not found", code, topicID)
+	}
+
+	// Work on a copy, the topic as read is the previous revision
+	config := topic.LanguageConfig[index]
+	config.Images = append([]models.TopicImageConfig(nil), config.Images...)
+	config.Videos = append([]models.TopicVideoConfig(nil), config.Videos...)
+	config.Audios = append([]models.TopicAudioConfig(nil), config.Audios...)
+	config.EnsureMediaIDs()
+
+	if err := edit(&config); err != nil {
+		return err
+	}
+
+	if err := validateLanguageTimecodes(config); err != nil {
+		return err
+	}
+
+	if err := checkMedia(config); err != nil {
+		return err
+	}
+
+	if err := validateLanguageComponent(config); err != nil {
+		return err
+	}
+
+	return txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		_, err := recorder.Record(ctx, revision.Entry{
+			EntityType:     constants.RevisionEntityTopic,
+			EntityID:       topic.ID,
+			OrganizationID: topic.OrganizationID,
+			Previous:       topic,
+			Action:         constants.RevisionActionUpdate,
+		})
+		if err != nil {
+			return err
+		}
+
+		saved, err := topicRepo.ReplaceLanguage(ctx, topic.ID, config, topic.UpdatedAt)
+		if err != nil {
+			return err
+		}
+
+		if !saved {
+			return errors.Wrap(httpPkg.Conflict, "the topic was changed meanwhile, try again")
+		}
+
+		return nil
+	})
+}
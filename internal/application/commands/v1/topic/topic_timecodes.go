@@ -0,0 +1,36 @@
+package topic
+
+import (
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/pkg/timecode"
+	httpPkg "gallery-service/pkg/http"
+
+	"github.com/pkg/errors"
+)
+
+// validateTimecodes checks that every video and audio of the languages ends after it starts
+func validateTimecodes(configs []models.TopicLanguageConfig) error {
+	for _, config := range configs {
+		if err := validateLanguageTimecodes(config); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateLanguageTimecodes(config models.TopicLanguageConfig) error {
+	for i, video := range config.Videos {
+		if err := timecode.CheckRange(video.StartTime, video.EndTime); err != nil {
+			return errors.Wrapf(httpPkg.BadRequest, "language %s video %d: %v", config.Language, i, err)
+		}
+	}
+
+	for i, audio := range config.Audios {
+		if err := timecode.CheckRange(audio.StartTime, audio.EndTime); err != nil {
+			return errors.Wrapf(httpPkg.BadRequest, "language %s audio %d: %v", config.Language, i, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package topic
+
+import "gallery-service/internal/pkg/constants"
+
+// TransitionTopicCommand moves a topic along the editorial workflow
+type TransitionTopicCommand struct {
+	ID      string                `json:"id" validate:"required"`
+	Action  constants.TopicAction `json:"action" validate:"required,oneof=submit approve reject publish archive"`
+	Comment string                `json:"comment" validate:"max=2000"`
+}
+
+func NewTransitionTopicCommand(id string, action constants.TopicAction, comment string) *TransitionTopicCommand {
+	return &TransitionTopicCommand{
+		ID:      id,
+		Action:  action,
+		Comment: comment,
+	}
+}
@@ -0,0 +1,76 @@
+package topic
+
+import (
+	"context"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/tenant"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type TransitionTopicCommandHandler interface {
+	Handle(ctx context.Context, command *TransitionTopicCommand) (constants.TopicStatus, error)
+}
+
+type transitionTopicHandler struct {
+	log       zap.Logger
+	topicRepo repository.TopicRepository
+}
+
+func NewTransitionTopicHandler(log zap.Logger, topicRepo repository.TopicRepository) *transitionTopicHandler {
+	return &transitionTopicHandler{log: log, topicRepo: topicRepo}
+}
+
+// Handle applies the action to the topic, records who did it and when, and returns the
+// status the topic is now in. An action that does not apply to the current status, or a
+// status changed meanwhile by someone else, is a conflict.
+func (u *transitionTopicHandler) Handle(ctx context.Context, command *TransitionTopicCommand) (constants.TopicStatus, error) {
+	topic, err := u.topicRepo.GetByID(ctx, command.ID)
+	if err != nil {
+		return "", err
+	}
+
+	scope := tenant.FromContext(ctx)
+	if !scope.CanWrite(topic.OrganizationID) {
+		return "", errors.Wrap(httpPkg.Forbidden, "cannot change the status of this topic")
+	}
+
+	from := topic.WorkflowStatus()
+	to, ok := command.Action.Next(from)
+	if !ok {
+		return "", errors.Wrapf(httpPkg.Conflict, "cannot %s a topic that is %s", command.Action, from)
+	}
+
+	comment := strings.TrimSpace(command.Comment)
+	if command.Action == constants.TopicActionReject && comment == "" {
+		return "", errors.Wrap(httpPkg.BadRequest, "a comment is required to reject a topic")
+	}
+
+	change := models.TopicStatusChange{
+		Action:  command.Action,
+		From:    from,
+		To:      to,
+		Comment: comment,
+		ActorID: scope.UserID(),
+		At:      time.Now(),
+	}
+
+	moved, err := u.topicRepo.Transition(ctx, topic.ID, change)
+	if err != nil {
+		return "", err
+	}
+
+	if !moved {
+		return "", errors.Wrapf(httpPkg.Conflict, "topic %s changed status meanwhile", topic.ID.Hex())
+	}
+
+	u.log.Infof("(TransitionTopicCommandHandler.Handle) id: {%s}, action: {%s}, from: {%s}, to: {%s}", topic.ID.Hex(), change.Action, from, to)
+
+	return to, nil
+}
@@ -2,8 +2,10 @@ package topic
 
 import (
 	"context"
+	"gallery-service/internal/application/revision"
 	"gallery-service/internal/domain/models"
 	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
 	"gallery-service/pkg/zap"
 	"time"
 )
@@ -15,19 +17,37 @@ type UpdateTopicCommandHandler interface {
 type updateTopicHandler struct {
 	log       zap.Logger
 	topicRepo repository.TopicRepository
+	recorder  revision.Recorder
+	txManager repository.TransactionManager
 }
 
 func NewUpdateTopicHandler(
 	log zap.Logger,
 	topicRepo repository.TopicRepository,
+	recorder revision.Recorder,
+	txManager repository.TransactionManager,
 ) *updateTopicHandler {
 	return &updateTopicHandler{
 		log:       log,
 		topicRepo: topicRepo,
+		recorder:  recorder,
+		txManager: txManager,
 	}
 }
 
 func (u *updateTopicHandler) Handle(ctx context.Context, command *UpdateTopicCommand) error {
+	if err := validateTimecodes(command.LanguageConfig); err != nil {
+		return err
+	}
+
+	if err := prepareMedia(command.LanguageConfig); err != nil {
+		return err
+	}
+
+	if err := validateComponents(command.LanguageConfig); err != nil {
+		return err
+	}
+
 	topic, err := u.topicRepo.GetByID(ctx, command.ID)
 	if err != nil {
 		return err
@@ -36,12 +56,24 @@ func (u *updateTopicHandler) Handle(ctx context.Context, command *UpdateTopicCom
 	t := models.Topic{
 		ID:             topic.ID,
 		TopicName:      command.TopicName,
-		IsPublished:    command.IsPublished,
 		LanguageConfig: command.LanguageConfig,
 		CreatedAt:      time.Now(),
 		UpdatedAt:      time.Now(),
 	}
 
-	// Save to database
-	return u.topicRepo.Update(ctx, &t)
+	// Keep the replaced document as a revision and save, both or neither
+	return u.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		_, err := u.recorder.Record(ctx, revision.Entry{
+			EntityType:     constants.RevisionEntityTopic,
+			EntityID:       topic.ID,
+			OrganizationID: topic.OrganizationID,
+			Previous:       topic,
+			Action:         constants.RevisionActionUpdate,
+		})
+		if err != nil {
+			return err
+		}
+
+		return u.topicRepo.Update(ctx, &t)
+	})
 }
@@ -0,0 +1,38 @@
+package topic
+
+import (
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/pkg/constants"
+)
+
+// UpdateTopicMediaCommand replaces the item ItemID of the Kind media of one language of a
+// topic. Only the item of that kind, Image, Video or Audio, is used.
+type UpdateTopicMediaCommand struct {
+	ID     string                   `json:"id" validate:"required"`
+	Code   string                   `json:"code" validate:"required"`
+	Kind   constants.TopicMediaKind `json:"kind" validate:"required,oneof=images videos audios"`
+	ItemID string                   `json:"item_id" validate:"required"`
+	Image  models.TopicImageConfig  `json:"image"`
+	Video  models.TopicVideoConfig  `json:"video"`
+	Audio  models.TopicAudioConfig  `json:"audio"`
+}
+
+func NewUpdateTopicMediaCommand(
+	id string,
+	code string,
+	kind constants.TopicMediaKind,
+	itemID string,
+	image models.TopicImageConfig,
+	video models.TopicVideoConfig,
+	audio models.TopicAudioConfig,
+) *UpdateTopicMediaCommand {
+	return &UpdateTopicMediaCommand{
+		ID:     id,
+		Code:   code,
+		Kind:   kind,
+		ItemID: itemID,
+		Image:  image,
+		Video:  video,
+		Audio:  audio,
+	}
+}
@@ -0,0 +1,65 @@
+package topic
+
+import (
+	"context"
+	"gallery-service/internal/application/revision"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type UpdateTopicMediaCommandHandler interface {
+	Handle(ctx context.Context, command *UpdateTopicMediaCommand) error
+}
+
+type updateTopicMediaHandler struct {
+	log       zap.Logger
+	topicRepo repository.TopicRepository
+	recorder  revision.Recorder
+	txManager repository.TransactionManager
+}
+
+func NewUpdateTopicMediaHandler(
+	log zap.Logger,
+	topicRepo repository.TopicRepository,
+	recorder revision.Recorder,
+	txManager repository.TransactionManager,
+) *updateTopicMediaHandler {
+	return &updateTopicMediaHandler{
+		log:       log,
+		topicRepo: topicRepo,
+		recorder:  recorder,
+		txManager: txManager,
+	}
+}
+
+func (u *updateTopicMediaHandler) Handle(ctx context.Context, command *UpdateTopicMediaCommand) error {
+	itemID, err := primitive.ObjectIDFromHex(command.ItemID)
+	if err != nil {
+		return errors.Wrap(httpPkg.BadRequest, "invalid item id")
+	}
+
+	err = editTopicLanguage(ctx, u.topicRepo, u.recorder, u.txManager, command.ID, command.Code, func(config *models.TopicLanguageConfig) error {
+		switch command.Kind {
+		case constants.TopicMediaImages:
+			return updateMediaItem(config.Images, itemID, command.Image)
+		case constants.TopicMediaVideos:
+			return updateMediaItem(config.Videos, itemID, command.Video)
+		case constants.TopicMediaAudios:
+			return updateMediaItem(config.Audios, itemID, command.Audio)
+		}
+
+		return nil
+	})
+	if err != nil {
+		u.log.Errorf("(UpdateTopicMediaCommandHandler.Handle) err: {%v}", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,13 @@
+package trash
+
+type PurgeTrashItemCommand struct {
+	Type string `json:"type" validate:"required,oneof=folder cluster topic"`
+	ID   string `json:"id" validate:"required"`
+}
+
+func NewPurgeTrashItemCommand(itemType string, id string) *PurgeTrashItemCommand {
+	return &PurgeTrashItemCommand{
+		Type: itemType,
+		ID:   id,
+	}
+}
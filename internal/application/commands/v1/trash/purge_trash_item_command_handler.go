@@ -0,0 +1,129 @@
+package trash
+
+import (
+	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type PurgeTrashItemCommandHandler interface {
+	Handle(ctx context.Context, command *PurgeTrashItemCommand) error
+}
+
+type purgeTrashItemHandler struct {
+	log         zap.Logger
+	folderRepo  repository.FolderRepository
+	clusterRepo repository.ClusterRepository
+	topicRepo   repository.TopicRepository
+	trashAccess access.TrashAccess
+	txManager   repository.TransactionManager
+}
+
+func NewPurgeTrashItemHandler(
+	log zap.Logger,
+	folderRepo repository.FolderRepository,
+	clusterRepo repository.ClusterRepository,
+	topicRepo repository.TopicRepository,
+	trashAccess access.TrashAccess,
+	txManager repository.TransactionManager,
+) *purgeTrashItemHandler {
+	return &purgeTrashItemHandler{
+		log:         log,
+		folderRepo:  folderRepo,
+		clusterRepo: clusterRepo,
+		topicRepo:   topicRepo,
+		trashAccess: trashAccess,
+		txManager:   txManager,
+	}
+}
+
+// Handle deletes a trash item for good, before its retention period is over
+func (u *purgeTrashItemHandler) Handle(ctx context.Context, command *PurgeTrashItemCommand) error {
+	id, _ := primitive.ObjectIDFromHex(command.ID)
+
+	switch constants.TrashItemType(command.Type) {
+	case constants.TrashItemFolder:
+		return u.purgeFolder(ctx, id)
+	case constants.TrashItemCluster:
+		return u.purgeCluster(ctx, id)
+	default:
+		return u.purgeTopic(ctx, id)
+	}
+}
+
+func (u *purgeTrashItemHandler) purgeFolder(ctx context.Context, id primitive.ObjectID) error {
+	folder, err := u.folderRepo.GetDeletedByID(ctx, id.Hex())
+	if err != nil {
+		return errors.New("folder not found in the trash")
+	}
+
+	if err := u.trashAccess.RequireFolder(ctx, folder); err != nil {
+		return err
+	}
+
+	return u.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if _, err := u.clusterRepo.Purge(ctx, folder.ID); err != nil {
+			u.log.Errorf("(PurgeTrashItemCommandHandler.purgeFolder) err: {%v}", err)
+			return errors.Wrap(err, "failed to purge clusters")
+		}
+
+		if _, err := u.topicRepo.Purge(ctx, folder.ID); err != nil {
+			u.log.Errorf("(PurgeTrashItemCommandHandler.purgeFolder) err: {%v}", err)
+			return errors.Wrap(err, "failed to purge topics")
+		}
+
+		if _, err := u.folderRepo.Purge(ctx, folder.ID); err != nil {
+			u.log.Errorf("(PurgeTrashItemCommandHandler.purgeFolder) err: {%v}", err)
+			return errors.Wrap(err, "failed to purge folders")
+		}
+
+		return nil
+	})
+}
+
+func (u *purgeTrashItemHandler) purgeCluster(ctx context.Context, id primitive.ObjectID) error {
+	cluster, err := u.clusterRepo.GetDeletedByID(ctx, id.Hex())
+	if err != nil {
+		return errors.New("cluster not found in the trash")
+	}
+
+	if err := u.trashAccess.RequireCluster(ctx, cluster); err != nil {
+		return err
+	}
+
+	// The cluster and its revisions go together
+	return u.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if n, err := u.clusterRepo.Purge(ctx, cluster.ID); n == 0 || err != nil {
+			u.log.Errorf("(PurgeTrashItemCommandHandler.purgeCluster) err: {%v}", err)
+			return errors.New("failed to purge cluster")
+		}
+
+		return nil
+	})
+}
+
+func (u *purgeTrashItemHandler) purgeTopic(ctx context.Context, id primitive.ObjectID) error {
+	if err := u.trashAccess.RequireTopic(ctx); err != nil {
+		return err
+	}
+
+	topic, err := u.topicRepo.GetDeletedByID(ctx, id.Hex())
+	if err != nil {
+		return errors.New("topic not found in the trash")
+	}
+
+	// The topic and its revisions go together
+	return u.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if n, err := u.topicRepo.Purge(ctx, topic.ID); n == 0 || err != nil {
+			u.log.Errorf("(PurgeTrashItemCommandHandler.purgeTopic) err: {%v}", err)
+			return errors.New("failed to purge topic")
+		}
+
+		return nil
+	})
+}
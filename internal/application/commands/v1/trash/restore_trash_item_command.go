@@ -0,0 +1,13 @@
+package trash
+
+type RestoreTrashItemCommand struct {
+	Type string `json:"type" validate:"required,oneof=folder cluster topic"`
+	ID   string `json:"id" validate:"required"`
+}
+
+func NewRestoreTrashItemCommand(itemType string, id string) *RestoreTrashItemCommand {
+	return &RestoreTrashItemCommand{
+		Type: itemType,
+		ID:   id,
+	}
+}
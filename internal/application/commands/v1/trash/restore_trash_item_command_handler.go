@@ -0,0 +1,143 @@
+package trash
+
+import (
+	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type RestoreTrashItemCommandHandler interface {
+	Handle(ctx context.Context, command *RestoreTrashItemCommand) error
+}
+
+type restoreTrashItemHandler struct {
+	log         zap.Logger
+	folderRepo  repository.FolderRepository
+	clusterRepo repository.ClusterRepository
+	topicRepo   repository.TopicRepository
+	trashAccess access.TrashAccess
+	txManager   repository.TransactionManager
+}
+
+func NewRestoreTrashItemHandler(
+	log zap.Logger,
+	folderRepo repository.FolderRepository,
+	clusterRepo repository.ClusterRepository,
+	topicRepo repository.TopicRepository,
+	trashAccess access.TrashAccess,
+	txManager repository.TransactionManager,
+) *restoreTrashItemHandler {
+	return &restoreTrashItemHandler{
+		log:         log,
+		folderRepo:  folderRepo,
+		clusterRepo: clusterRepo,
+		topicRepo:   topicRepo,
+		trashAccess: trashAccess,
+		txManager:   txManager,
+	}
+}
+
+func (u *restoreTrashItemHandler) Handle(ctx context.Context, command *RestoreTrashItemCommand) error {
+	id, _ := primitive.ObjectIDFromHex(command.ID)
+
+	switch constants.TrashItemType(command.Type) {
+	case constants.TrashItemFolder:
+		return u.restoreFolder(ctx, id)
+	case constants.TrashItemCluster:
+		return u.restoreCluster(ctx, id)
+	default:
+		return u.restoreTopic(ctx, id)
+	}
+}
+
+// restoreFolder brings the folder back in its old place together with the subtree,
+// clusters and topics deleted with it
+func (u *restoreTrashItemHandler) restoreFolder(ctx context.Context, id primitive.ObjectID) error {
+	folder, err := u.folderRepo.GetDeletedByID(ctx, id.Hex())
+	if err != nil {
+		return errors.New("folder not found in the trash")
+	}
+
+	if folder.ParentID != nil {
+		if _, err := u.folderRepo.GetByID(ctx, folder.ParentID.Hex()); err != nil {
+			return errors.Wrap(httpPkg.Conflict, "parent folder is in the trash")
+		}
+	}
+
+	if err := u.trashAccess.RequireFolder(ctx, folder); err != nil {
+		return err
+	}
+
+	return u.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if _, err := u.folderRepo.Restore(ctx, folder.ID); err != nil {
+			u.log.Errorf("(RestoreTrashItemCommandHandler.restoreFolder) err: {%v}", err)
+			return errors.Wrap(err, "failed to restore folders")
+		}
+
+		if _, err := u.clusterRepo.Restore(ctx, folder.ID); err != nil {
+			u.log.Errorf("(RestoreTrashItemCommandHandler.restoreFolder) err: {%v}", err)
+			return errors.Wrap(err, "failed to restore clusters")
+		}
+
+		if _, err := u.topicRepo.Restore(ctx, folder.ID); err != nil {
+			u.log.Errorf("(RestoreTrashItemCommandHandler.restoreFolder) err: {%v}", err)
+			return errors.Wrap(err, "failed to restore topics")
+		}
+
+		return nil
+	})
+}
+
+func (u *restoreTrashItemHandler) restoreCluster(ctx context.Context, id primitive.ObjectID) error {
+	cluster, err := u.clusterRepo.GetDeletedByID(ctx, id.Hex())
+	if err != nil {
+		return errors.New("cluster not found in the trash")
+	}
+
+	if !cluster.FolderID.IsZero() {
+		if _, err := u.folderRepo.GetByID(ctx, cluster.FolderID.Hex()); err != nil {
+			return errors.Wrap(httpPkg.Conflict, "folder is in the trash")
+		}
+	}
+
+	if err := u.trashAccess.RequireCluster(ctx, cluster); err != nil {
+		return err
+	}
+
+	if n, err := u.clusterRepo.Restore(ctx, cluster.ID); n == 0 || err != nil {
+		u.log.Errorf("(RestoreTrashItemCommandHandler.restoreCluster) err: {%v}", err)
+		return errors.New("failed to restore cluster")
+	}
+
+	return nil
+}
+
+func (u *restoreTrashItemHandler) restoreTopic(ctx context.Context, id primitive.ObjectID) error {
+	if err := u.trashAccess.RequireTopic(ctx); err != nil {
+		return err
+	}
+
+	topic, err := u.topicRepo.GetDeletedByID(ctx, id.Hex())
+	if err != nil {
+		return errors.New("topic not found in the trash")
+	}
+
+	if !topic.FolderID.IsZero() {
+		if _, err := u.folderRepo.GetByID(ctx, topic.FolderID.Hex()); err != nil {
+			return errors.Wrap(httpPkg.Conflict, "folder is in the trash")
+		}
+	}
+
+	if n, err := u.topicRepo.Restore(ctx, topic.ID); n == 0 || err != nil {
+		u.log.Errorf("(RestoreTrashItemCommandHandler.restoreTopic) err: {%v}", err)
+		return errors.New("failed to restore topic")
+	}
+
+	return nil
+}
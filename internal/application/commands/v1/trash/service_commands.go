@@ -0,0 +1,16 @@
+package trash
+
+type Commands struct {
+	RestoreItem RestoreTrashItemCommandHandler
+	PurgeItem   PurgeTrashItemCommandHandler
+}
+
+func NewTrashCommands(
+	restoreItem RestoreTrashItemCommandHandler,
+	purgeItem PurgeTrashItemCommandHandler,
+) *Commands {
+	return &Commands{
+		RestoreItem: restoreItem,
+		PurgeItem:   purgeItem,
+	}
+}
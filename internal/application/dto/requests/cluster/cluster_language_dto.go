@@ -0,0 +1,10 @@
+package cluster
+
+import "gallery-service/internal/domain/models"
+
+// SetClusterLanguageReqDto is the entry of one language, the language itself comes from
+// the path
+type SetClusterLanguageReqDto struct {
+	Video models.VideoConfig `json:"video"`
+	Audio models.AudioConfig `json:"audio"`
+}
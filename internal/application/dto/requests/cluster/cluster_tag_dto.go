@@ -0,0 +1,14 @@
+package cluster
+
+// GetAllClusterReqDto filters the cluster listing by tags, comma separated. With
+// tag_mode=and, the default, a cluster needs every tag, with or any of them. terms lists
+// taxonomy term ids, comma separated, a cluster needs to be classified under each of them.
+type GetAllClusterReqDto struct {
+	Tags    string `query:"tags"`
+	TagMode string `query:"tag_mode" validate:"omitempty,oneof=and or"`
+	Terms   string `query:"terms"`
+}
+
+type RenameClusterTagReqDto struct {
+	Name string `json:"name" validate:"required,max=64"`
+}
@@ -9,6 +9,7 @@ type CreateClusterReqDto struct {
 	Title          string                  `json:"title" validate:"required"`
 	Note           string                  `json:"note" validate:"required"`
 	Image          models.ImageConfig      `json:"image" validate:"required"`
-	LanguageConfig []models.LanguageConfig `json:"language_config" validate:"required"`
+	LanguageConfig []models.LanguageConfig `json:"language_config" validate:"required,unique=Language"`
 	FolderID       string                  `json:"folder_id" validate:"required"`
+	Tags           []string                `json:"tags" validate:"omitempty,dive,required,max=64"`
 }
@@ -0,0 +1,17 @@
+package cluster
+
+import "gallery-service/internal/domain/models"
+
+// PatchClusterReqDto is a cluster once a merge patch is applied to it. It is checked like
+// an update, except that clusters stored before folders were required may stay without
+// one.
+type PatchClusterReqDto struct {
+	ID             string                  `json:"id" validate:"required"`
+	ClusterName    string                  `json:"cluster_name" validate:"required"`
+	Title          string                  `json:"title" validate:"required"`
+	Note           string                  `json:"note" validate:"required"`
+	Image          models.ImageConfig      `json:"image" validate:"required"`
+	LanguageConfig []models.LanguageConfig `json:"language_config" validate:"required,unique=Language"`
+	FolderID       string                  `json:"folder_id"`
+	Tags           []string                `json:"tags" validate:"omitempty,dive,required,max=64"`
+}
@@ -0,0 +1,6 @@
+package cluster
+
+type ReorderClustersReqDto struct {
+	FolderID string   `json:"folder_id" validate:"required"`
+	IDs      []string `json:"ids" validate:"required,min=1"`
+}
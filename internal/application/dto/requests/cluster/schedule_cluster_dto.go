@@ -0,0 +1,10 @@
+package cluster
+
+import "time"
+
+// ScheduleClusterReqDto replaces when a cluster is shown and hidden. A time left out
+// clears it.
+type ScheduleClusterReqDto struct {
+	PublishAt   *time.Time `json:"publish_at"`
+	UnpublishAt *time.Time `json:"unpublish_at"`
+}
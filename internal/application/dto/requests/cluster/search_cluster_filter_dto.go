@@ -2,4 +2,7 @@ package cluster
 
 type SearchClusterFilterReqDto struct {
 	Keyword string `json:"keyword,omitempty" validate:"required"`
+	Tags    string `json:"tags,omitempty" query:"tags"`
+	TagMode string `json:"tag_mode,omitempty" query:"tag_mode" validate:"omitempty,oneof=and or"`
+	Terms   string `json:"terms,omitempty" query:"terms"`
 }
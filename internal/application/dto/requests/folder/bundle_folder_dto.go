@@ -0,0 +1,13 @@
+package folder
+
+type ExportFolderReqDto struct {
+	// Topics is a comma separated list of the topic ids bundled along with the folder
+	Topics string `json:"topics,omitempty" query:"topics"`
+	Format string `json:"format,omitempty" query:"format" validate:"omitempty,oneof=json zip"`
+}
+
+type ImportFolderReqDto struct {
+	ParentID   string `json:"parent_id,omitempty" query:"parent_id"`
+	OnConflict string `json:"on_conflict,omitempty" query:"on_conflict" validate:"omitempty,oneof=skip overwrite"`
+	DryRun     bool   `json:"dry_run,omitempty" query:"dry_run"`
+}
@@ -0,0 +1,6 @@
+package folder
+
+type DuplicateFolderReqDto struct {
+	FolderName *string `json:"folder_name"`
+	ParentID   *string `json:"parent_id"`
+}
@@ -0,0 +1,12 @@
+package folder
+
+type GrantFolderAccessReqDto struct {
+	UserID     string `json:"user_id"`
+	Role       string `json:"role"`
+	Permission string `json:"permission"`
+}
+
+type RevokeFolderAccessReqDto struct {
+	UserID string `json:"user_id,omitempty" query:"user_id"`
+	Role   string `json:"role,omitempty" query:"role"`
+}
@@ -0,0 +1,6 @@
+package folder
+
+type GetFolderTreeReqDto struct {
+	RootID   string `json:"root_id,omitempty" query:"root_id"`
+	MaxDepth int    `json:"max_depth,omitempty" query:"max_depth" validate:"gte=0"`
+}
@@ -0,0 +1,5 @@
+package folder
+
+type MoveFolderReqDto struct {
+	ParentID *string `json:"parent_id"`
+}
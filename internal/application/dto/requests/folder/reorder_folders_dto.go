@@ -0,0 +1,6 @@
+package folder
+
+type ReorderFoldersReqDto struct {
+	ParentID *string  `json:"parent_id"`
+	IDs      []string `json:"ids" validate:"required,min=1"`
+}
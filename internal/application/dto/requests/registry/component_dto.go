@@ -0,0 +1,28 @@
+package registry
+
+// ComponentMediaReqDto is the least number of images, videos and audios a language entry
+// needs for a component
+type ComponentMediaReqDto struct {
+	MinImages int `json:"min_images" validate:"min=0"`
+	MinVideos int `json:"min_videos" validate:"min=0"`
+	MinAudios int `json:"min_audios" validate:"min=0"`
+}
+
+// CreateComponentReqDto adds a component. key is what topic languages refer to it by,
+// such as video-player. The component is enabled unless enabled is false.
+type CreateComponentReqDto struct {
+	Key          string                `json:"key" validate:"required,max=64"`
+	Name         string                `json:"name" validate:"required,max=64"`
+	DisplayNames map[string]string     `json:"display_names" validate:"omitempty,dive,max=64"`
+	Media        *ComponentMediaReqDto `json:"media"`
+	Position     *int64                `json:"position" validate:"omitempty,min=0"`
+	Enabled      *bool                 `json:"enabled"`
+}
+
+// UpdateComponentReqDto changes the fields that are sent
+type UpdateComponentReqDto struct {
+	DisplayNames map[string]string     `json:"display_names" validate:"omitempty,dive,max=64"`
+	Media        *ComponentMediaReqDto `json:"media"`
+	Position     *int64                `json:"position" validate:"omitempty,min=0"`
+	Enabled      *bool                 `json:"enabled"`
+}
@@ -0,0 +1,19 @@
+package registry
+
+// CreateLanguageReqDto adds a language. code is the base language tag, such as ja, and
+// name is what content in the language is stored under. The language is enabled unless
+// enabled is false.
+type CreateLanguageReqDto struct {
+	Code         string            `json:"code" validate:"required,lowercase,alpha,min=2,max=3"`
+	Name         string            `json:"name" validate:"required,max=64"`
+	DisplayNames map[string]string `json:"display_names" validate:"omitempty,dive,max=64"`
+	Position     *int64            `json:"position" validate:"omitempty,min=0"`
+	Enabled      *bool             `json:"enabled"`
+}
+
+// UpdateLanguageReqDto changes the fields that are sent
+type UpdateLanguageReqDto struct {
+	DisplayNames map[string]string `json:"display_names" validate:"omitempty,dive,max=64"`
+	Position     *int64            `json:"position" validate:"omitempty,min=0"`
+	Enabled      *bool             `json:"enabled"`
+}
@@ -0,0 +1,6 @@
+package revision
+
+type RevisionDiffReqDto struct {
+	From int64 `json:"from" query:"from" validate:"required,gt=0"`
+	To   int64 `json:"to,omitempty" query:"to" validate:"gte=0"`
+}
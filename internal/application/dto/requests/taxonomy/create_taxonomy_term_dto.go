@@ -0,0 +1,9 @@
+package taxonomy
+
+// CreateTaxonomyTermReqDto adds a term. kind is required for a root term, a child term
+// takes the kind of its parent.
+type CreateTaxonomyTermReqDto struct {
+	Name     string  `json:"name" validate:"required,max=128"`
+	Kind     string  `json:"kind" validate:"omitempty,oneof=subject skill age_range"`
+	ParentID *string `json:"parent_id"`
+}
@@ -0,0 +1,5 @@
+package taxonomy
+
+type GetTaxonomyReqDto struct {
+	Kind string `json:"kind,omitempty" query:"kind" validate:"omitempty,oneof=subject skill age_range"`
+}
@@ -0,0 +1,5 @@
+package taxonomy
+
+type MoveTaxonomyTermReqDto struct {
+	ParentID *string `json:"parent_id"`
+}
@@ -0,0 +1,5 @@
+package taxonomy
+
+type UpdateTaxonomyTermReqDto struct {
+	Name string `json:"name" validate:"required,max=128"`
+}
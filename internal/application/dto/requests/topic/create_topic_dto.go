@@ -4,6 +4,6 @@ import "gallery-service/internal/domain/models"
 
 type CreateTopicReqDto struct {
 	TopicName      string                       `json:"topic_name" validate:"required"`
-	IsPublished    bool                         `json:"is_published"`
-	LanguageConfig []models.TopicLanguageConfig `json:"language_config" validate:"required"`
+	FolderID       string                       `json:"folder_id"`
+	LanguageConfig []models.TopicLanguageConfig `json:"language_config" validate:"required,unique=Language"`
 }
@@ -0,0 +1,7 @@
+package topic
+
+// MoveTopicReqDto files a topic in a folder. An empty folder_id takes it out of the
+// folder tree.
+type MoveTopicReqDto struct {
+	FolderID string `json:"folder_id"`
+}
@@ -0,0 +1,8 @@
+package topic
+
+// GetPublishedTopicsReqDto orders the published topics by sort, position by default, in
+// the order given, ascending by default
+type GetPublishedTopicsReqDto struct {
+	Sort  string `query:"sort" validate:"omitempty,oneof=position topic_name created_at updated_at"`
+	Order string `query:"order" validate:"omitempty,oneof=asc desc"`
+}
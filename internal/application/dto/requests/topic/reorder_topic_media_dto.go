@@ -0,0 +1,6 @@
+package topic
+
+// ReorderTopicMediaReqDto lists media item ids in the order they should come first
+type ReorderTopicMediaReqDto struct {
+	IDs []string `json:"ids" validate:"required,min=1"`
+}
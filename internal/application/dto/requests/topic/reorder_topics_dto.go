@@ -0,0 +1,8 @@
+package topic
+
+// ReorderTopicsReqDto orders the topics of a folder, or the topics outside the folder
+// tree when FolderID is empty
+type ReorderTopicsReqDto struct {
+	FolderID string   `json:"folder_id"`
+	IDs      []string `json:"ids" validate:"required,min=1"`
+}
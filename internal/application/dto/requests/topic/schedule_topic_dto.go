@@ -0,0 +1,10 @@
+package topic
+
+import "time"
+
+// ScheduleTopicReqDto replaces when a topic is published and archived. A time left out
+// clears it.
+type ScheduleTopicReqDto struct {
+	PublishAt   *time.Time `json:"publish_at"`
+	UnpublishAt *time.Time `json:"unpublish_at"`
+}
@@ -2,4 +2,5 @@ package topic
 
 type SearchTopicFilterReqDto struct {
 	Keyword string `json:"keyword,omitempty" validate:"required"`
+	Terms   string `json:"terms,omitempty" query:"terms"`
 }
@@ -0,0 +1,15 @@
+package topic
+
+import "gallery-service/internal/domain/models"
+
+// SetTopicLanguageReqDto is the entry of one language, the language itself comes from
+// the path
+type SetTopicLanguageReqDto struct {
+	Component   string                    `json:"component"`
+	Title       string                    `json:"title"`
+	Note        string                    `json:"note"`
+	Description string                    `json:"description"`
+	Images      []models.TopicImageConfig `json:"images"`
+	Videos      []models.TopicVideoConfig `json:"videos"`
+	Audios      []models.TopicAudioConfig `json:"audios"`
+}
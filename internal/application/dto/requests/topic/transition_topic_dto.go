@@ -0,0 +1,6 @@
+package topic
+
+// TransitionTopicReqDto comments a workflow transition. A rejection must say why.
+type TransitionTopicReqDto struct {
+	Comment string `json:"comment"`
+}
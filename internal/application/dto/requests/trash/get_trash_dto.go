@@ -0,0 +1,5 @@
+package trash
+
+type GetTrashReqDto struct {
+	Type string `json:"type,omitempty" query:"type" validate:"omitempty,oneof=folder cluster topic"`
+}
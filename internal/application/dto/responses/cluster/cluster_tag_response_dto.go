@@ -0,0 +1,20 @@
+package cluster
+
+// TagCountDto is how many clusters carry a tag
+type TagCountDto struct {
+	Tag   string `json:"tag"`
+	Count int64  `json:"count"`
+}
+
+type GetAllClusterTagResponseDto struct {
+	Tags []TagCountDto `json:"tags"`
+}
+
+// RenameClusterTagResponseDto reports a tag rename. Skipped counts the clusters carrying
+// the tag in folders the user cannot write, which keep it.
+type RenameClusterTagResponseDto struct {
+	Tag     string `json:"tag"`
+	Name    string `json:"name"`
+	Renamed int64  `json:"renamed"`
+	Skipped int64  `json:"skipped"`
+}
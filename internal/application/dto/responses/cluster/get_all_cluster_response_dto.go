@@ -1,15 +1,27 @@
 package cluster
 
-import "gallery-service/internal/application/dto/responses"
+import (
+	"gallery-service/internal/application/dto/responses"
+	"time"
+)
 
 type GetAllClusterResponseDto struct {
 	Pagination responses.Pagination    `json:"pagination"`
 	Clusters   []GetClusterResponseDto `json:"clusters"`
+	Facets     []TagCountDto           `json:"facets,omitempty"`
 }
 
 type GetClusterResponseDto struct {
-	ID          string `json:"id"`
-	ClusterName string `json:"cluster_name"`
-	ImageKey    string `json:"image_key"`
-	ImageURL    string `json:"image_url"`
+	ID             string     `json:"id"`
+	ClusterName    string     `json:"cluster_name"`
+	ImageKey       string     `json:"image_key"`
+	ImageURL       string     `json:"image_url"`
+	FolderID       string     `json:"folder_id"`
+	Position       int64      `json:"position"`
+	Hidden         bool       `json:"hidden"`
+	PublishAt      *time.Time `json:"publish_at,omitempty"`
+	UnpublishAt    *time.Time `json:"unpublish_at,omitempty"`
+	OrganizationID *string    `json:"organization_id"`
+	Tags           []string   `json:"tags"`
+	TaxonomyTerms  []string   `json:"taxonomy_terms"`
 }
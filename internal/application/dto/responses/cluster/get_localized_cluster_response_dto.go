@@ -0,0 +1,26 @@
+package cluster
+
+import (
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/pkg/constants"
+	"time"
+)
+
+// LocalizedClusterResponseDto is a cluster served in a single language. Language is the
+// language of Localized, and Fallback is set when it is none of the languages asked for.
+type LocalizedClusterResponseDto struct {
+	ID                 string                 `json:"id"`
+	ClusterName        string                 `json:"cluster_name"`
+	Title              string                 `json:"title"`
+	Note               string                 `json:"note"`
+	Image              models.ImageConfig     `json:"image"`
+	FolderID           string                 `json:"folder_id"`
+	Position           int64                  `json:"position"`
+	OrganizationID     *string                `json:"organization_id"`
+	Language           constants.Language     `json:"language"`
+	Fallback           bool                   `json:"fallback"`
+	AvailableLanguages []constants.Language   `json:"available_languages"`
+	Localized          *models.LanguageConfig `json:"localized"`
+	CreatedAt          time.Time              `json:"created_at"`
+	UpdatedAt          time.Time              `json:"updated_at"`
+}
@@ -0,0 +1,15 @@
+package cluster
+
+type MoveClustersResponseDto struct {
+	FolderID string                 `json:"folder_id"`
+	Moved    int64                  `json:"moved"`
+	Results  []MoveClusterResultDto `json:"results"`
+}
+
+// MoveClusterResultDto reports what happened to one of the requested clusters. Status is
+// moved, unchanged when the cluster already was in the folder, or failed with an error.
+type MoveClusterResultDto struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
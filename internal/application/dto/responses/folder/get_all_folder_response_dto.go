@@ -8,9 +8,21 @@ type GetAllFolderResponseDto struct {
 }
 
 type GetFolderResponseDto struct {
-	ID                 string `json:"id"`
-	FolderName         string `json:"folder_name"`
-	FolderThumbnailKey string `json:"folder_thumbnail_key"`
-	FolderThumbnailURL string `json:"folder_thumbnail_url"`
-	ParentID           string `json:"parent_id"`
+	ID                 string  `json:"id"`
+	FolderName         string  `json:"folder_name"`
+	FolderThumbnailKey string  `json:"folder_thumbnail_key"`
+	FolderThumbnailURL string  `json:"folder_thumbnail_url"`
+	ParentID           string  `json:"parent_id"`
+	Position           int64   `json:"position"`
+	OrganizationID     *string `json:"organization_id"`
+}
+
+type GetFolderPathResponseDto struct {
+	Folders []GetFolderResponseDto `json:"folders"`
+}
+
+type DuplicateFolderResponseDto struct {
+	FolderID   string            `json:"folder_id"`
+	FolderIDs  map[string]string `json:"folder_ids"`
+	ClusterIDs map[string]string `json:"cluster_ids"`
 }
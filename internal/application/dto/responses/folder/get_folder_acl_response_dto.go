@@ -0,0 +1,21 @@
+package folder
+
+type GetFolderACLResponseDto struct {
+	Permission string                     `json:"permission"`
+	Grants     []FolderGrantDto           `json:"grants"`
+	Inherited  []InheritedFolderGrantsDto `json:"inherited"`
+}
+
+type FolderGrantDto struct {
+	UserID     string `json:"user_id,omitempty"`
+	Role       string `json:"role,omitempty"`
+	Permission string `json:"permission"`
+}
+
+// InheritedFolderGrantsDto lists the grants of an ancestor folder that also restrict
+// access to the requested folder
+type InheritedFolderGrantsDto struct {
+	FolderID   string           `json:"folder_id"`
+	FolderName string           `json:"folder_name"`
+	Grants     []FolderGrantDto `json:"grants"`
+}
@@ -0,0 +1,17 @@
+package folder
+
+type GetFolderTreeResponseDto struct {
+	Folders []*FolderTreeNodeDto `json:"folders"`
+}
+
+type FolderTreeNodeDto struct {
+	ID                 string               `json:"id"`
+	FolderName         string               `json:"folder_name"`
+	FolderThumbnailKey string               `json:"folder_thumbnail_key"`
+	FolderThumbnailURL string               `json:"folder_thumbnail_url"`
+	ParentID           string               `json:"parent_id"`
+	Position           int64                `json:"position"`
+	ChildCount         int64                `json:"child_count"`
+	ClusterCount       int64                `json:"cluster_count"`
+	Children           []*FolderTreeNodeDto `json:"children"`
+}
@@ -0,0 +1,19 @@
+package folder
+
+// ImportFolderResponseDto lists what an import did with every entry of the bundle, or
+// what it would do when it is a dry run. Entries created by a dry run have no target id.
+type ImportFolderResponseDto struct {
+	DryRun   bool             `json:"dry_run"`
+	FolderID string           `json:"folder_id,omitempty"`
+	Folders  []ImportEntryDto `json:"folders"`
+	Clusters []ImportEntryDto `json:"clusters"`
+	Topics   []ImportEntryDto `json:"topics"`
+	Warnings []string         `json:"warnings"`
+}
+
+type ImportEntryDto struct {
+	SourceID string `json:"source_id"`
+	TargetID string `json:"target_id,omitempty"`
+	Name     string `json:"name"`
+	Action   string `json:"action"`
+}
@@ -0,0 +1,37 @@
+package registry
+
+import (
+	"gallery-service/internal/pkg/constants"
+	"time"
+)
+
+type GetAllLanguageResponseDto struct {
+	Languages []GetLanguageResponseDto `json:"languages"`
+}
+
+type GetLanguageResponseDto struct {
+	ID           string            `json:"id"`
+	Code         string            `json:"code"`
+	Name         string            `json:"name"`
+	DisplayNames map[string]string `json:"display_names"`
+	Position     int64             `json:"position"`
+	Enabled      bool              `json:"enabled"`
+	CreatedAt    time.Time         `json:"created_at"`
+	UpdatedAt    time.Time         `json:"updated_at"`
+}
+
+type GetAllComponentResponseDto struct {
+	Components []GetComponentResponseDto `json:"components"`
+}
+
+type GetComponentResponseDto struct {
+	ID           string                     `json:"id"`
+	Key          string                     `json:"key"`
+	Name         string                     `json:"name"`
+	DisplayNames map[string]string          `json:"display_names"`
+	Media        constants.MediaRequirement `json:"media"`
+	Position     int64                      `json:"position"`
+	Enabled      bool                       `json:"enabled"`
+	CreatedAt    time.Time                  `json:"created_at"`
+	UpdatedAt    time.Time                  `json:"updated_at"`
+}
@@ -0,0 +1,32 @@
+package revision
+
+import "time"
+
+type GetAllRevisionResponseDto struct {
+	Revisions []GetRevisionResponseDto `json:"revisions"`
+}
+
+// GetRevisionResponseDto describes a change to a document. Snapshot is the document as
+// it was before the change.
+type GetRevisionResponseDto struct {
+	Number       int64       `json:"number"`
+	Action       string      `json:"action"`
+	RestoredFrom *int64      `json:"restored_from,omitempty"`
+	ChangedBy    string      `json:"changed_by"`
+	ChangedAt    time.Time   `json:"changed_at"`
+	Snapshot     interface{} `json:"snapshot"`
+}
+
+// RevisionDiffResponseDto lists the top level fields that differ between two revisions.
+// A To of 0 stands for the current document.
+type RevisionDiffResponseDto struct {
+	From    int64            `json:"from"`
+	To      int64            `json:"to"`
+	Changes []FieldChangeDto `json:"changes"`
+}
+
+type FieldChangeDto struct {
+	Field string      `json:"field"`
+	From  interface{} `json:"from"`
+	To    interface{} `json:"to"`
+}
@@ -0,0 +1,35 @@
+package taxonomy
+
+import (
+	"gallery-service/internal/pkg/constants"
+	"time"
+)
+
+type GetAllTaxonomyTermResponseDto struct {
+	Terms []GetTaxonomyTermResponseDto `json:"terms"`
+}
+
+type GetTaxonomyTermResponseDto struct {
+	ID        string                 `json:"id"`
+	Name      string                 `json:"name"`
+	Kind      constants.TaxonomyKind `json:"kind"`
+	ParentID  string                 `json:"parent_id"`
+	Ancestors []string               `json:"ancestors"`
+	Depth     int                    `json:"depth"`
+	Position  int64                  `json:"position"`
+	CreatedAt time.Time              `json:"created_at"`
+	UpdatedAt time.Time              `json:"updated_at"`
+}
+
+type GetTaxonomyTreeResponseDto struct {
+	Terms []*TaxonomyTreeNodeDto `json:"terms"`
+}
+
+type TaxonomyTreeNodeDto struct {
+	ID       string                 `json:"id"`
+	Name     string                 `json:"name"`
+	Kind     constants.TaxonomyKind `json:"kind"`
+	ParentID string                 `json:"parent_id"`
+	Position int64                  `json:"position"`
+	Children []*TaxonomyTreeNodeDto `json:"children"`
+}
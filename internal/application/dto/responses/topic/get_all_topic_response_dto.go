@@ -1,30 +1,28 @@
 package topic
 
 import (
+	"gallery-service/internal/application/dto/responses"
 	"gallery-service/internal/domain/models"
 	"time"
 )
 
 type GetAllTopicResponseDto struct {
-	//Pagination responses.Pagination  `json:"pagination"`
-	Topics []GetTopicResponseDto `json:"topics"`
+	Pagination responses.Pagination  `json:"pagination"`
+	Topics     []GetTopicResponseDto `json:"topics"`
 }
 
 type GetTopicResponseDto struct {
 	ID             string                       `json:"id"`
 	TopicName      string                       `json:"topic_name"`
+	FolderID       string                       `json:"folder_id"`
+	Status         string                       `json:"status"`
 	IsPublished    bool                         `json:"is_published"`
+	PublishAt      *time.Time                   `json:"publish_at,omitempty"`
+	UnpublishAt    *time.Time                   `json:"unpublish_at,omitempty"`
 	LanguageConfig []models.TopicLanguageConfig `json:"language_config"`
+	TaxonomyTerms  []string                     `json:"taxonomy_terms"`
+	Position       int64                        `json:"position"`
+	OrganizationID *string                      `json:"organization_id"`
 	CreatedAt      time.Time                    `json:"created_at"`
 	UpdatedAt      time.Time                    `json:"updated_at"`
 }
-
-type TopicForAppResponseDto struct {
-	ID        string `json:"id"`
-	TopicName string `json:"topic_name"`
-}
-
-type Topic4GatwayResponseDto struct {
-	ID        string `json:"id"`
-	TopicName string `json:"topic_name"`
-}
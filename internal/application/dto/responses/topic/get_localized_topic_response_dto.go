@@ -0,0 +1,24 @@
+package topic
+
+import (
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/pkg/constants"
+	"time"
+)
+
+// LocalizedTopicResponseDto is a topic served in a single language. Language is the
+// language of Localized, and Fallback is set when it is none of the languages asked for.
+type LocalizedTopicResponseDto struct {
+	ID                 string                      `json:"id"`
+	TopicName          string                      `json:"topic_name"`
+	Status             string                      `json:"status"`
+	IsPublished        bool                        `json:"is_published"`
+	Position           int64                       `json:"position"`
+	OrganizationID     *string                     `json:"organization_id"`
+	Language           constants.Language          `json:"language"`
+	Fallback           bool                        `json:"fallback"`
+	AvailableLanguages []constants.Language        `json:"available_languages"`
+	Localized          *models.TopicLanguageConfig `json:"localized"`
+	CreatedAt          time.Time                   `json:"created_at"`
+	UpdatedAt          time.Time                   `json:"updated_at"`
+}
@@ -0,0 +1,20 @@
+package topic
+
+import "time"
+
+// GetTopicWorkflowResponseDto is where a topic is in the editorial workflow and the
+// transitions that got it there, oldest first
+type GetTopicWorkflowResponseDto struct {
+	ID      string                 `json:"id"`
+	Status  string                 `json:"status"`
+	History []TopicStatusChangeDto `json:"history"`
+}
+
+type TopicStatusChangeDto struct {
+	Action  string    `json:"action"`
+	From    string    `json:"from"`
+	To      string    `json:"to"`
+	Comment string    `json:"comment,omitempty"`
+	ActorID string    `json:"actor_id"`
+	At      time.Time `json:"at"`
+}
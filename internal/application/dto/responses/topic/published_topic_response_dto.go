@@ -0,0 +1,38 @@
+package topic
+
+import (
+	"gallery-service/internal/application/dto/responses"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/pkg/constants"
+	"time"
+)
+
+type GetPublishedTopicsResponseDto struct {
+	Pagination responses.Pagination        `json:"pagination"`
+	Topics     []PublishedTopicResponseDto `json:"topics"`
+}
+
+// PublishedTopicResponseDto is a published topic as the apps list it, in a single
+// language. Language is the language of Title, Description, Cover and MediaCounts, and
+// Fallback is set when it is none of the languages asked for. Cover is the primary
+// image, or the first one when none is.
+type PublishedTopicResponseDto struct {
+	ID                 string                   `json:"id"`
+	TopicName          string                   `json:"topic_name"`
+	Language           constants.Language       `json:"language"`
+	Fallback           bool                     `json:"fallback"`
+	AvailableLanguages []constants.Language     `json:"available_languages"`
+	Title              string                   `json:"title"`
+	Description        string                   `json:"description"`
+	Cover              *models.TopicImageConfig `json:"cover"`
+	MediaCounts        TopicMediaCountsDto      `json:"media_counts"`
+	Position           int64                    `json:"position"`
+	CreatedAt          time.Time                `json:"created_at"`
+	UpdatedAt          time.Time                `json:"updated_at"`
+}
+
+type TopicMediaCountsDto struct {
+	Images int `json:"images"`
+	Videos int `json:"videos"`
+	Audios int `json:"audios"`
+}
@@ -0,0 +1,19 @@
+package trash
+
+import "time"
+
+type GetAllTrashResponseDto struct {
+	Items []TrashItemDto `json:"items"`
+}
+
+// TrashItemDto is a document that was deleted on its own. Restoring or purging a folder
+// takes the folders and clusters deleted with it along. PurgeAt is when the document
+// is deleted for good.
+type TrashItemDto struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	Name      string    `json:"name"`
+	DeletedAt time.Time `json:"deleted_at"`
+	DeletedBy string    `json:"deleted_by"`
+	PurgeAt   time.Time `json:"purge_at"`
+}
@@ -3,14 +3,29 @@ package mappers
 import (
 	"gallery-service/internal/application/dto/responses/cluster"
 	"gallery-service/internal/domain/models"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/language"
 )
 
 func GetAllClustersFromModel(c *models.Cluster) cluster.GetClusterResponseDto {
+	var folderID string
+	if !c.FolderID.IsZero() {
+		folderID = c.FolderID.Hex()
+	}
+
 	return cluster.GetClusterResponseDto{
-		ID:          c.ID.Hex(),
-		ClusterName: c.ClusterName,
-		ImageKey:    c.Image.ImageKey,
-		ImageURL:    c.Image.ImageURL,
+		ID:             c.ID.Hex(),
+		ClusterName:    c.ClusterName,
+		ImageKey:       c.Image.ImageKey,
+		ImageURL:       c.Image.ImageURL,
+		FolderID:       folderID,
+		Position:       c.Position,
+		Hidden:         c.Hidden,
+		PublishAt:      c.PublishAt,
+		UnpublishAt:    c.UnpublishAt,
+		OrganizationID: c.OrganizationID,
+		Tags:           c.Tags,
+		TaxonomyTerms:  GetHexIDs(c.TaxonomyTerms),
 	}
 }
 
@@ -21,3 +36,40 @@ func GetAllClustersFromModels(clusters []*models.Cluster) []cluster.GetClusterRe
 	}
 	return res
 }
+
+// GetLocalizedClusterFromModel serves the cluster in the first language of chain it has
+func GetLocalizedClusterFromModel(c *models.Cluster, chain []constants.Language) *cluster.LocalizedClusterResponseDto {
+	var folderID string
+	if !c.FolderID.IsZero() {
+		folderID = c.FolderID.Hex()
+	}
+
+	available := make([]constants.Language, 0, len(c.LanguageConfig))
+	for _, l := range c.LanguageConfig {
+		available = append(available, l.Language)
+	}
+
+	res := &cluster.LocalizedClusterResponseDto{
+		ID:                 c.ID.Hex(),
+		ClusterName:        c.ClusterName,
+		Title:              c.Title,
+		Note:               c.Note,
+		Image:              c.Image,
+		FolderID:           folderID,
+		Position:           c.Position,
+		OrganizationID:     c.OrganizationID,
+		AvailableLanguages: available,
+		CreatedAt:          c.CreatedAt,
+		UpdatedAt:          c.UpdatedAt,
+	}
+
+	index, matched := language.Pick(available, chain)
+	if index >= 0 {
+		localized := c.LanguageConfig[index]
+		res.Language = localized.Language
+		res.Fallback = !matched
+		res.Localized = &localized
+	}
+
+	return res
+}
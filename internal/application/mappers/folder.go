@@ -3,6 +3,10 @@ package mappers
 import (
 	"gallery-service/internal/application/dto/responses/folder"
 	"gallery-service/internal/domain/models"
+	"gallery-service/internal/pkg/constants"
+	"sort"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 func GetAllFoldersFromModel(f *models.Folder) folder.GetFolderResponseDto {
@@ -17,6 +21,8 @@ func GetAllFoldersFromModel(f *models.Folder) folder.GetFolderResponseDto {
 		FolderThumbnailKey: f.FolderThumbnailKey,
 		FolderThumbnailURL: f.FolderThumbnailURL,
 		ParentID:           parentID,
+		Position:           f.Position,
+		OrganizationID:     f.OrganizationID,
 	}
 }
 
@@ -27,3 +33,116 @@ func GetAllFoldersFromModels(folders []*models.Folder) []folder.GetFolderRespons
 	}
 	return res
 }
+
+func GetFolderTreeNodeFromModel(f *models.Folder) *folder.FolderTreeNodeDto {
+	dto := GetAllFoldersFromModel(f)
+
+	return &folder.FolderTreeNodeDto{
+		ID:                 dto.ID,
+		FolderName:         dto.FolderName,
+		FolderThumbnailKey: dto.FolderThumbnailKey,
+		FolderThumbnailURL: dto.FolderThumbnailURL,
+		ParentID:           dto.ParentID,
+		Position:           dto.Position,
+		Children:           make([]*folder.FolderTreeNodeDto, 0),
+	}
+}
+
+// GetFolderTreeFromModels nests the given descendants under their roots. Child and
+// cluster counts are looked up by folder id, so folders cut off by a depth limit still
+// report how many children they have.
+func GetFolderTreeFromModels(
+	roots []*models.Folder,
+	descendants []*models.Folder,
+	childCounts map[primitive.ObjectID]int64,
+	clusterCounts map[primitive.ObjectID]int64,
+) []*folder.FolderTreeNodeDto {
+	nodes := make(map[primitive.ObjectID]*folder.FolderTreeNodeDto, len(roots)+len(descendants))
+	res := make([]*folder.FolderTreeNodeDto, 0, len(roots))
+	for _, f := range roots {
+		node := GetFolderTreeNodeFromModel(f)
+		node.ChildCount = childCounts[f.ID]
+		node.ClusterCount = clusterCounts[f.ID]
+		nodes[f.ID] = node
+		res = append(res, node)
+	}
+
+	for _, f := range descendants {
+		if _, ok := nodes[f.ID]; ok {
+			continue
+		}
+
+		node := GetFolderTreeNodeFromModel(f)
+		node.ChildCount = childCounts[f.ID]
+		node.ClusterCount = clusterCounts[f.ID]
+		nodes[f.ID] = node
+	}
+
+	for _, f := range descendants {
+		if f.ParentID == nil {
+			continue
+		}
+
+		if parent, ok := nodes[*f.ParentID]; ok {
+			parent.Children = append(parent.Children, nodes[f.ID])
+		}
+	}
+
+	for _, node := range nodes {
+		sortFolderTreeNodes(node.Children)
+	}
+
+	return res
+}
+
+// sortFolderTreeNodes orders siblings by their manual position, falling back to the id
+// so folders that were never reordered keep their creation order
+func sortFolderTreeNodes(nodes []*folder.FolderTreeNodeDto) {
+	sort.SliceStable(nodes, func(i, j int) bool {
+		if nodes[i].Position != nodes[j].Position {
+			return nodes[i].Position < nodes[j].Position
+		}
+		return nodes[i].ID < nodes[j].ID
+	})
+}
+
+func GetFolderGrantsFromModels(grants []models.FolderGrant) []folder.FolderGrantDto {
+	res := make([]folder.FolderGrantDto, 0, len(grants))
+	for _, g := range grants {
+		res = append(res, folder.FolderGrantDto{
+			UserID:     g.UserID,
+			Role:       g.Role,
+			Permission: g.Permission.String(),
+		})
+	}
+	return res
+}
+
+// GetFolderACLFromModels lists the grants of the folder and of every ancestor that has
+// any, ancestors ordered from the root down
+func GetFolderACLFromModels(f *models.Folder, ancestors []*models.Folder, permission constants.FolderPermission) *folder.GetFolderACLResponseDto {
+	byID := make(map[primitive.ObjectID]*models.Folder, len(ancestors))
+	for _, a := range ancestors {
+		byID[a.ID] = a
+	}
+
+	inherited := make([]folder.InheritedFolderGrantsDto, 0)
+	for _, id := range f.Ancestors {
+		a, ok := byID[id]
+		if !ok || len(a.ACL) == 0 {
+			continue
+		}
+
+		inherited = append(inherited, folder.InheritedFolderGrantsDto{
+			FolderID:   a.ID.Hex(),
+			FolderName: a.FolderName,
+			Grants:     GetFolderGrantsFromModels(a.ACL),
+		})
+	}
+
+	return &folder.GetFolderACLResponseDto{
+		Permission: permission.String(),
+		Grants:     GetFolderGrantsFromModels(f.ACL),
+		Inherited:  inherited,
+	}
+}
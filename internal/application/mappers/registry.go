@@ -0,0 +1,96 @@
+package mappers
+
+import (
+	"gallery-service/internal/application/dto/responses"
+	"gallery-service/internal/application/dto/responses/registry"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/pkg/constants"
+	registryCache "gallery-service/internal/pkg/registry"
+)
+
+// GetLanguageOptions lists the enabled languages in display order, each named in the first
+// language of chain it has a name in
+func GetLanguageOptions(chain []constants.Language) []responses.KeyValueResponseDto {
+	codes := registryCache.Codes(chain)
+	languages := registryCache.Languages(true)
+
+	res := make([]responses.KeyValueResponseDto, 0, len(languages))
+	for _, language := range languages {
+		res = append(res, responses.KeyValueResponseDto{
+			Key:   language.Code,
+			Value: language.DisplayName(codes),
+		})
+	}
+
+	return res
+}
+
+// GetComponentOptions lists the enabled components in display order, each named in the
+// first language of chain it has a name in
+func GetComponentOptions(chain []constants.Language) []responses.KeyValueResponseDto {
+	codes := registryCache.Codes(chain)
+	components := registryCache.Components(true)
+
+	res := make([]responses.KeyValueResponseDto, 0, len(components))
+	for _, component := range components {
+		res = append(res, responses.KeyValueResponseDto{
+			Key:   component.Key,
+			Value: component.DisplayName(codes),
+		})
+	}
+
+	return res
+}
+
+func GetLanguageFromModel(l *models.GalleryLanguage) registry.GetLanguageResponseDto {
+	return registry.GetLanguageResponseDto{
+		ID:           l.ID.Hex(),
+		Code:         l.Code,
+		Name:         l.Name.String(),
+		DisplayNames: displayNames(l.DisplayNames),
+		Position:     l.Position,
+		Enabled:      l.Enabled,
+		CreatedAt:    l.CreatedAt,
+		UpdatedAt:    l.UpdatedAt,
+	}
+}
+
+func GetLanguagesFromModels(languages []*models.GalleryLanguage) []registry.GetLanguageResponseDto {
+	res := make([]registry.GetLanguageResponseDto, 0, len(languages))
+	for _, l := range languages {
+		res = append(res, GetLanguageFromModel(l))
+	}
+
+	return res
+}
+
+func GetComponentFromModel(c *models.GalleryComponent) registry.GetComponentResponseDto {
+	return registry.GetComponentResponseDto{
+		ID:           c.ID.Hex(),
+		Key:          c.Key,
+		Name:         c.Name.String(),
+		DisplayNames: displayNames(c.DisplayNames),
+		Media:        c.Media,
+		Position:     c.Position,
+		Enabled:      c.Enabled,
+		CreatedAt:    c.CreatedAt,
+		UpdatedAt:    c.UpdatedAt,
+	}
+}
+
+func GetComponentsFromModels(components []*models.GalleryComponent) []registry.GetComponentResponseDto {
+	res := make([]registry.GetComponentResponseDto, 0, len(components))
+	for _, c := range components {
+		res = append(res, GetComponentFromModel(c))
+	}
+
+	return res
+}
+
+func displayNames(names map[string]string) map[string]string {
+	if names == nil {
+		return map[string]string{}
+	}
+
+	return names
+}
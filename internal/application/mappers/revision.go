@@ -0,0 +1,17 @@
+package mappers
+
+import (
+	"gallery-service/internal/application/dto/responses/revision"
+	"gallery-service/internal/domain/models"
+)
+
+func GetRevisionFromModel(r *models.Revision, snapshot interface{}) revision.GetRevisionResponseDto {
+	return revision.GetRevisionResponseDto{
+		Number:       r.Number,
+		Action:       r.Action.String(),
+		RestoredFrom: r.RestoredFrom,
+		ChangedBy:    r.ChangedBy,
+		ChangedAt:    r.ChangedAt,
+		Snapshot:     snapshot,
+	}
+}
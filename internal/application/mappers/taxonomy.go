@@ -0,0 +1,74 @@
+package mappers
+
+import (
+	"gallery-service/internal/application/dto/responses/taxonomy"
+	"gallery-service/internal/domain/models"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func GetTaxonomyTermFromModel(t *models.TaxonomyTerm) taxonomy.GetTaxonomyTermResponseDto {
+	var parentID string
+	if t.ParentID != nil {
+		parentID = t.ParentID.Hex()
+	}
+
+	return taxonomy.GetTaxonomyTermResponseDto{
+		ID:        t.ID.Hex(),
+		Name:      t.Name,
+		Kind:      t.Kind,
+		ParentID:  parentID,
+		Ancestors: GetHexIDs(t.Ancestors),
+		Depth:     t.Depth,
+		Position:  t.Position,
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+	}
+}
+
+func GetTaxonomyTermsFromModels(terms []*models.TaxonomyTerm) []taxonomy.GetTaxonomyTermResponseDto {
+	res := make([]taxonomy.GetTaxonomyTermResponseDto, 0, len(terms))
+	for _, t := range terms {
+		res = append(res, GetTaxonomyTermFromModel(t))
+	}
+	return res
+}
+
+// GetTaxonomyTreeFromModels nests terms under their parents. Terms must come ordered by
+// depth then position, as the repository lists them, so that every parent is placed
+// before its children and siblings keep their order.
+func GetTaxonomyTreeFromModels(terms []*models.TaxonomyTerm) []*taxonomy.TaxonomyTreeNodeDto {
+	nodes := make(map[primitive.ObjectID]*taxonomy.TaxonomyTreeNodeDto, len(terms))
+	res := make([]*taxonomy.TaxonomyTreeNodeDto, 0)
+	for _, t := range terms {
+		node := &taxonomy.TaxonomyTreeNodeDto{
+			ID:       t.ID.Hex(),
+			Name:     t.Name,
+			Kind:     t.Kind,
+			Position: t.Position,
+			Children: make([]*taxonomy.TaxonomyTreeNodeDto, 0),
+		}
+		nodes[t.ID] = node
+
+		if t.ParentID == nil {
+			res = append(res, node)
+			continue
+		}
+
+		node.ParentID = t.ParentID.Hex()
+		if parent, ok := nodes[*t.ParentID]; ok {
+			parent.Children = append(parent.Children, node)
+		}
+	}
+
+	return res
+}
+
+// GetHexIDs returns ids in their hex form
+func GetHexIDs(ids []primitive.ObjectID) []string {
+	res := make([]string, 0, len(ids))
+	for _, id := range ids {
+		res = append(res, id.Hex())
+	}
+	return res
+}
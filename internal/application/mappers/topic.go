@@ -3,14 +3,28 @@ package mappers
 import (
 	"gallery-service/internal/application/dto/responses/topic"
 	"gallery-service/internal/domain/models"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/language"
 )
 
 func GetTopicFromModel(c *models.Topic) topic.GetTopicResponseDto {
+	folderID := ""
+	if !c.FolderID.IsZero() {
+		folderID = c.FolderID.Hex()
+	}
+
 	return topic.GetTopicResponseDto{
 		ID:             c.ID.Hex(),
 		TopicName:      c.TopicName,
-		IsPublished:    c.IsPublished,
+		FolderID:       folderID,
+		Status:         c.WorkflowStatus().String(),
+		IsPublished:    c.WorkflowStatus() == constants.TopicStatusPublished,
+		PublishAt:      c.PublishAt,
+		UnpublishAt:    c.UnpublishAt,
 		LanguageConfig: c.LanguageConfig,
+		TaxonomyTerms:  GetHexIDs(c.TaxonomyTerms),
+		Position:       c.Position,
+		OrganizationID: c.OrganizationID,
 		CreatedAt:      c.CreatedAt,
 		UpdatedAt:      c.UpdatedAt,
 	}
@@ -24,9 +38,109 @@ func GetTopicsFromModels(topics []*models.Topic) []topic.GetTopicResponseDto {
 	return res
 }
 
-func GetTopic4GatewayFromModel(c *models.Topic) *topic.Topic4GatwayResponseDto {
-	return &topic.Topic4GatwayResponseDto{
-		ID:        c.ID.Hex(),
-		TopicName: c.TopicName,
+// GetLocalizedTopicFromModel serves the topic in the first language of chain it has
+func GetLocalizedTopicFromModel(c *models.Topic, chain []constants.Language) *topic.LocalizedTopicResponseDto {
+	available := make([]constants.Language, 0, len(c.LanguageConfig))
+	for _, l := range c.LanguageConfig {
+		available = append(available, l.Language)
 	}
+
+	res := &topic.LocalizedTopicResponseDto{
+		ID:                 c.ID.Hex(),
+		TopicName:          c.TopicName,
+		Status:             c.WorkflowStatus().String(),
+		IsPublished:        c.WorkflowStatus() == constants.TopicStatusPublished,
+		Position:           c.Position,
+		OrganizationID:     c.OrganizationID,
+		AvailableLanguages: available,
+		CreatedAt:          c.CreatedAt,
+		UpdatedAt:          c.UpdatedAt,
+	}
+
+	index, matched := language.Pick(available, chain)
+	if index >= 0 {
+		localized := c.LanguageConfig[index]
+		res.Language = localized.Language
+		res.Fallback = !matched
+		res.Localized = &localized
+	}
+
+	return res
+}
+
+func GetTopicWorkflowFromModel(c *models.Topic) *topic.GetTopicWorkflowResponseDto {
+	history := make([]topic.TopicStatusChangeDto, 0, len(c.StatusHistory))
+	for _, change := range c.StatusHistory {
+		history = append(history, topic.TopicStatusChangeDto{
+			Action:  change.Action.String(),
+			From:    change.From.String(),
+			To:      change.To.String(),
+			Comment: change.Comment,
+			ActorID: change.ActorID,
+			At:      change.At,
+		})
+	}
+
+	return &topic.GetTopicWorkflowResponseDto{
+		ID:      c.ID.Hex(),
+		Status:  c.WorkflowStatus().String(),
+		History: history,
+	}
+}
+
+// GetPublishedTopicFromModel lists the topic in the first language of chain it has
+func GetPublishedTopicFromModel(c *models.Topic, chain []constants.Language) topic.PublishedTopicResponseDto {
+	available := make([]constants.Language, 0, len(c.LanguageConfig))
+	for _, l := range c.LanguageConfig {
+		available = append(available, l.Language)
+	}
+
+	res := topic.PublishedTopicResponseDto{
+		ID:                 c.ID.Hex(),
+		TopicName:          c.TopicName,
+		AvailableLanguages: available,
+		Position:           c.Position,
+		CreatedAt:          c.CreatedAt,
+		UpdatedAt:          c.UpdatedAt,
+	}
+
+	index, matched := language.Pick(available, chain)
+	if index >= 0 {
+		localized := c.LanguageConfig[index]
+		res.Language = localized.Language
+		res.Fallback = !matched
+		res.Title = localized.Title
+		res.Description = localized.Description
+		res.MediaCounts = topic.TopicMediaCountsDto{
+			Images: len(localized.Images),
+			Videos: len(localized.Videos),
+			Audios: len(localized.Audios),
+		}
+		res.Cover = coverImage(localized.Images)
+	}
+
+	return res
+}
+
+// coverImage returns the primary image, or the first one when none is primary
+func coverImage(images []models.TopicImageConfig) *models.TopicImageConfig {
+	for i := range images {
+		if images[i].Primary {
+			return &images[i]
+		}
+	}
+
+	if len(images) > 0 {
+		return &images[0]
+	}
+
+	return nil
+}
+
+func GetPublishedTopicsFromModels(topics []*models.Topic, chain []constants.Language) []topic.PublishedTopicResponseDto {
+	res := make([]topic.PublishedTopicResponseDto, 0, len(topics))
+	for _, c := range topics {
+		res = append(res, GetPublishedTopicFromModel(c, chain))
+	}
+	return res
 }
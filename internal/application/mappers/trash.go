@@ -0,0 +1,35 @@
+package mappers
+
+import (
+	"gallery-service/internal/application/dto/responses/trash"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/pkg/constants"
+	"time"
+)
+
+func GetTrashItemFromFolder(f *models.Folder, retention time.Duration) trash.TrashItemDto {
+	return getTrashItem(f.ID.Hex(), constants.TrashItemFolder, f.FolderName, f.DeletedAt, f.DeletedBy, retention)
+}
+
+func GetTrashItemFromCluster(c *models.Cluster, retention time.Duration) trash.TrashItemDto {
+	return getTrashItem(c.ID.Hex(), constants.TrashItemCluster, c.ClusterName, c.DeletedAt, c.DeletedBy, retention)
+}
+
+func GetTrashItemFromTopic(t *models.Topic, retention time.Duration) trash.TrashItemDto {
+	return getTrashItem(t.ID.Hex(), constants.TrashItemTopic, t.TopicName, t.DeletedAt, t.DeletedBy, retention)
+}
+
+func getTrashItem(id string, itemType constants.TrashItemType, name string, deletedAt *time.Time, deletedBy string, retention time.Duration) trash.TrashItemDto {
+	item := trash.TrashItemDto{
+		ID:        id,
+		Type:      itemType.String(),
+		Name:      name,
+		DeletedBy: deletedBy,
+	}
+	if deletedAt != nil {
+		item.DeletedAt = *deletedAt
+		item.PurgeAt = deletedAt.Add(retention)
+	}
+
+	return item
+}
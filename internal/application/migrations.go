@@ -0,0 +1,141 @@
+package application
+
+import (
+	"context"
+	"gallery-service/internal/infrastructure/database/mongo/migrations"
+	"gallery-service/pkg/mongodb"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// BackfillFolderAncestors fills ancestors and depth on folders stored before the
+// materialized path was introduced
+func (a *App) BackfillFolderAncestors() error {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
+	defer cancel()
+
+	mongoDBClient := mongodb.NewMongoDBConn(ctx, a.logger, a.cfg.Mongo)
+	defer mongoDBClient.Close()
+
+	updated, err := migrations.BackfillFolderAncestors(ctx, a.logger, a.cfg, mongoDBClient.GetClient())
+	if err != nil {
+		a.logger.Errorf("(BackfillFolderAncestors) err: {%v}", err)
+		return err
+	}
+
+	a.logger.Infof("(BackfillFolderAncestors) updated folders: {%d}", updated)
+
+	return nil
+}
+
+// AssignFolderOrganization moves a folder subtree and its clusters into an organization
+func (a *App) AssignFolderOrganization(folderID string, organizationID string) error {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
+	defer cancel()
+
+	objectID, err := primitive.ObjectIDFromHex(folderID)
+	if err != nil {
+		return errors.Wrap(err, "invalid folder id")
+	}
+
+	mongoDBClient := mongodb.NewMongoDBConn(ctx, a.logger, a.cfg.Mongo)
+	defer mongoDBClient.Close()
+
+	folders, clusters, err := migrations.AssignFolderOrganization(ctx, a.logger, a.cfg, mongoDBClient.GetClient(), objectID, organizationID)
+	if err != nil {
+		a.logger.Errorf("(AssignFolderOrganization) err: {%v}", err)
+		return err
+	}
+
+	a.logger.Infof("(AssignFolderOrganization) updated folders: {%d}, clusters: {%d}", folders, clusters)
+
+	return nil
+}
+
+// NormalizeMediaTimecodes rewrites the media start and end times of clusters and topics in
+// the canonical timecode form and reports the ones it cannot fix
+func (a *App) NormalizeMediaTimecodes() error {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
+	defer cancel()
+
+	mongoDBClient := mongodb.NewMongoDBConn(ctx, a.logger, a.cfg.Mongo)
+	defer mongoDBClient.Close()
+
+	updated, invalid, err := migrations.NormalizeMediaTimecodes(ctx, a.logger, a.cfg, mongoDBClient.GetClient())
+	if err != nil {
+		a.logger.Errorf("(NormalizeMediaTimecodes) err: {%v}", err)
+		return err
+	}
+
+	for _, i := range invalid {
+		a.logger.Warnf("(NormalizeMediaTimecodes) collection: {%s}, id: {%s}, path: {%s}, value: {%v}, reason: {%s}", i.Collection, i.ID.Hex(), i.Path, i.Value, i.Reason)
+	}
+
+	a.logger.Infof("(NormalizeMediaTimecodes) updated documents: {%d}, invalid timecodes: {%d}", updated, len(invalid))
+
+	return nil
+}
+
+// BackfillTopicStatus gives the topics stored before the editorial workflow a status
+// matching their is_published flag
+func (a *App) BackfillTopicStatus() error {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
+	defer cancel()
+
+	mongoDBClient := mongodb.NewMongoDBConn(ctx, a.logger, a.cfg.Mongo)
+	defer mongoDBClient.Close()
+
+	updated, err := migrations.BackfillTopicStatus(ctx, a.logger, a.cfg, mongoDBClient.GetClient())
+	if err != nil {
+		a.logger.Errorf("(BackfillTopicStatus) err: {%v}", err)
+		return err
+	}
+
+	a.logger.Infof("(BackfillTopicStatus) updated topics: {%d}", updated)
+
+	return nil
+}
+
+// BackfillTopicMediaIDs gives an ID to the media of the topics stored before media items
+// had one, so that the apps can edit them one by one
+func (a *App) BackfillTopicMediaIDs() error {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
+	defer cancel()
+
+	mongoDBClient := mongodb.NewMongoDBConn(ctx, a.logger, a.cfg.Mongo)
+	defer mongoDBClient.Close()
+
+	updated, err := migrations.BackfillTopicMediaIDs(ctx, a.logger, a.cfg, mongoDBClient.GetClient())
+	if err != nil {
+		a.logger.Errorf("(BackfillTopicMediaIDs) err: {%v}", err)
+		return err
+	}
+
+	a.logger.Infof("(BackfillTopicMediaIDs) updated topics: {%d}", updated)
+
+	return nil
+}
+
+// RemoveStrayClusterFolderField removes the misspelt folder field cluster updates stored
+// instead of moving the cluster
+func (a *App) RemoveStrayClusterFolderField() error {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
+	defer cancel()
+
+	mongoDBClient := mongodb.NewMongoDBConn(ctx, a.logger, a.cfg.Mongo)
+	defer mongoDBClient.Close()
+
+	updated, err := migrations.RemoveStrayClusterFolderField(ctx, a.logger, a.cfg, mongoDBClient.GetClient())
+	if err != nil {
+		a.logger.Errorf("(RemoveStrayClusterFolderField) err: {%v}", err)
+		return err
+	}
+
+	a.logger.Infof("(RemoveStrayClusterFolderField) updated clusters: {%d}", updated)
+
+	return nil
+}
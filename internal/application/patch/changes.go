@@ -0,0 +1,53 @@
+package patch
+
+import (
+	"reflect"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Changes compares the given top level fields of two documents the way they are stored
+// in mongo and returns the fields to $set and the fields to $unset to turn before into
+// after. Fields that did not change are left out, so a stale copy can't overwrite them.
+func Changes(before interface{}, after interface{}, fields ...string) (map[string]interface{}, []string, error) {
+	b, err := toBSON(before)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a, err := toBSON(after)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	set := make(map[string]interface{})
+	unset := make([]string, 0)
+	for _, field := range fields {
+		newValue, inAfter := a[field]
+		oldValue, inBefore := b[field]
+
+		switch {
+		case !inAfter && inBefore:
+			unset = append(unset, field)
+		case inAfter && (!inBefore || !reflect.DeepEqual(newValue, oldValue)):
+			set[field] = newValue
+		}
+	}
+
+	return set, unset, nil
+}
+
+func toBSON(document interface{}) (bson.M, error) {
+	raw, err := bson.Marshal(document)
+	if err != nil {
+		return nil, errors.Wrap(err, "bson.Marshal")
+	}
+
+	var m bson.M
+	if err := bson.Unmarshal(raw, &m); err != nil {
+		return nil, errors.Wrap(err, "bson.Unmarshal")
+	}
+
+	return m, nil
+}
@@ -0,0 +1,163 @@
+package patch
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+type nested struct {
+	Title string `bson:"title,omitempty"`
+	Note  string `bson:"note,omitempty"`
+}
+
+type stored struct {
+	Name   string   `bson:"name,omitempty"`
+	Count  int64    `bson:"count"`
+	Tags   []string `bson:"tags,omitempty"`
+	Nested *nested  `bson:"nested,omitempty"`
+	Other  string   `bson:"other,omitempty"`
+}
+
+func TestChanges(t *testing.T) {
+	before := stored{
+		Name:   "before",
+		Count:  1,
+		Tags:   []string{"a", "b"},
+		Nested: &nested{Title: "title", Note: "note"},
+		Other:  "other",
+	}
+
+	tests := []struct {
+		name      string
+		after     stored
+		fields    []string
+		wantSet   map[string]interface{}
+		wantUnset []string
+	}{
+		{
+			name:      "nothing changed",
+			after:     before,
+			fields:    []string{"name", "count", "tags", "nested"},
+			wantSet:   map[string]interface{}{},
+			wantUnset: []string{},
+		},
+		{
+			name:      "changed field set",
+			after:     stored{Name: "after", Count: 1, Tags: []string{"a", "b"}, Nested: &nested{Title: "title", Note: "note"}},
+			fields:    []string{"name", "count", "tags", "nested"},
+			wantSet:   map[string]interface{}{"name": "after"},
+			wantUnset: []string{},
+		},
+		{
+			name:      "cleared fields unset",
+			after:     stored{Count: 1, Nested: &nested{Title: "title", Note: "note"}},
+			fields:    []string{"name", "count", "tags", "nested"},
+			wantSet:   map[string]interface{}{},
+			wantUnset: []string{"name", "tags"},
+		},
+		{
+			name:      "nested document set as a whole",
+			after:     stored{Name: "before", Count: 1, Tags: []string{"a", "b"}, Nested: &nested{Title: "title"}},
+			fields:    []string{"name", "count", "tags", "nested"},
+			wantSet:   map[string]interface{}{"nested": map[string]interface{}{"title": "title"}},
+			wantUnset: []string{},
+		},
+		{
+			name:      "zero value that is stored set",
+			after:     stored{Name: "before", Tags: []string{"a", "b"}, Nested: &nested{Title: "title", Note: "note"}},
+			fields:    []string{"name", "count", "tags", "nested"},
+			wantSet:   map[string]interface{}{"count": int64(0)},
+			wantUnset: []string{},
+		},
+		{
+			name:      "fields not asked for left out",
+			after:     stored{Name: "after", Count: 2},
+			fields:    []string{"count"},
+			wantSet:   map[string]interface{}{"count": int64(2)},
+			wantUnset: []string{},
+		},
+		{
+			name:      "unchanged field asked for alone",
+			after:     stored{Name: "before", Count: 1, Tags: []string{"a", "b"}, Nested: &nested{Title: "title", Note: "note"}, Other: "other"},
+			fields:    []string{"other"},
+			wantSet:   map[string]interface{}{},
+			wantUnset: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set, unset, err := Changes(before, tt.after, tt.fields...)
+			if err != nil {
+				t.Fatalf("Changes err: %v", err)
+			}
+
+			if len(set) != len(tt.wantSet) {
+				t.Errorf("Changes set = %v, want %v", set, tt.wantSet)
+			}
+			for field, want := range tt.wantSet {
+				got, ok := set[field]
+				if !ok {
+					t.Errorf("Changes set = %v, want %s set", set, field)
+					continue
+				}
+				if !sameValue(got, want) {
+					t.Errorf("Changes set %s = %#v, want %#v", field, got, want)
+				}
+			}
+
+			sort.Strings(unset)
+			if !reflect.DeepEqual(unset, tt.wantUnset) {
+				t.Errorf("Changes unset = %v, want %v", unset, tt.wantUnset)
+			}
+		})
+	}
+}
+
+func TestChangesFieldAddedToEmptyDocument(t *testing.T) {
+	set, unset, err := Changes(stored{}, stored{Other: "other"}, "other")
+	if err != nil {
+		t.Fatalf("Changes err: %v", err)
+	}
+
+	if len(unset) != 0 || len(set) != 1 || set["other"] != "other" {
+		t.Errorf("Changes = %v, %v, want other set", set, unset)
+	}
+}
+
+// sameValue compares a decoded bson value with a plain one, nested documents being
+// compared field by field
+func sameValue(got interface{}, want interface{}) bool {
+	wantObject, ok := want.(map[string]interface{})
+	if !ok {
+		return reflect.DeepEqual(got, want)
+	}
+
+	gotObject, ok := toMap(got)
+	if !ok || len(gotObject) != len(wantObject) {
+		return false
+	}
+
+	for key, value := range wantObject {
+		if !sameValue(gotObject[key], value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func toMap(value interface{}) (map[string]interface{}, bool) {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Map {
+		return nil, false
+	}
+
+	m := make(map[string]interface{}, v.Len())
+	for _, key := range v.MapKeys() {
+		m[key.String()] = v.MapIndex(key).Interface()
+	}
+
+	return m, true
+}
@@ -0,0 +1,94 @@
+package patch
+
+import (
+	"bytes"
+	"encoding/json"
+	httpPkg "gallery-service/pkg/http"
+
+	"github.com/pkg/errors"
+)
+
+// Validator checks a patched document before it is saved. The rest validator.Wrapper
+// satisfies it.
+type Validator interface {
+	DataValidation(data interface{}) error
+}
+
+// Apply merges the JSON merge patch (RFC 7396) into the JSON form of document and
+// decodes the result into out. The patch has to be an object, and fields out does not
+// know are rejected.
+func Apply(document interface{}, patch []byte, out interface{}) error {
+	target, err := json.Marshal(document)
+	if err != nil {
+		return errors.Wrap(err, "json.Marshal")
+	}
+
+	merged, err := Merge(target, patch)
+	if err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(merged))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(out); err != nil {
+		return errors.Wrapf(httpPkg.BadRequest, "invalid merge patch: %v", err)
+	}
+
+	return nil
+}
+
+// Merge applies the JSON merge patch to the JSON object target. A null in the patch
+// removes the field, an object is merged field by field and anything else replaces the
+// value.
+func Merge(target []byte, patch []byte) ([]byte, error) {
+	p, err := decode(patch)
+	if err != nil {
+		return nil, errors.Wrapf(httpPkg.BadRequest, "invalid merge patch: %v", err)
+	}
+
+	if _, ok := p.(map[string]interface{}); !ok {
+		return nil, errors.Wrap(httpPkg.BadRequest, "a merge patch must be a JSON object")
+	}
+
+	t, err := decode(target)
+	if err != nil {
+		return nil, errors.Wrap(err, "json.Unmarshal")
+	}
+
+	return json.Marshal(mergeValue(t, p))
+}
+
+func mergeValue(target interface{}, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]interface{})
+	if !ok {
+		targetObject = make(map[string]interface{}, len(patchObject))
+	}
+
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+			continue
+		}
+		targetObject[key] = mergeValue(targetObject[key], value)
+	}
+
+	return targetObject
+}
+
+// decode keeps numbers as json.Number so that they are written back unchanged
+func decode(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
@@ -0,0 +1,178 @@
+package patch
+
+import (
+	"encoding/json"
+	"testing"
+
+	httpPkg "gallery-service/pkg/http"
+
+	"github.com/pkg/errors"
+)
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name   string
+		target string
+		patch  string
+		want   string
+	}{
+		{
+			name:   "empty patch",
+			target: `{"a":"b"}`,
+			patch:  `{}`,
+			want:   `{"a":"b"}`,
+		},
+		{
+			name:   "replace a value",
+			target: `{"a":"b"}`,
+			patch:  `{"a":"c"}`,
+			want:   `{"a":"c"}`,
+		},
+		{
+			name:   "add a field",
+			target: `{"a":"b"}`,
+			patch:  `{"b":"c"}`,
+			want:   `{"a":"b","b":"c"}`,
+		},
+		{
+			name:   "null removes the field",
+			target: `{"a":"b","b":"c"}`,
+			patch:  `{"a":null}`,
+			want:   `{"b":"c"}`,
+		},
+		{
+			name:   "null on a missing field",
+			target: `{"a":"b"}`,
+			patch:  `{"c":null}`,
+			want:   `{"a":"b"}`,
+		},
+		{
+			name:   "nested object merged field by field",
+			target: `{"a":{"b":"c","d":"e"}}`,
+			patch:  `{"a":{"b":"f"}}`,
+			want:   `{"a":{"b":"f","d":"e"}}`,
+		},
+		{
+			name:   "null removes a nested field",
+			target: `{"a":{"b":"c","d":"e"}}`,
+			patch:  `{"a":{"d":null}}`,
+			want:   `{"a":{"b":"c"}}`,
+		},
+		{
+			name:   "nested object created where there was none",
+			target: `{"a":"b"}`,
+			patch:  `{"a":{"c":"d","e":null}}`,
+			want:   `{"a":{"c":"d"}}`,
+		},
+		{
+			name:   "array replaced as a whole",
+			target: `{"a":["b","c"]}`,
+			patch:  `{"a":["d"]}`,
+			want:   `{"a":["d"]}`,
+		},
+		{
+			name:   "object replaced by a value",
+			target: `{"a":{"b":"c"}}`,
+			patch:  `{"a":1}`,
+			want:   `{"a":1}`,
+		},
+		{
+			name:   "numbers written back unchanged",
+			target: `{"a":9007199254740993,"b":1.10}`,
+			patch:  `{"c":12345678901234567890,"d":0.1e2}`,
+			want:   `{"a":9007199254740993,"b":1.10,"c":12345678901234567890,"d":0.1e2}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Merge([]byte(tt.target), []byte(tt.patch))
+			if err != nil {
+				t.Fatalf("Merge(%s, %s) err: %v", tt.target, tt.patch, err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Merge(%s, %s) = %s, want %s", tt.target, tt.patch, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeRejectsNonObjectPatches(t *testing.T) {
+	patches := []string{`null`, `[]`, `["a"]`, `"a"`, `1`, `true`, `{"a":`, ``}
+
+	for _, patch := range patches {
+		t.Run(patch, func(t *testing.T) {
+			got, err := Merge([]byte(`{"a":"b"}`), []byte(patch))
+			if err == nil {
+				t.Fatalf("Merge(%s) = %s, want an error", patch, got)
+			}
+			if !errors.Is(err, httpPkg.BadRequest) {
+				t.Errorf("Merge(%s) err: %v, want a bad request", patch, err)
+			}
+		})
+	}
+}
+
+type document struct {
+	Name   string            `json:"name"`
+	Count  int64             `json:"count"`
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name    string
+		patch   string
+		want    document
+		wantErr bool
+	}{
+		{
+			name:  "changes the patched fields only",
+			patch: `{"name":"after","labels":{"b":"c"}}`,
+			want:  document{Name: "after", Count: 9007199254740993, Labels: map[string]string{"a": "b", "b": "c"}},
+		},
+		{
+			name:  "null clears a field",
+			patch: `{"labels":null}`,
+			want:  document{Name: "before", Count: 9007199254740993},
+		},
+		{
+			name:    "unknown field",
+			patch:   `{"unknown":"a"}`,
+			wantErr: true,
+		},
+		{
+			name:    "wrong type",
+			patch:   `{"count":"a"}`,
+			wantErr: true,
+		},
+		{
+			name:    "not an object",
+			patch:   `["name"]`,
+			wantErr: true,
+		},
+	}
+
+	before := document{Name: "before", Count: 9007199254740993, Labels: map[string]string{"a": "b"}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got document
+			err := Apply(before, []byte(tt.patch), &got)
+			if tt.wantErr {
+				if !errors.Is(err, httpPkg.BadRequest) {
+					t.Fatalf("Apply(%s) err: %v, want a bad request", tt.patch, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Apply(%s) err: %v", tt.patch, err)
+			}
+
+			gotJSON, _ := json.Marshal(got)
+			wantJSON, _ := json.Marshal(tt.want)
+			if string(gotJSON) != string(wantJSON) {
+				t.Errorf("Apply(%s) = %s, want %s", tt.patch, gotJSON, wantJSON)
+			}
+		})
+	}
+}
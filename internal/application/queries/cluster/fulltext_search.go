@@ -2,6 +2,8 @@ package cluster
 
 import (
 	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/application/classification"
 	"gallery-service/internal/application/dto/responses/cluster"
 	"gallery-service/internal/domain/repository"
 	"gallery-service/pkg/zap"
@@ -9,20 +11,65 @@ import (
 
 type SearchClustersQueryHandler interface {
 	Handle(ctx context.Context, command *SearchClustersQuery) (*cluster.GetAllClusterResponseDto, error)
+	Handle4App(ctx context.Context, command *SearchClustersQuery) (*cluster.GetAllClusterResponseDto, error)
 }
 
 type searchClustersHandler struct {
 	log            zap.Logger
 	taskRepository repository.ClusterRepository
+	taxonomyRepo   repository.TaxonomyRepository
+	folderAccess   access.FolderAccess
 }
 
-func NewSearchClustersHandler(log zap.Logger, taskRepository repository.ClusterRepository) *searchClustersHandler {
-	return &searchClustersHandler{log: log, taskRepository: taskRepository}
+func NewSearchClustersHandler(log zap.Logger, taskRepository repository.ClusterRepository, taxonomyRepo repository.TaxonomyRepository, folderAccess access.FolderAccess) *searchClustersHandler {
+	return &searchClustersHandler{log: log, taskRepository: taskRepository, taxonomyRepo: taxonomyRepo, folderAccess: folderAccess}
 }
 
 func (s *searchClustersHandler) Handle(ctx context.Context, command *SearchClustersQuery) (*cluster.GetAllClusterResponseDto, error) {
+	return s.search(ctx, command, false)
+}
+
+// Handle4App searches the clusters shown to users, leaving out the hidden ones
+func (s *searchClustersHandler) Handle4App(ctx context.Context, command *SearchClustersQuery) (*cluster.GetAllClusterResponseDto, error) {
+	return s.search(ctx, command, true)
+}
+
+func (s *searchClustersHandler) search(ctx context.Context, command *SearchClustersQuery, visible bool) (*cluster.GetAllClusterResponseDto, error) {
+	subtrees, err := classification.Subtrees(ctx, s.taxonomyRepo, command.Terms)
+	if err != nil {
+		return nil, err
+	}
+
 	query := make(map[string]interface{})
 	query["keyword"] = command.Keyword
+	query["tags"] = command.Tags
+	query["tag_mode"] = command.TagMode
+	query["taxonomy_terms"] = subtrees
+	query["visible"] = visible
+
+	// Folders may be closed off by their own grants, so they are left out before paging
+	folderIDs, restricted, err := s.folderAccess.ReadableIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if restricted {
+		query["folder_ids"] = folderIDs
+	}
+
+	res, err := s.taskRepository.Search(ctx, query, command.Pq)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := s.taskRepository.GetTagCounts(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	res.Facets, err = readableTagCounts(ctx, s.folderAccess, counts)
+	if err != nil {
+		return nil, err
+	}
 
-	return s.taskRepository.Search(ctx, query, command.Pq)
+	return res, nil
 }
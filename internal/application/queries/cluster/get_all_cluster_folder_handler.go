@@ -2,25 +2,76 @@ package cluster
 
 import (
 	"context"
+	"gallery-service/internal/application/access"
 	"gallery-service/internal/application/dto/responses/cluster"
 	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
 	"gallery-service/pkg/utils"
 	"gallery-service/pkg/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 type GetAllClusterFolderQueryHandler interface {
 	Handle(ctx context.Context, command *GetFolderID, pq *utils.Pagination) (*cluster.GetAllClusterResponseDto, error)
+	Handle4App(ctx context.Context, command *GetFolderID, pq *utils.Pagination) (*cluster.GetAllClusterResponseDto, error)
 }
 
 type getClusterFolderHandler struct {
-	log         zap.Logger
-	clusterRepo repository.ClusterRepository
+	log          zap.Logger
+	clusterRepo  repository.ClusterRepository
+	folderRepo   repository.FolderRepository
+	folderAccess access.FolderAccess
 }
 
-func NewGetAllClusterFolderHandler(log zap.Logger, clusterRepo repository.ClusterRepository) *getClusterFolderHandler {
-	return &getClusterFolderHandler{log: log, clusterRepo: clusterRepo}
+func NewGetAllClusterFolderHandler(
+	log zap.Logger,
+	clusterRepo repository.ClusterRepository,
+	folderRepo repository.FolderRepository,
+	folderAccess access.FolderAccess,
+) *getClusterFolderHandler {
+	return &getClusterFolderHandler{log: log, clusterRepo: clusterRepo, folderRepo: folderRepo, folderAccess: folderAccess}
 }
 
 func (q *getClusterFolderHandler) Handle(ctx context.Context, command *GetFolderID, pq *utils.Pagination) (*cluster.GetAllClusterResponseDto, error) {
-	return q.clusterRepo.GetAllByFolderID(ctx, command.ID, pq)
+	return q.getAll(ctx, command, false, pq)
+}
+
+// Handle4App lists the clusters of the folder shown to users, leaving out the hidden ones
+func (q *getClusterFolderHandler) Handle4App(ctx context.Context, command *GetFolderID, pq *utils.Pagination) (*cluster.GetAllClusterResponseDto, error) {
+	return q.getAll(ctx, command, true, pq)
+}
+
+func (q *getClusterFolderHandler) getAll(ctx context.Context, command *GetFolderID, visible bool, pq *utils.Pagination) (*cluster.GetAllClusterResponseDto, error) {
+	folder, err := q.folderRepo.GetByID(ctx, command.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := q.folderAccess.Require(ctx, folder, constants.FolderPermissionRead); err != nil {
+		return nil, err
+	}
+
+	folderIDs := []primitive.ObjectID{folder.ID}
+	if command.Recursive {
+		// Subfolders may be closed off by their own grants, so they are left out before
+		// paging rather than after
+		descendants, err := q.folderRepo.GetDescendantIDs(ctx, command.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		readable, err := q.folderAccess.Readable(ctx, descendants)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, id := range descendants {
+			if readable[id] {
+				folderIDs = append(folderIDs, id)
+			}
+		}
+	}
+
+	return q.clusterRepo.GetAllByFolderIDs(ctx, folderIDs, visible, pq)
 }
@@ -2,25 +2,74 @@ package cluster
 
 import (
 	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/application/classification"
 	"gallery-service/internal/application/dto/responses/cluster"
 	"gallery-service/internal/domain/repository"
-	"gallery-service/pkg/utils"
 	"gallery-service/pkg/zap"
 )
 
 type GetAllClusterQueryHandler interface {
-	Handle(ctx context.Context, pq *utils.Pagination) (*cluster.GetAllClusterResponseDto, error)
+	Handle(ctx context.Context, query *GetAllClusterQuery) (*cluster.GetAllClusterResponseDto, error)
+	Handle4App(ctx context.Context, query *GetAllClusterQuery) (*cluster.GetAllClusterResponseDto, error)
 }
 
 type getClusterHandler struct {
-	log         zap.Logger
-	clusterRepo repository.ClusterRepository
+	log          zap.Logger
+	clusterRepo  repository.ClusterRepository
+	taxonomyRepo repository.TaxonomyRepository
+	folderAccess access.FolderAccess
 }
 
-func NewGetAllClusterHandler(log zap.Logger, clusterRepo repository.ClusterRepository) *getClusterHandler {
-	return &getClusterHandler{log: log, clusterRepo: clusterRepo}
+func NewGetAllClusterHandler(log zap.Logger, clusterRepo repository.ClusterRepository, taxonomyRepo repository.TaxonomyRepository, folderAccess access.FolderAccess) *getClusterHandler {
+	return &getClusterHandler{log: log, clusterRepo: clusterRepo, taxonomyRepo: taxonomyRepo, folderAccess: folderAccess}
 }
 
-func (q *getClusterHandler) Handle(ctx context.Context, pq *utils.Pagination) (*cluster.GetAllClusterResponseDto, error) {
-	return q.clusterRepo.GetAll(ctx, pq)
+func (q *getClusterHandler) Handle(ctx context.Context, query *GetAllClusterQuery) (*cluster.GetAllClusterResponseDto, error) {
+	return q.getAll(ctx, query, false)
+}
+
+// Handle4App lists the clusters shown to users, leaving out the hidden ones
+func (q *getClusterHandler) Handle4App(ctx context.Context, query *GetAllClusterQuery) (*cluster.GetAllClusterResponseDto, error) {
+	return q.getAll(ctx, query, true)
+}
+
+func (q *getClusterHandler) getAll(ctx context.Context, query *GetAllClusterQuery, visible bool) (*cluster.GetAllClusterResponseDto, error) {
+	subtrees, err := classification.Subtrees(ctx, q.taxonomyRepo, query.Terms)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := map[string]interface{}{
+		"tags":           query.Tags,
+		"tag_mode":       query.TagMode,
+		"taxonomy_terms": subtrees,
+		"visible":        visible,
+	}
+
+	// Folders may be closed off by their own grants, so they are left out before paging
+	folderIDs, restricted, err := q.folderAccess.ReadableIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if restricted {
+		filter["folder_ids"] = folderIDs
+	}
+
+	res, err := q.clusterRepo.GetAll(ctx, filter, query.Pq)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := q.clusterRepo.GetTagCounts(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	res.Facets, err = readableTagCounts(ctx, q.folderAccess, counts)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
 }
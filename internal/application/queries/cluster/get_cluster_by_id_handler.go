@@ -2,24 +2,51 @@ package cluster
 
 import (
 	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/application/dto/responses/cluster"
+	"gallery-service/internal/application/mappers"
 	"gallery-service/internal/domain/models"
 	"gallery-service/internal/domain/repository"
 	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
 )
 
 type GetClusterByIDQueryHandler interface {
 	Handle(ctx context.Context, command *GetClusterByIDQuery) (*models.Cluster, error)
+	Handle4App(ctx context.Context, command *GetLocalizedClusterQuery) (*cluster.LocalizedClusterResponseDto, error)
 }
 
 type getClusterByIDHandler struct {
-	log      zap.Logger
-	taskRepo repository.ClusterRepository
+	log          zap.Logger
+	taskRepo     repository.ClusterRepository
+	folderRepo   repository.FolderRepository
+	folderAccess access.FolderAccess
 }
 
-func NewGetClusterByIDHandler(log zap.Logger, taskRepo repository.ClusterRepository) *getClusterByIDHandler {
-	return &getClusterByIDHandler{log: log, taskRepo: taskRepo}
+func NewGetClusterByIDHandler(
+	log zap.Logger,
+	taskRepo repository.ClusterRepository,
+	folderRepo repository.FolderRepository,
+	folderAccess access.FolderAccess,
+) *getClusterByIDHandler {
+	return &getClusterByIDHandler{log: log, taskRepo: taskRepo, folderRepo: folderRepo, folderAccess: folderAccess}
 }
 
 func (q *getClusterByIDHandler) Handle(ctx context.Context, query *GetClusterByIDQuery) (*models.Cluster, error) {
-	return q.taskRepo.GetByID(ctx, query.ID)
+	return readableCluster(ctx, q.taskRepo, q.folderRepo, q.folderAccess, query.ID)
+}
+
+func (q *getClusterByIDHandler) Handle4App(ctx context.Context, query *GetLocalizedClusterQuery) (*cluster.LocalizedClusterResponseDto, error) {
+	cluster, err := readableCluster(ctx, q.taskRepo, q.folderRepo, q.folderAccess, query.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// A hidden cluster is not published yet, or not anymore
+	if cluster.Hidden {
+		return nil, errors.Errorf("cluster %s not found", query.ID)
+	}
+
+	return mappers.GetLocalizedClusterFromModel(cluster, query.Languages), nil
 }
@@ -0,0 +1,87 @@
+package cluster
+
+import (
+	"context"
+	"gallery-service/internal/application/access"
+	revisionDto "gallery-service/internal/application/dto/responses/revision"
+	"gallery-service/internal/application/revision"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/pkg/zap"
+)
+
+type GetClusterRevisionDiffQueryHandler interface {
+	Handle(ctx context.Context, query *GetClusterRevisionDiffQuery) (*revisionDto.RevisionDiffResponseDto, error)
+}
+
+type getClusterRevisionDiffHandler struct {
+	log          zap.Logger
+	clusterRepo  repository.ClusterRepository
+	folderRepo   repository.FolderRepository
+	revisionRepo repository.RevisionRepository
+	folderAccess access.FolderAccess
+}
+
+func NewGetClusterRevisionDiffHandler(
+	log zap.Logger,
+	clusterRepo repository.ClusterRepository,
+	folderRepo repository.FolderRepository,
+	revisionRepo repository.RevisionRepository,
+	folderAccess access.FolderAccess,
+) *getClusterRevisionDiffHandler {
+	return &getClusterRevisionDiffHandler{
+		log:          log,
+		clusterRepo:  clusterRepo,
+		folderRepo:   folderRepo,
+		revisionRepo: revisionRepo,
+		folderAccess: folderAccess,
+	}
+}
+
+// Handle compares revision From with revision To, or with the current cluster when To is 0
+func (q *getClusterRevisionDiffHandler) Handle(ctx context.Context, query *GetClusterRevisionDiffQuery) (*revisionDto.RevisionDiffResponseDto, error) {
+	cluster, err := readableCluster(ctx, q.clusterRepo, q.folderRepo, q.folderAccess, query.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := q.snapshot(ctx, cluster, query.From)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := q.snapshot(ctx, cluster, query.To)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := revision.Diff(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &revisionDto.RevisionDiffResponseDto{
+		From:    query.From,
+		To:      query.To,
+		Changes: changes,
+	}, nil
+}
+
+func (q *getClusterRevisionDiffHandler) snapshot(ctx context.Context, cluster *models.Cluster, number int64) (*models.Cluster, error) {
+	if number == 0 {
+		return cluster, nil
+	}
+
+	r, err := q.revisionRepo.GetByNumber(ctx, constants.RevisionEntityCluster, cluster.ID, number)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshot models.Cluster
+	if err := revision.Decode(r, &snapshot); err != nil {
+		return nil, err
+	}
+
+	return &snapshot, nil
+}
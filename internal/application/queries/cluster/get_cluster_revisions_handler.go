@@ -0,0 +1,66 @@
+package cluster
+
+import (
+	"context"
+	"gallery-service/internal/application/access"
+	revisionDto "gallery-service/internal/application/dto/responses/revision"
+	"gallery-service/internal/application/mappers"
+	"gallery-service/internal/application/revision"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/pkg/zap"
+)
+
+type GetClusterRevisionsQueryHandler interface {
+	Handle(ctx context.Context, query *GetClusterByIDQuery) (*revisionDto.GetAllRevisionResponseDto, error)
+}
+
+type getClusterRevisionsHandler struct {
+	log          zap.Logger
+	clusterRepo  repository.ClusterRepository
+	folderRepo   repository.FolderRepository
+	revisionRepo repository.RevisionRepository
+	folderAccess access.FolderAccess
+}
+
+func NewGetClusterRevisionsHandler(
+	log zap.Logger,
+	clusterRepo repository.ClusterRepository,
+	folderRepo repository.FolderRepository,
+	revisionRepo repository.RevisionRepository,
+	folderAccess access.FolderAccess,
+) *getClusterRevisionsHandler {
+	return &getClusterRevisionsHandler{
+		log:          log,
+		clusterRepo:  clusterRepo,
+		folderRepo:   folderRepo,
+		revisionRepo: revisionRepo,
+		folderAccess: folderAccess,
+	}
+}
+
+func (q *getClusterRevisionsHandler) Handle(ctx context.Context, query *GetClusterByIDQuery) (*revisionDto.GetAllRevisionResponseDto, error) {
+	cluster, err := readableCluster(ctx, q.clusterRepo, q.folderRepo, q.folderAccess, query.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := q.revisionRepo.GetAll(ctx, constants.RevisionEntityCluster, cluster.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]revisionDto.GetRevisionResponseDto, 0, len(revisions))
+	for _, r := range revisions {
+		var snapshot models.Cluster
+		if err := revision.Decode(r, &snapshot); err != nil {
+			q.log.Errorf("(GetClusterRevisionsQueryHandler.Handle) revision: {%d}, err: {%v}", r.Number, err)
+			return nil, err
+		}
+
+		res = append(res, mappers.GetRevisionFromModel(r, &snapshot))
+	}
+
+	return &revisionDto.GetAllRevisionResponseDto{Revisions: res}, nil
+}
@@ -0,0 +1,37 @@
+package cluster
+
+import (
+	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/application/dto/responses/cluster"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/pkg/zap"
+)
+
+type GetClusterTagsQueryHandler interface {
+	Handle(ctx context.Context) (*cluster.GetAllClusterTagResponseDto, error)
+}
+
+type getClusterTagsHandler struct {
+	log          zap.Logger
+	clusterRepo  repository.ClusterRepository
+	folderAccess access.FolderAccess
+}
+
+func NewGetClusterTagsHandler(log zap.Logger, clusterRepo repository.ClusterRepository, folderAccess access.FolderAccess) *getClusterTagsHandler {
+	return &getClusterTagsHandler{log: log, clusterRepo: clusterRepo, folderAccess: folderAccess}
+}
+
+func (q *getClusterTagsHandler) Handle(ctx context.Context) (*cluster.GetAllClusterTagResponseDto, error) {
+	counts, err := q.clusterRepo.GetTagCounts(ctx, map[string]interface{}{})
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := readableTagCounts(ctx, q.folderAccess, counts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cluster.GetAllClusterTagResponseDto{Tags: tags}, nil
+}
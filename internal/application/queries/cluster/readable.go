@@ -0,0 +1,38 @@
+package cluster
+
+import (
+	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+)
+
+// readableCluster fetches the cluster if the current user can read its folder
+func readableCluster(
+	ctx context.Context,
+	clusterRepo repository.ClusterRepository,
+	folderRepo repository.FolderRepository,
+	folderAccess access.FolderAccess,
+	id string,
+) (*models.Cluster, error) {
+	cluster, err := clusterRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if cluster.FolderID.IsZero() {
+		return cluster, nil
+	}
+
+	folder, err := folderRepo.GetByID(ctx, cluster.FolderID.Hex())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := folderAccess.Require(ctx, folder, constants.FolderPermissionRead); err != nil {
+		return nil, err
+	}
+
+	return cluster, nil
+}
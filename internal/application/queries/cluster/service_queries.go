@@ -1,6 +1,7 @@
 package cluster
 
 import (
+	"gallery-service/internal/pkg/constants"
 	"gallery-service/pkg/utils"
 )
 
@@ -9,6 +10,9 @@ type Queries struct {
 	GetClusterByID      GetClusterByIDQueryHandler
 	SearchClusters      SearchClustersQueryHandler
 	GetAllClusterFolder GetAllClusterFolderQueryHandler
+	GetRevisions        GetClusterRevisionsQueryHandler
+	GetRevisionDiff     GetClusterRevisionDiffQueryHandler
+	GetTags             GetClusterTagsQueryHandler
 }
 
 func NewClusterQueries(
@@ -16,15 +20,34 @@ func NewClusterQueries(
 	getClusterByID GetClusterByIDQueryHandler,
 	searchClusters SearchClustersQueryHandler,
 	getClusterFolder GetAllClusterFolderQueryHandler,
+	getRevisions GetClusterRevisionsQueryHandler,
+	getRevisionDiff GetClusterRevisionDiffQueryHandler,
+	getTags GetClusterTagsQueryHandler,
 ) *Queries {
 	return &Queries{
 		GetAllCluster:       getAllCluster,
 		GetClusterByID:      getClusterByID,
 		SearchClusters:      searchClusters,
 		GetAllClusterFolder: getClusterFolder,
+		GetRevisions:        getRevisions,
+		GetRevisionDiff:     getRevisionDiff,
+		GetTags:             getTags,
 	}
 }
 
+// GetAllClusterQuery lists the clusters carrying Tags, matched with TagMode, and
+// classified under every one of the taxonomy Terms
+type GetAllClusterQuery struct {
+	Tags    []string
+	TagMode constants.TagMatch
+	Terms   []string
+	Pq      *utils.Pagination
+}
+
+func NewGetAllClusterQuery(tags []string, tagMode constants.TagMatch, terms []string, pq *utils.Pagination) *GetAllClusterQuery {
+	return &GetAllClusterQuery{Tags: tags, TagMode: tagMode, Terms: terms, Pq: pq}
+}
+
 type GetClusterByIDQuery struct {
 	ID string `json:"id" validate:"required"`
 }
@@ -33,25 +56,55 @@ func NewGetClusterByIDQuery(ID string) *GetClusterByIDQuery {
 	return &GetClusterByIDQuery{ID: ID}
 }
 
+// GetLocalizedClusterQuery reads a cluster in the first of Languages it has
+type GetLocalizedClusterQuery struct {
+	ID        string               `json:"id" validate:"required"`
+	Languages []constants.Language `json:"languages"`
+}
+
+func NewGetLocalizedClusterQuery(id string, languages []constants.Language) *GetLocalizedClusterQuery {
+	return &GetLocalizedClusterQuery{ID: id, Languages: languages}
+}
+
+type GetClusterRevisionDiffQuery struct {
+	ID   string `json:"id" validate:"required"`
+	From int64  `json:"from" validate:"required,gt=0"`
+	To   int64  `json:"to" validate:"gte=0"`
+}
+
+func NewGetClusterRevisionDiffQuery(id string, from int64, to int64) *GetClusterRevisionDiffQuery {
+	return &GetClusterRevisionDiffQuery{ID: id, From: from, To: to}
+}
+
 type GetFolderID struct {
-	ID string `json:"folder_id" validate:"required"`
+	ID        string `json:"folder_id" validate:"required"`
+	Recursive bool   `json:"recursive"`
 }
 
-func NewGetFolderID(ID string) *GetFolderID {
-	return &GetFolderID{ID: ID}
+func NewGetFolderID(ID string, recursive bool) *GetFolderID {
+	return &GetFolderID{ID: ID, Recursive: recursive}
 }
 
 type SearchClustersQuery struct {
 	Keyword string
+	Tags    []string
+	TagMode constants.TagMatch
+	Terms   []string
 	Pq      *utils.Pagination
 }
 
 func NewSearchClustersQuery(
 	keyword string,
+	tags []string,
+	tagMode constants.TagMatch,
+	terms []string,
 	pq *utils.Pagination,
 ) *SearchClustersQuery {
 	return &SearchClustersQuery{
 		Keyword: keyword,
+		Tags:    tags,
+		TagMode: tagMode,
+		Terms:   terms,
 		Pq:      pq,
 	}
 }
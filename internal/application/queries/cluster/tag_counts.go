@@ -0,0 +1,50 @@
+package cluster
+
+import (
+	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/application/dto/responses/cluster"
+	"gallery-service/internal/domain/models"
+	"sort"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// readableTagCounts adds up the per folder tag counts over the folders the current user
+// can read, most used tag first
+func readableTagCounts(ctx context.Context, folderAccess access.FolderAccess, counts []models.ClusterTagCount) ([]cluster.TagCountDto, error) {
+	seen := make(map[primitive.ObjectID]bool)
+	folderIDs := make([]primitive.ObjectID, 0)
+	for _, c := range counts {
+		if !c.FolderID.IsZero() && !seen[c.FolderID] {
+			seen[c.FolderID] = true
+			folderIDs = append(folderIDs, c.FolderID)
+		}
+	}
+
+	readable, err := folderAccess.Readable(ctx, folderIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	totals := make(map[string]int64)
+	for _, c := range counts {
+		if c.FolderID.IsZero() || readable[c.FolderID] {
+			totals[c.Tag] += c.Count
+		}
+	}
+
+	res := make([]cluster.TagCountDto, 0, len(totals))
+	for tag, count := range totals {
+		res = append(res, cluster.TagCountDto{Tag: tag, Count: count})
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Count != res[j].Count {
+			return res[i].Count > res[j].Count
+		}
+		return res[i].Tag < res[j].Tag
+	})
+
+	return res, nil
+}
@@ -0,0 +1,196 @@
+package folder
+
+import (
+	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/application/bundle"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+	"sort"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ExportFolderQueryHandler interface {
+	Handle(ctx context.Context, query *ExportFolderQuery) (*bundle.Bundle, error)
+}
+
+type exportFolderHandler struct {
+	log          zap.Logger
+	folderRepo   repository.FolderRepository
+	folderAccess access.FolderAccess
+	clusterRepo  repository.ClusterRepository
+	topicRepo    repository.TopicRepository
+	taxonomyRepo repository.TaxonomyRepository
+}
+
+func NewExportFolderHandler(
+	log zap.Logger,
+	folderRepo repository.FolderRepository,
+	folderAccess access.FolderAccess,
+	clusterRepo repository.ClusterRepository,
+	topicRepo repository.TopicRepository,
+	taxonomyRepo repository.TaxonomyRepository,
+) *exportFolderHandler {
+	return &exportFolderHandler{
+		log:          log,
+		folderRepo:   folderRepo,
+		folderAccess: folderAccess,
+		clusterRepo:  clusterRepo,
+		topicRepo:    topicRepo,
+		taxonomyRepo: taxonomyRepo,
+	}
+}
+
+// Handle bundles the folder, the folders below it the caller can read, their clusters and
+// the requested topics. Paths are cut at the exported folder so the bundle does not
+// depend on where it was taken from.
+func (q *exportFolderHandler) Handle(ctx context.Context, query *ExportFolderQuery) (*bundle.Bundle, error) {
+	source, err := q.folderRepo.GetByID(ctx, query.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := q.folderAccess.Require(ctx, source, constants.FolderPermissionRead); err != nil {
+		return nil, err
+	}
+
+	topicIDs := make([]primitive.ObjectID, 0, len(query.TopicIDs))
+	for _, topicID := range query.TopicIDs {
+		id, err := primitive.ObjectIDFromHex(topicID)
+		if err != nil {
+			return nil, errors.Wrapf(httpPkg.BadRequest, "invalid topic id %s", topicID)
+		}
+		topicIDs = append(topicIDs, id)
+	}
+
+	descendants, err := q.folderRepo.GetDescendants(ctx, query.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := q.folderAccess.Permissions(ctx, descendants)
+	if err != nil {
+		return nil, err
+	}
+
+	cut := len(source.Ancestors)
+	root := exportedFolder(source, cut)
+	folders := []*models.Folder{root}
+	folderIDs := []primitive.ObjectID{source.ID}
+	for _, f := range descendants {
+		if permissions[f.ID].Includes(constants.FolderPermissionRead) {
+			folders = append(folders, exportedFolder(f, cut))
+			folderIDs = append(folderIDs, f.ID)
+		}
+	}
+	sort.SliceStable(folders, func(i, j int) bool {
+		if folders[i].Depth != folders[j].Depth {
+			return folders[i].Depth < folders[j].Depth
+		}
+		return folders[i].Position < folders[j].Position
+	})
+
+	clusters, err := q.clusterRepo.GetByFolderIDs(ctx, folderIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// The topics filed in the exported folders come along, then those asked for
+	topics, err := q.topicRepo.GetByFolderIDs(ctx, folderIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	found := make(map[primitive.ObjectID]bool, len(topics))
+	for _, t := range topics {
+		found[t.ID] = true
+	}
+
+	if len(topicIDs) > 0 {
+		requested, err := q.topicRepo.GetByIDs(ctx, topicIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		exported := make(map[primitive.ObjectID]bool, len(folderIDs))
+		for _, id := range folderIDs {
+			exported[id] = true
+		}
+
+		for _, t := range requested {
+			if found[t.ID] {
+				continue
+			}
+			found[t.ID] = true
+
+			// Filed outside of the bundle, the topic is imported out of the folder tree
+			if !exported[t.FolderID] {
+				t.FolderID = primitive.NilObjectID
+			}
+			topics = append(topics, t)
+		}
+	}
+
+	for _, id := range topicIDs {
+		if !found[id] {
+			return nil, errors.Errorf("topic %s not found", id.Hex())
+		}
+	}
+
+	termIDs := make([]primitive.ObjectID, 0)
+	seen := make(map[primitive.ObjectID]bool)
+	for _, c := range clusters {
+		c.OrganizationID = nil
+		termIDs = appendUnseen(termIDs, seen, c.TaxonomyTerms)
+	}
+	for _, t := range topics {
+		t.OrganizationID = nil
+		t.StatusHistory = nil
+		termIDs = appendUnseen(termIDs, seen, t.TaxonomyTerms)
+	}
+
+	terms, err := bundle.TermRefs(ctx, q.taxonomyRepo, termIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	q.log.Infof("(ExportFolderQueryHandler.Handle) id: {%s}, folders: {%d}, clusters: {%d}, topics: {%d}", query.ID, len(folders), len(clusters), len(topics))
+
+	return bundle.New(source.ID, folders, clusters, topics, terms), nil
+}
+
+// exportedFolder copies f without what belongs to this environment, its path starting
+// at the exported folder
+func exportedFolder(f *models.Folder, cut int) *models.Folder {
+	folder := models.Folder{
+		ID:                 f.ID,
+		FolderName:         f.FolderName,
+		FolderThumbnailKey: f.FolderThumbnailKey,
+		FolderThumbnailURL: f.FolderThumbnailURL,
+		ParentID:           f.ParentID,
+		Ancestors:          append(make([]primitive.ObjectID, 0), f.Ancestors[cut:]...),
+		Position:           f.Position,
+	}
+	folder.Depth = len(folder.Ancestors)
+	if folder.Depth == 0 {
+		folder.ParentID = nil
+	}
+
+	return &folder
+}
+
+func appendUnseen(ids []primitive.ObjectID, seen map[primitive.ObjectID]bool, more []primitive.ObjectID) []primitive.ObjectID {
+	for _, id := range more {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
@@ -2,6 +2,7 @@ package folder
 
 import (
 	"context"
+	"gallery-service/internal/application/access"
 	"gallery-service/internal/application/dto/responses/folder"
 	"gallery-service/internal/domain/repository"
 	"gallery-service/pkg/zap"
@@ -14,15 +15,25 @@ type SearchFoldersQueryHandler interface {
 type searchFoldersHandler struct {
 	log            zap.Logger
 	taskRepository repository.FolderRepository
+	folderAccess   access.FolderAccess
 }
 
-func NewSearchFoldersHandler(log zap.Logger, taskRepository repository.FolderRepository) *searchFoldersHandler {
-	return &searchFoldersHandler{log: log, taskRepository: taskRepository}
+func NewSearchFoldersHandler(log zap.Logger, taskRepository repository.FolderRepository, folderAccess access.FolderAccess) *searchFoldersHandler {
+	return &searchFoldersHandler{log: log, taskRepository: taskRepository, folderAccess: folderAccess}
 }
 
 func (s *searchFoldersHandler) Handle(ctx context.Context, command *SearchFoldersQuery) (*folder.GetAllFolderResponseDto, error) {
 	query := make(map[string]interface{})
 	query["keyword"] = command.Keyword
 
+	// Folders may be closed off by their own grants, so they are left out before paging
+	folderIDs, restricted, err := s.folderAccess.ReadableIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if restricted {
+		query["folder_ids"] = folderIDs
+	}
+
 	return s.taskRepository.Search(ctx, query, command.Pq)
 }
@@ -2,6 +2,7 @@ package folder
 
 import (
 	"context"
+	"gallery-service/internal/application/access"
 	"gallery-service/internal/application/dto/responses/folder"
 	"gallery-service/internal/domain/repository"
 	"gallery-service/pkg/utils"
@@ -13,14 +14,26 @@ type GetAllFolderQueryHandler interface {
 }
 
 type getFolderHandler struct {
-	log         zap.Logger
-	clusterRepo repository.FolderRepository
+	log          zap.Logger
+	clusterRepo  repository.FolderRepository
+	folderAccess access.FolderAccess
 }
 
-func NewGetAllFolderHandler(log zap.Logger, clusterRepo repository.FolderRepository) *getFolderHandler {
-	return &getFolderHandler{log: log, clusterRepo: clusterRepo}
+func NewGetAllFolderHandler(log zap.Logger, clusterRepo repository.FolderRepository, folderAccess access.FolderAccess) *getFolderHandler {
+	return &getFolderHandler{log: log, clusterRepo: clusterRepo, folderAccess: folderAccess}
 }
 
 func (q *getFolderHandler) Handle(ctx context.Context, pq *utils.Pagination) (*folder.GetAllFolderResponseDto, error) {
-	return q.clusterRepo.GetAll(ctx, pq)
+	query := make(map[string]interface{})
+
+	// Folders may be closed off by their own grants, so they are left out before paging
+	folderIDs, restricted, err := q.folderAccess.ReadableIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if restricted {
+		query["folder_ids"] = folderIDs
+	}
+
+	return q.clusterRepo.GetAll(ctx, query, pq)
 }
@@ -0,0 +1,55 @@
+package folder
+
+import (
+	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/application/dto/responses/folder"
+	"gallery-service/internal/application/mappers"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	httpPkg "gallery-service/pkg/http"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+)
+
+type GetFolderACLQueryHandler interface {
+	Handle(ctx context.Context, query *GetFolderByIDQuery) (*folder.GetFolderACLResponseDto, error)
+}
+
+type getFolderACLHandler struct {
+	log          zap.Logger
+	folderRepo   repository.FolderRepository
+	folderAccess access.FolderAccess
+}
+
+func NewGetFolderACLHandler(log zap.Logger, folderRepo repository.FolderRepository, folderAccess access.FolderAccess) *getFolderACLHandler {
+	return &getFolderACLHandler{log: log, folderRepo: folderRepo, folderAccess: folderAccess}
+}
+
+// Handle returns the grants on the folder, the grants it inherits from its ancestors and
+// the permission the current user ends up with
+func (q *getFolderACLHandler) Handle(ctx context.Context, query *GetFolderByIDQuery) (*folder.GetFolderACLResponseDto, error) {
+	f, err := q.folderRepo.GetByID(ctx, query.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := q.folderAccess.Permissions(ctx, []*models.Folder{f})
+	if err != nil {
+		return nil, err
+	}
+
+	permission := permissions[f.ID]
+	if !permission.Includes(constants.FolderPermissionRead) {
+		return nil, errors.Wrapf(httpPkg.Forbidden, "%s permission required on folder %s", constants.FolderPermissionRead, f.ID.Hex())
+	}
+
+	ancestors, err := q.folderRepo.GetByIDs(ctx, f.Ancestors)
+	if err != nil {
+		return nil, err
+	}
+
+	return mappers.GetFolderACLFromModels(f, ancestors, permission), nil
+}
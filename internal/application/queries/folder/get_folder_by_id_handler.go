@@ -2,8 +2,10 @@ package folder
 
 import (
 	"context"
+	"gallery-service/internal/application/access"
 	"gallery-service/internal/domain/models"
 	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
 	"gallery-service/pkg/zap"
 )
 
@@ -12,14 +14,24 @@ type GetFolderByIDQueryHandler interface {
 }
 
 type getFolderByIDHandler struct {
-	log      zap.Logger
-	taskRepo repository.FolderRepository
+	log          zap.Logger
+	taskRepo     repository.FolderRepository
+	folderAccess access.FolderAccess
 }
 
-func NewGetFolderByIDHandler(log zap.Logger, taskRepo repository.FolderRepository) *getFolderByIDHandler {
-	return &getFolderByIDHandler{log: log, taskRepo: taskRepo}
+func NewGetFolderByIDHandler(log zap.Logger, taskRepo repository.FolderRepository, folderAccess access.FolderAccess) *getFolderByIDHandler {
+	return &getFolderByIDHandler{log: log, taskRepo: taskRepo, folderAccess: folderAccess}
 }
 
 func (q *getFolderByIDHandler) Handle(ctx context.Context, query *GetFolderByIDQuery) (*models.Folder, error) {
-	return q.taskRepo.GetByID(ctx, query.ID)
+	folder, err := q.taskRepo.GetByID(ctx, query.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := q.folderAccess.Require(ctx, folder, constants.FolderPermissionRead); err != nil {
+		return nil, err
+	}
+
+	return folder, nil
 }
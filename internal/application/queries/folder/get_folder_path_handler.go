@@ -0,0 +1,37 @@
+package folder
+
+import (
+	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/application/dto/responses/folder"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/pkg/zap"
+)
+
+type GetFolderPathQueryHandler interface {
+	Handle(ctx context.Context, query *GetFolderByIDQuery) (*folder.GetFolderPathResponseDto, error)
+}
+
+type getFolderPathHandler struct {
+	log          zap.Logger
+	folderRepo   repository.FolderRepository
+	folderAccess access.FolderAccess
+}
+
+func NewGetFolderPathHandler(log zap.Logger, folderRepo repository.FolderRepository, folderAccess access.FolderAccess) *getFolderPathHandler {
+	return &getFolderPathHandler{log: log, folderRepo: folderRepo, folderAccess: folderAccess}
+}
+
+func (q *getFolderPathHandler) Handle(ctx context.Context, query *GetFolderByIDQuery) (*folder.GetFolderPathResponseDto, error) {
+	f, err := q.folderRepo.GetByID(ctx, query.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := q.folderAccess.Require(ctx, f, constants.FolderPermissionRead); err != nil {
+		return nil, err
+	}
+
+	return q.folderRepo.GetPath(ctx, query.ID)
+}
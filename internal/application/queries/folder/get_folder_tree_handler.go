@@ -0,0 +1,38 @@
+package folder
+
+import (
+	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/application/dto/responses/folder"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/pkg/zap"
+)
+
+type GetFolderTreeQueryHandler interface {
+	Handle(ctx context.Context, query *GetFolderTreeQuery) (*folder.GetFolderTreeResponseDto, error)
+}
+
+type getFolderTreeHandler struct {
+	log          zap.Logger
+	folderRepo   repository.FolderRepository
+	folderAccess access.FolderAccess
+}
+
+func NewGetFolderTreeHandler(log zap.Logger, folderRepo repository.FolderRepository, folderAccess access.FolderAccess) *getFolderTreeHandler {
+	return &getFolderTreeHandler{log: log, folderRepo: folderRepo, folderAccess: folderAccess}
+}
+
+func (q *getFolderTreeHandler) Handle(ctx context.Context, query *GetFolderTreeQuery) (*folder.GetFolderTreeResponseDto, error) {
+	tree, err := q.folderRepo.GetTree(ctx, query.RootID, query.MaxDepth)
+	if err != nil {
+		return nil, err
+	}
+
+	readable, err := q.folderAccess.Readable(ctx, collectTreeIDs(tree.Folders, nil))
+	if err != nil {
+		return nil, err
+	}
+	tree.Folders = readableTree(tree.Folders, readable)
+
+	return tree, nil
+}
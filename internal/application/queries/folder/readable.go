@@ -0,0 +1,35 @@
+package folder
+
+import (
+	"gallery-service/internal/application/dto/responses/folder"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// readableTree prunes the nodes the current user cannot read. Access never widens down
+// the tree, so the whole subtree of an unreadable folder goes with it.
+func readableTree(nodes []*folder.FolderTreeNodeDto, readable map[primitive.ObjectID]bool) []*folder.FolderTreeNodeDto {
+	res := make([]*folder.FolderTreeNodeDto, 0, len(nodes))
+	for _, node := range nodes {
+		id, err := primitive.ObjectIDFromHex(node.ID)
+		if err != nil || !readable[id] {
+			continue
+		}
+
+		node.Children = readableTree(node.Children, readable)
+		res = append(res, node)
+	}
+
+	return res
+}
+
+func collectTreeIDs(nodes []*folder.FolderTreeNodeDto, ids []primitive.ObjectID) []primitive.ObjectID {
+	for _, node := range nodes {
+		if id, err := primitive.ObjectIDFromHex(node.ID); err == nil {
+			ids = append(ids, id)
+		}
+		ids = collectTreeIDs(node.Children, ids)
+	}
+
+	return ids
+}
@@ -8,17 +8,29 @@ type Queries struct {
 	GetAllFolder  GetAllFolderQueryHandler
 	GetFolderByID GetFolderByIDQueryHandler
 	SearchFolders SearchFoldersQueryHandler
+	GetFolderTree GetFolderTreeQueryHandler
+	GetFolderPath GetFolderPathQueryHandler
+	GetFolderACL  GetFolderACLQueryHandler
+	ExportFolder  ExportFolderQueryHandler
 }
 
 func NewFolderQueries(
 	getAllFolder GetAllFolderQueryHandler,
 	getFolderByID GetFolderByIDQueryHandler,
 	searchFolders SearchFoldersQueryHandler,
+	getFolderTree GetFolderTreeQueryHandler,
+	getFolderPath GetFolderPathQueryHandler,
+	getFolderACL GetFolderACLQueryHandler,
+	exportFolder ExportFolderQueryHandler,
 ) *Queries {
 	return &Queries{
 		GetAllFolder:  getAllFolder,
 		GetFolderByID: getFolderByID,
 		SearchFolders: searchFolders,
+		GetFolderTree: getFolderTree,
+		GetFolderPath: getFolderPath,
+		GetFolderACL:  getFolderACL,
+		ExportFolder:  exportFolder,
 	}
 }
 
@@ -44,3 +56,28 @@ func NewSearchFoldersQuery(
 		Pq:      pq,
 	}
 }
+
+type GetFolderTreeQuery struct {
+	RootID   string
+	MaxDepth int
+}
+
+func NewGetFolderTreeQuery(rootID string, maxDepth int) *GetFolderTreeQuery {
+	return &GetFolderTreeQuery{
+		RootID:   rootID,
+		MaxDepth: maxDepth,
+	}
+}
+
+type ExportFolderQuery struct {
+	ID string `json:"id" validate:"required"`
+	// TopicIDs are the topics bundled along with the folder
+	TopicIDs []string
+}
+
+func NewExportFolderQuery(id string, topicIDs []string) *ExportFolderQuery {
+	return &ExportFolderQuery{
+		ID:       id,
+		TopicIDs: topicIDs,
+	}
+}
@@ -0,0 +1,34 @@
+package registry
+
+import (
+	"context"
+	"gallery-service/internal/application/dto/responses/registry"
+	"gallery-service/internal/application/mappers"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/pkg/zap"
+)
+
+type GetAllComponentsQueryHandler interface {
+	Handle(ctx context.Context) (*registry.GetAllComponentResponseDto, error)
+}
+
+type getAllComponentsHandler struct {
+	log          zap.Logger
+	registryRepo repository.RegistryRepository
+}
+
+func NewGetAllComponentsHandler(log zap.Logger, registryRepo repository.RegistryRepository) *getAllComponentsHandler {
+	return &getAllComponentsHandler{log: log, registryRepo: registryRepo}
+}
+
+// Handle lists every component, disabled ones included, as stored
+func (q *getAllComponentsHandler) Handle(ctx context.Context) (*registry.GetAllComponentResponseDto, error) {
+	components, err := q.registryRepo.GetComponents(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &registry.GetAllComponentResponseDto{
+		Components: mappers.GetComponentsFromModels(components),
+	}, nil
+}
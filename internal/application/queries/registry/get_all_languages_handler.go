@@ -0,0 +1,35 @@
+package registry
+
+import (
+	"context"
+	"gallery-service/internal/application/dto/responses/registry"
+	"gallery-service/internal/application/mappers"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/pkg/zap"
+)
+
+type GetAllLanguagesQueryHandler interface {
+	Handle(ctx context.Context) (*registry.GetAllLanguageResponseDto, error)
+}
+
+type getAllLanguagesHandler struct {
+	log          zap.Logger
+	registryRepo repository.RegistryRepository
+}
+
+func NewGetAllLanguagesHandler(log zap.Logger, registryRepo repository.RegistryRepository) *getAllLanguagesHandler {
+	return &getAllLanguagesHandler{log: log, registryRepo: registryRepo}
+}
+
+// Handle lists every language, disabled ones included, as stored rather than as held
+// in process, so that a change shows right away on any replica
+func (q *getAllLanguagesHandler) Handle(ctx context.Context) (*registry.GetAllLanguageResponseDto, error) {
+	languages, err := q.registryRepo.GetLanguages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &registry.GetAllLanguageResponseDto{
+		Languages: mappers.GetLanguagesFromModels(languages),
+	}, nil
+}
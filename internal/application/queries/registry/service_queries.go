@@ -0,0 +1,16 @@
+package registry
+
+type Queries struct {
+	GetAllLanguages  GetAllLanguagesQueryHandler
+	GetAllComponents GetAllComponentsQueryHandler
+}
+
+func NewRegistryQueries(
+	getAllLanguages GetAllLanguagesQueryHandler,
+	getAllComponents GetAllComponentsQueryHandler,
+) *Queries {
+	return &Queries{
+		GetAllLanguages:  getAllLanguages,
+		GetAllComponents: getAllComponents,
+	}
+}
@@ -0,0 +1,33 @@
+package taxonomy
+
+import (
+	"context"
+	"gallery-service/internal/application/dto/responses/taxonomy"
+	"gallery-service/internal/application/mappers"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/pkg/zap"
+)
+
+type GetAllTaxonomyTermsQueryHandler interface {
+	Handle(ctx context.Context, query *GetTaxonomyQuery) (*taxonomy.GetAllTaxonomyTermResponseDto, error)
+}
+
+type getAllTaxonomyTermsHandler struct {
+	log          zap.Logger
+	taxonomyRepo repository.TaxonomyRepository
+}
+
+func NewGetAllTaxonomyTermsHandler(log zap.Logger, taxonomyRepo repository.TaxonomyRepository) *getAllTaxonomyTermsHandler {
+	return &getAllTaxonomyTermsHandler{log: log, taxonomyRepo: taxonomyRepo}
+}
+
+func (q *getAllTaxonomyTermsHandler) Handle(ctx context.Context, query *GetTaxonomyQuery) (*taxonomy.GetAllTaxonomyTermResponseDto, error) {
+	terms, err := q.taxonomyRepo.GetAll(ctx, query.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	return &taxonomy.GetAllTaxonomyTermResponseDto{
+		Terms: mappers.GetTaxonomyTermsFromModels(terms),
+	}, nil
+}
@@ -0,0 +1,33 @@
+package taxonomy
+
+import (
+	"context"
+	"gallery-service/internal/application/dto/responses/taxonomy"
+	"gallery-service/internal/application/mappers"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/pkg/zap"
+)
+
+type GetTaxonomyTermByIDQueryHandler interface {
+	Handle(ctx context.Context, query *GetTaxonomyTermByIDQuery) (*taxonomy.GetTaxonomyTermResponseDto, error)
+}
+
+type getTaxonomyTermByIDHandler struct {
+	log          zap.Logger
+	taxonomyRepo repository.TaxonomyRepository
+}
+
+func NewGetTaxonomyTermByIDHandler(log zap.Logger, taxonomyRepo repository.TaxonomyRepository) *getTaxonomyTermByIDHandler {
+	return &getTaxonomyTermByIDHandler{log: log, taxonomyRepo: taxonomyRepo}
+}
+
+func (q *getTaxonomyTermByIDHandler) Handle(ctx context.Context, query *GetTaxonomyTermByIDQuery) (*taxonomy.GetTaxonomyTermResponseDto, error) {
+	term, err := q.taxonomyRepo.GetByID(ctx, query.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := mappers.GetTaxonomyTermFromModel(term)
+
+	return &res, nil
+}
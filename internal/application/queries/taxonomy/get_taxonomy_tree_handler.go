@@ -0,0 +1,35 @@
+package taxonomy
+
+import (
+	"context"
+	"gallery-service/internal/application/dto/responses/taxonomy"
+	"gallery-service/internal/application/mappers"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/pkg/zap"
+)
+
+type GetTaxonomyTreeQueryHandler interface {
+	Handle(ctx context.Context, query *GetTaxonomyQuery) (*taxonomy.GetTaxonomyTreeResponseDto, error)
+}
+
+type getTaxonomyTreeHandler struct {
+	log          zap.Logger
+	taxonomyRepo repository.TaxonomyRepository
+}
+
+func NewGetTaxonomyTreeHandler(log zap.Logger, taxonomyRepo repository.TaxonomyRepository) *getTaxonomyTreeHandler {
+	return &getTaxonomyTreeHandler{log: log, taxonomyRepo: taxonomyRepo}
+}
+
+// Handle nests the whole taxonomy, or the trees of one kind. Curricula stay small enough
+// to be read in one go, unlike folder trees.
+func (q *getTaxonomyTreeHandler) Handle(ctx context.Context, query *GetTaxonomyQuery) (*taxonomy.GetTaxonomyTreeResponseDto, error) {
+	terms, err := q.taxonomyRepo.GetAll(ctx, query.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	return &taxonomy.GetTaxonomyTreeResponseDto{
+		Terms: mappers.GetTaxonomyTreeFromModels(terms),
+	}, nil
+}
@@ -0,0 +1,38 @@
+package taxonomy
+
+import "gallery-service/internal/pkg/constants"
+
+type Queries struct {
+	GetAllTerms GetAllTaxonomyTermsQueryHandler
+	GetTermByID GetTaxonomyTermByIDQueryHandler
+	GetTree     GetTaxonomyTreeQueryHandler
+}
+
+func NewTaxonomyQueries(
+	getAllTerms GetAllTaxonomyTermsQueryHandler,
+	getTermByID GetTaxonomyTermByIDQueryHandler,
+	getTree GetTaxonomyTreeQueryHandler,
+) *Queries {
+	return &Queries{
+		GetAllTerms: getAllTerms,
+		GetTermByID: getTermByID,
+		GetTree:     getTree,
+	}
+}
+
+// GetTaxonomyQuery lists the terms of Kind, or of every kind when Kind is empty
+type GetTaxonomyQuery struct {
+	Kind constants.TaxonomyKind
+}
+
+func NewGetTaxonomyQuery(kind constants.TaxonomyKind) *GetTaxonomyQuery {
+	return &GetTaxonomyQuery{Kind: kind}
+}
+
+type GetTaxonomyTermByIDQuery struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func NewGetTaxonomyTermByIDQuery(id string) *GetTaxonomyTermByIDQuery {
+	return &GetTaxonomyTermByIDQuery{ID: id}
+}
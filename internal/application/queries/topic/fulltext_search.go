@@ -2,6 +2,7 @@ package topic
 
 import (
 	"context"
+	"gallery-service/internal/application/classification"
 	"gallery-service/internal/application/dto/responses/topic"
 	"gallery-service/internal/domain/repository"
 	"gallery-service/pkg/zap"
@@ -14,15 +15,22 @@ type SearchTopicsQueryHandler interface {
 type searchTopicsHandler struct {
 	log            zap.Logger
 	taskRepository repository.TopicRepository
+	taxonomyRepo   repository.TaxonomyRepository
 }
 
-func NewSearchTopicsHandler(log zap.Logger, taskRepository repository.TopicRepository) *searchTopicsHandler {
-	return &searchTopicsHandler{log: log, taskRepository: taskRepository}
+func NewSearchTopicsHandler(log zap.Logger, taskRepository repository.TopicRepository, taxonomyRepo repository.TaxonomyRepository) *searchTopicsHandler {
+	return &searchTopicsHandler{log: log, taskRepository: taskRepository, taxonomyRepo: taxonomyRepo}
 }
 
 func (s *searchTopicsHandler) Handle(ctx context.Context, command *SearchTopicsQuery) (*topic.GetAllTopicResponseDto, error) {
+	subtrees, err := classification.Subtrees(ctx, s.taxonomyRepo, command.Terms)
+	if err != nil {
+		return nil, err
+	}
+
 	query := make(map[string]interface{})
 	query["keyword"] = command.Keyword
+	query["taxonomy_terms"] = subtrees
 
 	return s.taskRepository.Search(ctx, query, command.Pq)
 }
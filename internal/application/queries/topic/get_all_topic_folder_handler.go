@@ -2,25 +2,118 @@ package topic
 
 import (
 	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/application/dto/responses"
 	"gallery-service/internal/application/dto/responses/topic"
+	"gallery-service/internal/application/mappers"
 	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
 	"gallery-service/pkg/utils"
 	"gallery-service/pkg/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 type GetAllTopicFolderQueryHandler interface {
-	Handle(ctx context.Context, command *GetFolderID, pq *utils.Pagination) (*topic.GetAllTopicResponseDto, error)
+	Handle(ctx context.Context, query *GetFolderID, pq *utils.Pagination) (*topic.GetAllTopicResponseDto, error)
+	Handle4App(ctx context.Context, query *GetFolderID, pq *utils.Pagination) (*topic.GetPublishedTopicsResponseDto, error)
 }
 
 type getTopicFolderHandler struct {
-	log       zap.Logger
-	topicRepo repository.TopicRepository
+	log          zap.Logger
+	topicRepo    repository.TopicRepository
+	folderRepo   repository.FolderRepository
+	folderAccess access.FolderAccess
+}
+
+func NewGetAllTopicFolderHandler(
+	log zap.Logger,
+	topicRepo repository.TopicRepository,
+	folderRepo repository.FolderRepository,
+	folderAccess access.FolderAccess,
+) *getTopicFolderHandler {
+	return &getTopicFolderHandler{log: log, topicRepo: topicRepo, folderRepo: folderRepo, folderAccess: folderAccess}
+}
+
+func (q *getTopicFolderHandler) Handle(ctx context.Context, query *GetFolderID, pq *utils.Pagination) (*topic.GetAllTopicResponseDto, error) {
+	folderIDs, err := q.folderIDs(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	topics, total, err := q.topicRepo.GetAllByFolderIDs(ctx, folderIDs, false, pq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &topic.GetAllTopicResponseDto{
+		Pagination: pagination(pq, total, len(topics)),
+		Topics:     mappers.GetTopicsFromModels(topics),
+	}, nil
+}
+
+// Handle4App lists the published topics of the folder, each in the first language of the
+// query it has
+func (q *getTopicFolderHandler) Handle4App(ctx context.Context, query *GetFolderID, pq *utils.Pagination) (*topic.GetPublishedTopicsResponseDto, error) {
+	folderIDs, err := q.folderIDs(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	topics, total, err := q.topicRepo.GetAllByFolderIDs(ctx, folderIDs, true, pq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &topic.GetPublishedTopicsResponseDto{
+		Pagination: pagination(pq, total, len(topics)),
+		Topics:     mappers.GetPublishedTopicsFromModels(topics, query.Languages),
+	}, nil
 }
 
-func NewGetAllClusterFolderHandler(log zap.Logger, topicRepo repository.TopicRepository) *getTopicFolderHandler {
-	return &getTopicFolderHandler{log: log, topicRepo: topicRepo}
+// folderIDs returns the folder and, when the query is recursive, the folders below it
+// that the current user can read. Subfolders may be closed off by their own grants, so
+// they are left out before paging rather than after.
+func (q *getTopicFolderHandler) folderIDs(ctx context.Context, query *GetFolderID) ([]primitive.ObjectID, error) {
+	folder, err := q.folderRepo.GetByID(ctx, query.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := q.folderAccess.Require(ctx, folder, constants.FolderPermissionRead); err != nil {
+		return nil, err
+	}
+
+	folderIDs := []primitive.ObjectID{folder.ID}
+	if !query.Recursive {
+		return folderIDs, nil
+	}
+
+	descendants, err := q.folderRepo.GetDescendantIDs(ctx, query.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	readable, err := q.folderAccess.Readable(ctx, descendants)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range descendants {
+		if readable[id] {
+			folderIDs = append(folderIDs, id)
+		}
+	}
+
+	return folderIDs, nil
 }
 
-// func (q *getTopicFolderHandler) Handle(ctx context.Context, command *GetFolderID, pq *utils.Pagination) (*topic.GetAllTopicResponseDto, error) {
-// 	return q.topicRepo.GetAllByFolderID(ctx, command.ID, pq)
-// }
+func pagination(pq *utils.Pagination, total int64, count int) responses.Pagination {
+	return responses.Pagination{
+		TotalCount: total,
+		TotalPages: int64(pq.GetTotalPages(int(total))),
+		Page:       int64(pq.GetPage()),
+		Size:       int64(pq.GetSize()),
+		HasMore:    int64(pq.GetOffset()+count) < total,
+	}
+}
@@ -2,7 +2,9 @@ package topic
 
 import (
 	"context"
+	"gallery-service/internal/application/dto/responses"
 	"gallery-service/internal/application/dto/responses/topic"
+	"gallery-service/internal/application/mappers"
 	"gallery-service/internal/domain/repository"
 	"gallery-service/pkg/utils"
 	"gallery-service/pkg/zap"
@@ -10,8 +12,7 @@ import (
 
 type GetAllTopicQueryHandler interface {
 	Handle(ctx context.Context, pq *utils.Pagination) ([]topic.GetTopicResponseDto, error)
-	Handle4App(ctx context.Context) ([]topic.TopicForAppResponseDto, error)
-	Handle4Gateway(ctx context.Context, pq *utils.Pagination) ([]topic.GetTopicResponseDto, error)
+	Handle4App(ctx context.Context, query *GetPublishedTopicsQuery) (*topic.GetPublishedTopicsResponseDto, error)
 }
 
 type getTopicHandler struct {
@@ -27,10 +28,22 @@ func (q *getTopicHandler) Handle(ctx context.Context, pq *utils.Pagination) ([]t
 	return q.topicRepo.GetAll(ctx, pq)
 }
 
-func (q *getTopicHandler) Handle4App(ctx context.Context) ([]topic.TopicForAppResponseDto, error) {
-	return q.topicRepo.GetAll4App(ctx)
-}
+// Handle4App reads a page of the published topics, each in the first language of the
+// query it has. It serves users and the gateway alike.
+func (q *getTopicHandler) Handle4App(ctx context.Context, query *GetPublishedTopicsQuery) (*topic.GetPublishedTopicsResponseDto, error) {
+	topics, total, err := q.topicRepo.GetPublished(ctx, query.Sort, query.Descending, query.Pq)
+	if err != nil {
+		return nil, err
+	}
 
-func (q *getTopicHandler) Handle4Gateway(ctx context.Context, pq *utils.Pagination) ([]topic.GetTopicResponseDto, error) {
-	return q.topicRepo.GetAll(ctx, pq)
+	return &topic.GetPublishedTopicsResponseDto{
+		Pagination: responses.Pagination{
+			TotalCount: total,
+			TotalPages: int64(query.Pq.GetTotalPages(int(total))),
+			Page:       int64(query.Pq.GetPage()),
+			Size:       int64(query.Pq.GetSize()),
+			HasMore:    int64(query.Pq.GetOffset()+len(topics)) < total,
+		},
+		Topics: mappers.GetPublishedTopicsFromModels(topics, query.Languages),
+	}, nil
 }
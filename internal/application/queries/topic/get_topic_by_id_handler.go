@@ -6,12 +6,15 @@ import (
 	"gallery-service/internal/application/mappers"
 	"gallery-service/internal/domain/models"
 	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
 	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
 )
 
 type GetTopicByIDQueryHandler interface {
 	Handle(ctx context.Context, command *GetTopicByIDQuery) (*models.Topic, error)
-	Handle4Gateway(ctx context.Context, command *GetTopicByIDQuery) (*topic.Topic4GatwayResponseDto, error)
+	Handle4App(ctx context.Context, command *GetLocalizedTopicQuery) (*topic.LocalizedTopicResponseDto, error)
 }
 
 type getTopicByIDHandler struct {
@@ -27,11 +30,17 @@ func (q *getTopicByIDHandler) Handle(ctx context.Context, query *GetTopicByIDQue
 	return q.taskRepo.GetByID(ctx, query.ID)
 }
 
-func (q *getTopicByIDHandler) Handle4Gateway(ctx context.Context, query *GetTopicByIDQuery) (*topic.Topic4GatwayResponseDto, error) {
-
+// Handle4App reads the topic in the first language of the query it has, for users and the
+// gateway alike. Topics that are not published are not found.
+func (q *getTopicByIDHandler) Handle4App(ctx context.Context, query *GetLocalizedTopicQuery) (*topic.LocalizedTopicResponseDto, error) {
 	topic, err := q.taskRepo.GetByID(ctx, query.ID)
 	if err != nil {
 		return nil, err
 	}
-	return mappers.GetTopic4GatewayFromModel(topic), nil
+
+	if topic.WorkflowStatus() != constants.TopicStatusPublished {
+		return nil, errors.Errorf("topic %s not found", query.ID)
+	}
+
+	return mappers.GetLocalizedTopicFromModel(topic, query.Languages), nil
 }
@@ -0,0 +1,76 @@
+package topic
+
+import (
+	"context"
+	revisionDto "gallery-service/internal/application/dto/responses/revision"
+	"gallery-service/internal/application/revision"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/pkg/zap"
+)
+
+type GetTopicRevisionDiffQueryHandler interface {
+	Handle(ctx context.Context, query *GetTopicRevisionDiffQuery) (*revisionDto.RevisionDiffResponseDto, error)
+}
+
+type getTopicRevisionDiffHandler struct {
+	log          zap.Logger
+	topicRepo    repository.TopicRepository
+	revisionRepo repository.RevisionRepository
+}
+
+func NewGetTopicRevisionDiffHandler(
+	log zap.Logger,
+	topicRepo repository.TopicRepository,
+	revisionRepo repository.RevisionRepository,
+) *getTopicRevisionDiffHandler {
+	return &getTopicRevisionDiffHandler{log: log, topicRepo: topicRepo, revisionRepo: revisionRepo}
+}
+
+// Handle compares revision From with revision To, or with the current topic when To is 0
+func (q *getTopicRevisionDiffHandler) Handle(ctx context.Context, query *GetTopicRevisionDiffQuery) (*revisionDto.RevisionDiffResponseDto, error) {
+	topic, err := q.topicRepo.GetByID(ctx, query.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := q.snapshot(ctx, topic, query.From)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := q.snapshot(ctx, topic, query.To)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := revision.Diff(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &revisionDto.RevisionDiffResponseDto{
+		From:    query.From,
+		To:      query.To,
+		Changes: changes,
+	}, nil
+}
+
+func (q *getTopicRevisionDiffHandler) snapshot(ctx context.Context, topic *models.Topic, number int64) (*models.Topic, error) {
+	if number == 0 {
+		return topic, nil
+	}
+
+	r, err := q.revisionRepo.GetByNumber(ctx, constants.RevisionEntityTopic, topic.ID, number)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshot models.Topic
+	if err := revision.Decode(r, &snapshot); err != nil {
+		return nil, err
+	}
+
+	return &snapshot, nil
+}
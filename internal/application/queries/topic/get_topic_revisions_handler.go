@@ -0,0 +1,55 @@
+package topic
+
+import (
+	"context"
+	revisionDto "gallery-service/internal/application/dto/responses/revision"
+	"gallery-service/internal/application/mappers"
+	"gallery-service/internal/application/revision"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/pkg/zap"
+)
+
+type GetTopicRevisionsQueryHandler interface {
+	Handle(ctx context.Context, query *GetTopicByIDQuery) (*revisionDto.GetAllRevisionResponseDto, error)
+}
+
+type getTopicRevisionsHandler struct {
+	log          zap.Logger
+	topicRepo    repository.TopicRepository
+	revisionRepo repository.RevisionRepository
+}
+
+func NewGetTopicRevisionsHandler(
+	log zap.Logger,
+	topicRepo repository.TopicRepository,
+	revisionRepo repository.RevisionRepository,
+) *getTopicRevisionsHandler {
+	return &getTopicRevisionsHandler{log: log, topicRepo: topicRepo, revisionRepo: revisionRepo}
+}
+
+func (q *getTopicRevisionsHandler) Handle(ctx context.Context, query *GetTopicByIDQuery) (*revisionDto.GetAllRevisionResponseDto, error) {
+	topic, err := q.topicRepo.GetByID(ctx, query.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := q.revisionRepo.GetAll(ctx, constants.RevisionEntityTopic, topic.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]revisionDto.GetRevisionResponseDto, 0, len(revisions))
+	for _, r := range revisions {
+		var snapshot models.Topic
+		if err := revision.Decode(r, &snapshot); err != nil {
+			q.log.Errorf("(GetTopicRevisionsQueryHandler.Handle) revision: {%d}, err: {%v}", r.Number, err)
+			return nil, err
+		}
+
+		res = append(res, mappers.GetRevisionFromModel(r, &snapshot))
+	}
+
+	return &revisionDto.GetAllRevisionResponseDto{Revisions: res}, nil
+}
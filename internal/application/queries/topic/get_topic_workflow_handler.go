@@ -0,0 +1,31 @@
+package topic
+
+import (
+	"context"
+	topicResponses "gallery-service/internal/application/dto/responses/topic"
+	"gallery-service/internal/application/mappers"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/pkg/zap"
+)
+
+type GetTopicWorkflowQueryHandler interface {
+	Handle(ctx context.Context, query *GetTopicByIDQuery) (*topicResponses.GetTopicWorkflowResponseDto, error)
+}
+
+type getTopicWorkflowHandler struct {
+	log       zap.Logger
+	topicRepo repository.TopicRepository
+}
+
+func NewGetTopicWorkflowHandler(log zap.Logger, topicRepo repository.TopicRepository) *getTopicWorkflowHandler {
+	return &getTopicWorkflowHandler{log: log, topicRepo: topicRepo}
+}
+
+func (q *getTopicWorkflowHandler) Handle(ctx context.Context, query *GetTopicByIDQuery) (*topicResponses.GetTopicWorkflowResponseDto, error) {
+	topic, err := q.topicRepo.GetByID(ctx, query.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return mappers.GetTopicWorkflowFromModel(topic), nil
+}
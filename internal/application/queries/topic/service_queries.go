@@ -1,6 +1,7 @@
 package topic
 
 import (
+	"gallery-service/internal/pkg/constants"
 	"gallery-service/pkg/utils"
 )
 
@@ -9,19 +10,28 @@ type Queries struct {
 	GetTopicByID      GetTopicByIDQueryHandler
 	SearchTopics      SearchTopicsQueryHandler
 	GetAllTopicFolder GetAllTopicFolderQueryHandler
+	GetRevisions      GetTopicRevisionsQueryHandler
+	GetRevisionDiff   GetTopicRevisionDiffQueryHandler
+	GetWorkflow       GetTopicWorkflowQueryHandler
 }
 
 func NewTopicQueries(
 	getAllTopic GetAllTopicQueryHandler,
 	getTopicByID GetTopicByIDQueryHandler,
 	searchTopics SearchTopicsQueryHandler,
-	//getTopicFolder GetAllTopicFolderQueryHandler,
+	getTopicFolder GetAllTopicFolderQueryHandler,
+	getRevisions GetTopicRevisionsQueryHandler,
+	getRevisionDiff GetTopicRevisionDiffQueryHandler,
+	getWorkflow GetTopicWorkflowQueryHandler,
 ) *Queries {
 	return &Queries{
-		GetAllTopic:  getAllTopic,
-		GetTopicByID: getTopicByID,
-		SearchTopics: searchTopics,
-		//GetAllTopicFolder: getTopicFolder,
+		GetAllTopic:       getAllTopic,
+		GetTopicByID:      getTopicByID,
+		SearchTopics:      searchTopics,
+		GetAllTopicFolder: getTopicFolder,
+		GetRevisions:      getRevisions,
+		GetRevisionDiff:   getRevisionDiff,
+		GetWorkflow:       getWorkflow,
 	}
 }
 
@@ -33,25 +43,67 @@ func NewGetTopicByIDQuery(ID string) *GetTopicByIDQuery {
 	return &GetTopicByIDQuery{ID: ID}
 }
 
+// GetLocalizedTopicQuery reads a topic in the first of Languages it has
+type GetLocalizedTopicQuery struct {
+	ID        string               `json:"id" validate:"required"`
+	Languages []constants.Language `json:"languages"`
+}
+
+func NewGetLocalizedTopicQuery(id string, languages []constants.Language) *GetLocalizedTopicQuery {
+	return &GetLocalizedTopicQuery{ID: id, Languages: languages}
+}
+
+// GetPublishedTopicsQuery lists a page of the published topics in the first of Languages
+// each has, ordered by Sort
+type GetPublishedTopicsQuery struct {
+	Languages  []constants.Language `json:"languages"`
+	Sort       string               `json:"sort" validate:"omitempty,oneof=position topic_name created_at updated_at"`
+	Descending bool                 `json:"descending"`
+	Pq         *utils.Pagination    `json:"pq" validate:"required"`
+}
+
+func NewGetPublishedTopicsQuery(languages []constants.Language, sort string, descending bool, pq *utils.Pagination) *GetPublishedTopicsQuery {
+	return &GetPublishedTopicsQuery{Languages: languages, Sort: sort, Descending: descending, Pq: pq}
+}
+
+type GetTopicRevisionDiffQuery struct {
+	ID   string `json:"id" validate:"required"`
+	From int64  `json:"from" validate:"required,gt=0"`
+	To   int64  `json:"to" validate:"gte=0"`
+}
+
+func NewGetTopicRevisionDiffQuery(id string, from int64, to int64) *GetTopicRevisionDiffQuery {
+	return &GetTopicRevisionDiffQuery{ID: id, From: from, To: to}
+}
+
+// GetFolderID lists the topics filed in a folder, and in the folders below it when
+// Recursive. Languages is only used by the apps, which read the topics localized.
 type GetFolderID struct {
-	ID string `json:"folder_id" validate:"required"`
+	ID        string               `json:"folder_id" validate:"required"`
+	Recursive bool                 `json:"recursive"`
+	Languages []constants.Language `json:"languages"`
 }
 
-func NewGetFolderID(ID string) *GetFolderID {
-	return &GetFolderID{ID: ID}
+func NewGetFolderID(ID string, recursive bool, languages []constants.Language) *GetFolderID {
+	return &GetFolderID{ID: ID, Recursive: recursive, Languages: languages}
 }
 
+// SearchTopicsQuery matches Keyword against the topics classified under every one of the
+// taxonomy Terms
 type SearchTopicsQuery struct {
 	Keyword string
+	Terms   []string
 	Pq      *utils.Pagination
 }
 
 func NewSearchTopicsQuery(
 	keyword string,
+	terms []string,
 	pq *utils.Pagination,
 ) *SearchTopicsQuery {
 	return &SearchTopicsQuery{
 		Keyword: keyword,
+		Terms:   terms,
 		Pq:      pq,
 	}
 }
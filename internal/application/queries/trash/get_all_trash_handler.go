@@ -0,0 +1,99 @@
+package trash
+
+import (
+	"context"
+	"gallery-service/internal/application/access"
+	"gallery-service/internal/application/dto/responses/trash"
+	"gallery-service/internal/application/mappers"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/pkg/zap"
+	"sort"
+	"time"
+)
+
+type GetAllTrashQueryHandler interface {
+	Handle(ctx context.Context, query *GetAllTrashQuery) (*trash.GetAllTrashResponseDto, error)
+}
+
+type getAllTrashHandler struct {
+	log         zap.Logger
+	folderRepo  repository.FolderRepository
+	clusterRepo repository.ClusterRepository
+	topicRepo   repository.TopicRepository
+	trashAccess access.TrashAccess
+	retention   time.Duration
+}
+
+func NewGetAllTrashHandler(
+	log zap.Logger,
+	folderRepo repository.FolderRepository,
+	clusterRepo repository.ClusterRepository,
+	topicRepo repository.TopicRepository,
+	trashAccess access.TrashAccess,
+	retention time.Duration,
+) *getAllTrashHandler {
+	return &getAllTrashHandler{
+		log:         log,
+		folderRepo:  folderRepo,
+		clusterRepo: clusterRepo,
+		topicRepo:   topicRepo,
+		trashAccess: trashAccess,
+		retention:   retention,
+	}
+}
+
+// Handle returns the items the current user could restore, most recently deleted first
+func (q *getAllTrashHandler) Handle(ctx context.Context, query *GetAllTrashQuery) (*trash.GetAllTrashResponseDto, error) {
+	itemType := constants.TrashItemType(query.Type)
+	items := make([]trash.TrashItemDto, 0)
+
+	if itemType == "" || itemType == constants.TrashItemFolder {
+		folders, err := q.folderRepo.GetDeleted(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		folders, err = q.trashAccess.Folders(ctx, folders)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, f := range folders {
+			items = append(items, mappers.GetTrashItemFromFolder(f, q.retention))
+		}
+	}
+
+	if itemType == "" || itemType == constants.TrashItemCluster {
+		clusters, err := q.clusterRepo.GetDeleted(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		clusters, err = q.trashAccess.Clusters(ctx, clusters)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, c := range clusters {
+			items = append(items, mappers.GetTrashItemFromCluster(c, q.retention))
+		}
+	}
+
+	if (itemType == "" || itemType == constants.TrashItemTopic) && q.trashAccess.RequireTopic(ctx) == nil {
+		topics, err := q.topicRepo.GetDeleted(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, t := range topics {
+			items = append(items, mappers.GetTrashItemFromTopic(t, q.retention))
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].DeletedAt.After(items[j].DeletedAt)
+	})
+
+	return &trash.GetAllTrashResponseDto{Items: items}, nil
+}
@@ -0,0 +1,18 @@
+package trash
+
+type Queries struct {
+	GetAllTrash GetAllTrashQueryHandler
+}
+
+func NewTrashQueries(getAllTrash GetAllTrashQueryHandler) *Queries {
+	return &Queries{GetAllTrash: getAllTrash}
+}
+
+// GetAllTrashQuery lists the trash, or only the items of Type when it is set
+type GetAllTrashQuery struct {
+	Type string `json:"type" validate:"omitempty,oneof=folder cluster topic"`
+}
+
+func NewGetAllTrashQuery(itemType string) *GetAllTrashQuery {
+	return &GetAllTrashQuery{Type: itemType}
+}
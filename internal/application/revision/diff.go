@@ -0,0 +1,78 @@
+package revision
+
+import (
+	"encoding/json"
+	revisionDto "gallery-service/internal/application/dto/responses/revision"
+	"reflect"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// ignoredFields change on every write or are managed elsewhere, so they are left out of diffs
+var ignoredFields = map[string]struct{}{
+	"updated_at":     {},
+	"position":       {},
+	"status":         {},
+	"status_history": {},
+	"is_published":   {},
+	"hidden":         {},
+	"publish_at":     {},
+	"unpublish_at":   {},
+}
+
+// Diff compares two versions of a document field by field, as they appear in the API.
+// Nested values such as language_config are compared and reported as a whole.
+func Diff(from, to interface{}) ([]revisionDto.FieldChangeDto, error) {
+	fromFields, err := fields(from)
+	if err != nil {
+		return nil, err
+	}
+
+	toFields, err := fields(to)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(fromFields)+len(toFields))
+	for name := range fromFields {
+		names = append(names, name)
+	}
+	for name := range toFields {
+		if _, ok := fromFields[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	changes := make([]revisionDto.FieldChangeDto, 0)
+	for _, name := range names {
+		if _, ok := ignoredFields[name]; ok {
+			continue
+		}
+
+		if !reflect.DeepEqual(fromFields[name], toFields[name]) {
+			changes = append(changes, revisionDto.FieldChangeDto{
+				Field: name,
+				From:  fromFields[name],
+				To:    toFields[name],
+			})
+		}
+	}
+
+	return changes, nil
+}
+
+func fields(document interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(document)
+	if err != nil {
+		return nil, errors.Wrap(err, "json.Marshal")
+	}
+
+	var res map[string]interface{}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, errors.Wrap(err, "json.Unmarshal")
+	}
+
+	return res, nil
+}
@@ -0,0 +1,68 @@
+package revision
+
+import (
+	"context"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/tenant"
+	"gallery-service/pkg/zap"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Recorder snapshots a cluster or topic before it is changed. Record belongs in the same
+// transaction as the change, so a failed change leaves no revision behind.
+type Recorder interface {
+	Record(ctx context.Context, entry Entry) (int64, error)
+}
+
+// Entry is the document about to be replaced and the kind of change replacing it
+type Entry struct {
+	EntityType     constants.RevisionEntity
+	EntityID       primitive.ObjectID
+	OrganizationID *string
+	Previous       interface{}
+	Action         constants.RevisionAction
+	RestoredFrom   *int64
+}
+
+type recorder struct {
+	log          zap.Logger
+	revisionRepo repository.RevisionRepository
+}
+
+func NewRecorder(log zap.Logger, revisionRepo repository.RevisionRepository) *recorder {
+	return &recorder{log: log, revisionRepo: revisionRepo}
+}
+
+func (r *recorder) Record(ctx context.Context, entry Entry) (int64, error) {
+	snapshot, err := bson.Marshal(entry.Previous)
+	if err != nil {
+		r.log.Errorf("(Recorder.Record) err: {%v}", err)
+		return 0, errors.Wrap(err, "bson.Marshal")
+	}
+
+	return r.revisionRepo.Insert(ctx, &models.Revision{
+		EntityType:     entry.EntityType,
+		EntityID:       entry.EntityID,
+		Snapshot:       snapshot,
+		Action:         entry.Action,
+		RestoredFrom:   entry.RestoredFrom,
+		ChangedBy:      tenant.FromContext(ctx).UserID(),
+		ChangedAt:      time.Now(),
+		OrganizationID: entry.OrganizationID,
+	})
+}
+
+// Decode reads the document stored in the revision into out
+func Decode(revision *models.Revision, out interface{}) error {
+	if err := bson.Unmarshal(revision.Snapshot, out); err != nil {
+		return errors.Wrap(err, "bson.Unmarshal")
+	}
+
+	return nil
+}
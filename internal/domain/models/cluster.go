@@ -2,22 +2,23 @@ package models
 
 import (
 	"gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/timecode"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"time"
 )
 
 type VideoConfig struct {
-	VideoKey  string `json:"video_key" bson:"video_key,omitempty"`
-	VideoURL  string `json:"video_url" bson:"video_url,omitempty"`
-	StartTime string `json:"start_time" bson:"start_time,omitempty"`
-	EndTime   string `json:"end_time" bson:"end_time,omitempty"`
+	VideoKey  string            `json:"video_key" bson:"video_key,omitempty"`
+	VideoURL  string            `json:"video_url" bson:"video_url,omitempty"`
+	StartTime timecode.Timecode `json:"start_time" bson:"start_time,omitempty"`
+	EndTime   timecode.Timecode `json:"end_time" bson:"end_time,omitempty"`
 }
 
 type AudioConfig struct {
-	AudioKey  string `json:"audio_key" bson:"audio_key,omitempty"`
-	AudioURL  string `json:"audio_url" bson:"audio_url,omitempty"`
-	StartTime string `json:"start_time" bson:"start_time,omitempty"`
-	EndTime   string `json:"end_time" bson:"end_time,omitempty"`
+	AudioKey  string            `json:"audio_key" bson:"audio_key,omitempty"`
+	AudioURL  string            `json:"audio_url" bson:"audio_url,omitempty"`
+	StartTime timecode.Timecode `json:"start_time" bson:"start_time,omitempty"`
+	EndTime   timecode.Timecode `json:"end_time" bson:"end_time,omitempty"`
 }
 
 type ImageConfig struct {
@@ -31,19 +32,38 @@ type LanguageConfig struct {
 	Audio    AudioConfig        `json:"audio" bson:"audio,omitempty"`
 }
 
+// Cluster is shown to users unless Hidden. PublishAt and UnpublishAt, when set, are when
+// the publish schedule shows and hides it.
 type Cluster struct {
-	ID             primitive.ObjectID `json:"id" bson:"_id,omitempty"`
-	ClusterName    string             `json:"cluster_name" bson:"cluster_name,omitempty"`
-	Title          string             `json:"title" bson:"title,omitempty"`
-	Note           string             `json:"note" bson:"note,omitempty"`
-	Image          ImageConfig        `json:"image" bson:"image,omitempty"`
-	LanguageConfig []LanguageConfig   `json:"language_config" bson:"language_config,omitempty"`
-	FolderID       primitive.ObjectID `json:"folder_id" bson:"folder_id,omitempty"`
-	CreatedAt      time.Time          `json:"created_at" bson:"created_at,omitempty"`
-	UpdatedAt      time.Time          `json:"updated_at" bson:"updated_at,omitempty"`
+	ID             primitive.ObjectID   `json:"id" bson:"_id,omitempty"`
+	ClusterName    string               `json:"cluster_name" bson:"cluster_name,omitempty"`
+	Title          string               `json:"title" bson:"title,omitempty"`
+	Note           string               `json:"note" bson:"note,omitempty"`
+	Image          ImageConfig          `json:"image" bson:"image,omitempty"`
+	LanguageConfig []LanguageConfig     `json:"language_config" bson:"language_config,omitempty"`
+	Tags           []string             `json:"tags" bson:"tags,omitempty"`
+	TaxonomyTerms  []primitive.ObjectID `json:"taxonomy_terms" bson:"taxonomy_terms,omitempty"`
+	FolderID       primitive.ObjectID   `json:"folder_id" bson:"folder_id,omitempty"`
+	Position       int64                `json:"position" bson:"position"`
+	Hidden         bool                 `json:"hidden" bson:"hidden,omitempty"`
+	PublishAt      *time.Time           `json:"publish_at,omitempty" bson:"publish_at,omitempty"`
+	UnpublishAt    *time.Time           `json:"unpublish_at,omitempty" bson:"unpublish_at,omitempty"`
+	OrganizationID *string              `json:"organization_id" bson:"organization_id"`
+	CreatedAt      time.Time            `json:"created_at" bson:"created_at,omitempty"`
+	UpdatedAt      time.Time            `json:"updated_at" bson:"updated_at,omitempty"`
+	DeletedAt      *time.Time           `json:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	DeletedBy      string               `json:"deleted_by,omitempty" bson:"deleted_by,omitempty"`
+	TrashID        *primitive.ObjectID  `json:"-" bson:"trash_id,omitempty"`
 }
 
 // GetName returns the name of the gallery
 func (c Cluster) GetName() string {
 	return "gallery"
 }
+
+// ClusterTagCount is how many clusters of a folder carry a tag
+type ClusterTagCount struct {
+	Tag      string             `bson:"tag"`
+	FolderID primitive.ObjectID `bson:"folder_id"`
+	Count    int64              `bson:"count"`
+}
@@ -1,13 +1,46 @@
 package models
 
-import "go.mongodb.org/mongo-driver/bson/primitive"
+import (
+	"gallery-service/internal/pkg/constants"
+	"time"
 
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Folder keeps its ancestor ids, ordered from the root down to its parent, so that
+// subtree and breadcrumb queries don't have to walk parent_id one level at a time.
+// A nil OrganizationID marks global content shared by every organization. ACL holds the
+// grants set on this folder only, the grants of the ancestors apply to it as well.
+// A deleted folder stays in the trash with the subtree and clusters deleted with it,
+// all sharing the id of the folder as TrashID.
 type Folder struct {
-	ID                 primitive.ObjectID  `json:"id" bson:"_id,omitempty"`
-	FolderName         string              `json:"folder_name" bson:"folder_name,omitempty"`
-	FolderThumbnailKey string              `json:"folder_thumbnail_key" bson:"folder_thumbnail_key,omitempty"`
-	FolderThumbnailURL string              `json:"folder_thumbnail_url" bson:"folder_thumbnail_url,omitempty"`
-	ParentID           *primitive.ObjectID `json:"parent_id" bson:"parent_id,omitempty"`
+	ID                 primitive.ObjectID   `json:"id" bson:"_id,omitempty"`
+	FolderName         string               `json:"folder_name" bson:"folder_name,omitempty"`
+	FolderThumbnailKey string               `json:"folder_thumbnail_key" bson:"folder_thumbnail_key,omitempty"`
+	FolderThumbnailURL string               `json:"folder_thumbnail_url" bson:"folder_thumbnail_url,omitempty"`
+	ParentID           *primitive.ObjectID  `json:"parent_id" bson:"parent_id,omitempty"`
+	Ancestors          []primitive.ObjectID `json:"ancestors" bson:"ancestors"`
+	Depth              int                  `json:"depth" bson:"depth"`
+	Position           int64                `json:"position" bson:"position"`
+	OrganizationID     *string              `json:"organization_id" bson:"organization_id"`
+	ACL                []FolderGrant        `json:"acl" bson:"acl,omitempty"`
+	DeletedAt          *time.Time           `json:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	DeletedBy          string               `json:"deleted_by,omitempty" bson:"deleted_by,omitempty"`
+	TrashID            *primitive.ObjectID  `json:"-" bson:"trash_id,omitempty"`
+}
+
+// FolderGrant gives a user, or every user holding a role, a permission on a folder
+type FolderGrant struct {
+	UserID     string                     `json:"user_id,omitempty" bson:"user_id,omitempty"`
+	Role       string                     `json:"role,omitempty" bson:"role,omitempty"`
+	Permission constants.FolderPermission `json:"permission" bson:"permission"`
+}
+
+// PathFromRoot returns the ancestors of a folder placed directly under this one
+func (c Folder) PathFromRoot() []primitive.ObjectID {
+	path := make([]primitive.ObjectID, 0, len(c.Ancestors)+1)
+	path = append(path, c.Ancestors...)
+	return append(path, c.ID)
 }
 
 // GetName returns the name of the cluster
@@ -0,0 +1,62 @@
+package models
+
+import (
+	"gallery-service/internal/pkg/constants"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// GalleryLanguage is a language content can be written in. Name is the value the
+// language_config entries of clusters and topics are stored under, so it does not change
+// once the language is created. DisplayNames are the names shown for the language, by
+// the code of the language they are written in. A disabled language is no longer offered
+// and new entries cannot be written in it.
+type GalleryLanguage struct {
+	ID           primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	Code         string             `json:"code" bson:"code"`
+	Name         constants.Language `json:"name" bson:"name"`
+	DisplayNames map[string]string  `json:"display_names" bson:"display_names,omitempty"`
+	Position     int64              `json:"position" bson:"position"`
+	Enabled      bool               `json:"enabled" bson:"enabled"`
+	CreatedAt    time.Time          `json:"created_at" bson:"created_at,omitempty"`
+	UpdatedAt    time.Time          `json:"updated_at" bson:"updated_at,omitempty"`
+}
+
+// DisplayName returns the name of the language in the first of codes it has one in, or
+// its Name
+func (l GalleryLanguage) DisplayName(codes []string) string {
+	return displayName(l.DisplayNames, codes, l.Name.String())
+}
+
+// GalleryComponent is an app component a topic language is shown with, such as the video
+// player. Key is the value of the component of topic languages. Media is what a language
+// entry needs for the component. A disabled component is no longer offered and topics
+// cannot be saved with it.
+type GalleryComponent struct {
+	ID           primitive.ObjectID         `json:"id" bson:"_id,omitempty"`
+	Key          string                     `json:"key" bson:"key"`
+	Name         constants.Component        `json:"name" bson:"name"`
+	DisplayNames map[string]string          `json:"display_names" bson:"display_names,omitempty"`
+	Media        constants.MediaRequirement `json:"media" bson:"media"`
+	Position     int64                      `json:"position" bson:"position"`
+	Enabled      bool                       `json:"enabled" bson:"enabled"`
+	CreatedAt    time.Time                  `json:"created_at" bson:"created_at,omitempty"`
+	UpdatedAt    time.Time                  `json:"updated_at" bson:"updated_at,omitempty"`
+}
+
+// DisplayName returns the name of the component in the first of codes it has one in, or
+// its Name
+func (c GalleryComponent) DisplayName(codes []string) string {
+	return displayName(c.DisplayNames, codes, c.Name.String())
+}
+
+func displayName(names map[string]string, codes []string, name string) string {
+	for _, code := range codes {
+		if displayName, ok := names[code]; ok && displayName != "" {
+			return displayName
+		}
+	}
+
+	return name
+}
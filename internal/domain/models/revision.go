@@ -0,0 +1,24 @@
+package models
+
+import (
+	"gallery-service/internal/pkg/constants"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Revision keeps the full document of a cluster or topic as it was before a change,
+// along with who made the change and when. Numbers count up from 1 per document.
+type Revision struct {
+	ID             primitive.ObjectID       `json:"id" bson:"_id,omitempty"`
+	EntityType     constants.RevisionEntity `json:"entity_type" bson:"entity_type"`
+	EntityID       primitive.ObjectID       `json:"entity_id" bson:"entity_id"`
+	Number         int64                    `json:"number" bson:"number"`
+	Snapshot       bson.Raw                 `json:"-" bson:"snapshot"`
+	Action         constants.RevisionAction `json:"action" bson:"action"`
+	RestoredFrom   *int64                   `json:"restored_from,omitempty" bson:"restored_from,omitempty"`
+	ChangedBy      string                   `json:"changed_by" bson:"changed_by,omitempty"`
+	ChangedAt      time.Time                `json:"changed_at" bson:"changed_at"`
+	OrganizationID *string                  `json:"organization_id" bson:"organization_id"`
+}
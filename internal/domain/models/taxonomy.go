@@ -0,0 +1,31 @@
+package models
+
+import (
+	"gallery-service/internal/pkg/constants"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// TaxonomyTerm is a node of the curriculum taxonomy that clusters and topics are
+// classified with. Like folders, a term keeps its ancestor ids from the root down to its
+// parent, so that a filter on a term can take in every term below it. The taxonomy is
+// shared by every organization.
+type TaxonomyTerm struct {
+	ID        primitive.ObjectID     `json:"id" bson:"_id,omitempty"`
+	Name      string                 `json:"name" bson:"name"`
+	Kind      constants.TaxonomyKind `json:"kind" bson:"kind"`
+	ParentID  *primitive.ObjectID    `json:"parent_id" bson:"parent_id,omitempty"`
+	Ancestors []primitive.ObjectID   `json:"ancestors" bson:"ancestors"`
+	Depth     int                    `json:"depth" bson:"depth"`
+	Position  int64                  `json:"position" bson:"position"`
+	CreatedAt time.Time              `json:"created_at" bson:"created_at,omitempty"`
+	UpdatedAt time.Time              `json:"updated_at" bson:"updated_at,omitempty"`
+}
+
+// PathFromRoot returns the ancestors of a term placed directly under this one
+func (t TaxonomyTerm) PathFromRoot() []primitive.ObjectID {
+	path := make([]primitive.ObjectID, 0, len(t.Ancestors)+1)
+	path = append(path, t.Ancestors...)
+	return append(path, t.ID)
+}
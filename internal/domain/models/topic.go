@@ -2,34 +2,51 @@ package models
 
 import (
 	"gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/timecode"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+// TopicMediaItem is what the images, videos and audios of a topic language have in
+// common: an ID that stays the same across edits, and whether the item is the primary
+// one of its kind, the cover for images.
+type TopicMediaItem struct {
+	ID      primitive.ObjectID `json:"id" bson:"id,omitempty"`
+	Primary bool               `json:"primary" bson:"primary,omitempty"`
+}
+
+// Media returns the item, shared by the three kinds of media that embed it
+func (m *TopicMediaItem) Media() *TopicMediaItem {
+	return m
+}
+
 type TopicImageConfig struct {
-	PicName   string `json:"pic_name" bson:"pic_name,omitempty"`
-	ImageKey  string `json:"image_key" bson:"image_key,omitempty"`
-	ImageURL  string `json:"image_url" bson:"image_url,omitempty"`
-	OnlineURL string `json:"online_url" bson:"online_url,omitempty"`
+	TopicMediaItem `bson:",inline"`
+	PicName        string `json:"pic_name" bson:"pic_name,omitempty"`
+	ImageKey       string `json:"image_key" bson:"image_key,omitempty"`
+	ImageURL       string `json:"image_url" bson:"image_url,omitempty"`
+	OnlineURL      string `json:"online_url" bson:"online_url,omitempty"`
 }
 
 type TopicVideoConfig struct {
-	VideoName string `json:"video_name" bson:"video_name,omitempty"`
-	VideoKey  string `json:"video_key" bson:"video_key,omitempty"`
-	VideoURL  string `json:"video_url" bson:"video_url,omitempty"`
-	OnlineURL string `json:"online_url" bson:"online_url,omitempty"`
-	StartTime string `json:"start_time" bson:"start_time,omitempty"`
-	EndTime   string `json:"end_time" bson:"end_time,omitempty"`
+	TopicMediaItem `bson:",inline"`
+	VideoName      string            `json:"video_name" bson:"video_name,omitempty"`
+	VideoKey       string            `json:"video_key" bson:"video_key,omitempty"`
+	VideoURL       string            `json:"video_url" bson:"video_url,omitempty"`
+	OnlineURL      string            `json:"online_url" bson:"online_url,omitempty"`
+	StartTime      timecode.Timecode `json:"start_time" bson:"start_time,omitempty"`
+	EndTime        timecode.Timecode `json:"end_time" bson:"end_time,omitempty"`
 }
 
 type TopicAudioConfig struct {
-	AudioName string `json:"audio_name" bson:"audio_name,omitempty"`
-	AudioKey  string `json:"audio_key" bson:"audio_key,omitempty"`
-	AudioURL  string `json:"audio_url" bson:"audio_url,omitempty"`
-	OnlineURL string `json:"online_url" bson:"online_url,omitempty"`
-	StartTime string `json:"start_time" bson:"start_time,omitempty"`
-	EndTime   string `json:"end_time" bson:"end_time,omitempty"`
+	TopicMediaItem `bson:",inline"`
+	AudioName      string            `json:"audio_name" bson:"audio_name,omitempty"`
+	AudioKey       string            `json:"audio_key" bson:"audio_key,omitempty"`
+	AudioURL       string            `json:"audio_url" bson:"audio_url,omitempty"`
+	OnlineURL      string            `json:"online_url" bson:"online_url,omitempty"`
+	StartTime      timecode.Timecode `json:"start_time" bson:"start_time,omitempty"`
+	EndTime        timecode.Timecode `json:"end_time" bson:"end_time,omitempty"`
 }
 
 type TopicLanguageConfig struct {
@@ -43,16 +60,76 @@ type TopicLanguageConfig struct {
 	Audios      []TopicAudioConfig `json:"audios" bson:"audios,omitempty"`
 }
 
+// EnsureMediaIDs gives an ID to the images, videos and audios of the language that have
+// none yet, those stored before items had one or sent without one
+func (c *TopicLanguageConfig) EnsureMediaIDs() {
+	for i := range c.Images {
+		ensureMediaID(&c.Images[i].TopicMediaItem)
+	}
+	for i := range c.Videos {
+		ensureMediaID(&c.Videos[i].TopicMediaItem)
+	}
+	for i := range c.Audios {
+		ensureMediaID(&c.Audios[i].TopicMediaItem)
+	}
+}
+
+func ensureMediaID(item *TopicMediaItem) {
+	if item.ID.IsZero() {
+		item.ID = primitive.NewObjectID()
+	}
+}
+
+// Topic goes through the editorial workflow, Status being where it is now and
+// StatusHistory every transition that got it there. IsPublished predates the workflow
+// and is kept in step with Status for the readers of the old field. PublishAt and
+// UnpublishAt, when set, are when the publish schedule publishes and archives it.
+// FolderID files the topic in the folder tree, topics outside of any folder have none.
 type Topic struct {
 	ID             primitive.ObjectID    `json:"id" bson:"_id,omitempty"`
 	TopicName      string                `json:"topic_name" bson:"topic_name,omitempty"`
+	FolderID       primitive.ObjectID    `json:"folder_id" bson:"folder_id,omitempty"`
+	Status         constants.TopicStatus `json:"status" bson:"status,omitempty"`
+	StatusHistory  []TopicStatusChange   `json:"status_history,omitempty" bson:"status_history,omitempty"`
 	IsPublished    bool                  `json:"is_published" bson:"is_published,omitempty"`
+	PublishAt      *time.Time            `json:"publish_at,omitempty" bson:"publish_at,omitempty"`
+	UnpublishAt    *time.Time            `json:"unpublish_at,omitempty" bson:"unpublish_at,omitempty"`
 	LanguageConfig []TopicLanguageConfig `json:"language_config" bson:"language_config,omitempty"`
+	TaxonomyTerms  []primitive.ObjectID  `json:"taxonomy_terms" bson:"taxonomy_terms,omitempty"`
+	Position       int64                 `json:"position" bson:"position"`
+	OrganizationID *string               `json:"organization_id" bson:"organization_id"`
 	CreatedAt      time.Time             `json:"created_at" bson:"created_at,omitempty"`
 	UpdatedAt      time.Time             `json:"updated_at" bson:"updated_at,omitempty"`
+	DeletedAt      *time.Time            `json:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	DeletedBy      string                `json:"deleted_by,omitempty" bson:"deleted_by,omitempty"`
+	TrashID        *primitive.ObjectID   `json:"-" bson:"trash_id,omitempty"`
+}
+
+// TopicStatusChange is one transition of the editorial workflow, made by ActorID
+type TopicStatusChange struct {
+	Action  constants.TopicAction `json:"action" bson:"action"`
+	From    constants.TopicStatus `json:"from" bson:"from"`
+	To      constants.TopicStatus `json:"to" bson:"to"`
+	Comment string                `json:"comment,omitempty" bson:"comment,omitempty"`
+	ActorID string                `json:"actor_id" bson:"actor_id,omitempty"`
+	At      time.Time             `json:"at" bson:"at"`
 }
 
 // GetName returns the name of the gallery
 func (c Topic) GetName() string {
 	return "topic gallery"
 }
+
+// WorkflowStatus returns the status of the topic. Topics stored before the workflow have
+// none and are published or draft according to IsPublished.
+func (c Topic) WorkflowStatus() constants.TopicStatus {
+	if c.Status != "" {
+		return c.Status
+	}
+
+	if c.IsPublished {
+		return constants.TopicStatusPublished
+	}
+
+	return constants.TopicStatusDraft
+}
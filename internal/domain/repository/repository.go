@@ -6,37 +6,157 @@ import (
 	"gallery-service/internal/application/dto/responses/folder"
 	"gallery-service/internal/application/dto/responses/topic"
 	"gallery-service/internal/domain/models"
+	"gallery-service/internal/pkg/constants"
 	"gallery-service/pkg/utils"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+type TransactionManager interface {
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
 type ClusterRepository interface {
 	Insert(ctx context.Context, cluster *models.Cluster) (string, error)
+	InsertMany(ctx context.Context, clusters []*models.Cluster) error
 	Update(ctx context.Context, cluster *models.Cluster) error
-	GetAll(ctx context.Context, pq *utils.Pagination) (*cluster.GetAllClusterResponseDto, error)
-	GetAllByFolderID(ctx context.Context, folderID string, pq *utils.Pagination) (*cluster.GetAllClusterResponseDto, error)
+	Patch(ctx context.Context, clusterID primitive.ObjectID, set map[string]interface{}, unset []string) error
+	SetLanguage(ctx context.Context, clusterID primitive.ObjectID, config models.LanguageConfig) (bool, error)
+	RemoveLanguage(ctx context.Context, clusterID primitive.ObjectID, language constants.Language) (bool, error)
+	AddTaxonomyTerm(ctx context.Context, clusterID primitive.ObjectID, termID primitive.ObjectID) (bool, error)
+	RemoveTaxonomyTerm(ctx context.Context, clusterID primitive.ObjectID, termID primitive.ObjectID) (bool, error)
+	RemoveTaxonomyTerms(ctx context.Context, termIDs []primitive.ObjectID) (int64, error)
+	SetSchedule(ctx context.Context, clusterID primitive.ObjectID, publishAt *time.Time, unpublishAt *time.Time, hidden bool) (bool, error)
+	ApplySchedule(ctx context.Context, hidden bool, at time.Time) (int64, error)
+	GetAll(ctx context.Context, query map[string]interface{}, pq *utils.Pagination) (*cluster.GetAllClusterResponseDto, error)
+	GetAllByFolderIDs(ctx context.Context, folderIDs []primitive.ObjectID, visible bool, pq *utils.Pagination) (*cluster.GetAllClusterResponseDto, error)
 	GetByID(ctx context.Context, clusterID string) (*models.Cluster, error)
+	GetByFolderIDs(ctx context.Context, folderIDs []primitive.ObjectID) ([]*models.Cluster, error)
 	Search(ctx context.Context, query map[string]interface{}, pq *utils.Pagination) (*cluster.GetAllClusterResponseDto, error)
+	GetTagCounts(ctx context.Context, query map[string]interface{}) ([]models.ClusterTagCount, error)
+	GetByTag(ctx context.Context, tag string) ([]*models.Cluster, error)
+	RenameTag(ctx context.Context, clusterIDs []primitive.ObjectID, from string, to string) (int64, error)
 	Delete(ctx context.Context, clusterID string) (bool, error)
+	DeleteByFolderIDs(ctx context.Context, folderIDs []primitive.ObjectID, trashID primitive.ObjectID) (int64, error)
+	ChangeFolder(ctx context.Context, fromFolderID primitive.ObjectID, toFolderID primitive.ObjectID) (int64, error)
+	Move(ctx context.Context, clusterID primitive.ObjectID, folderID primitive.ObjectID, position int64) (bool, error)
+	GetNextPosition(ctx context.Context, folderID primitive.ObjectID) (int64, error)
+	Reorder(ctx context.Context, folderID primitive.ObjectID, clusterIDs []primitive.ObjectID) error
 	Exists(ctx context.Context, query map[string]interface{}) (bool, error)
+	ExistsAnywhere(ctx context.Context, query map[string]interface{}) (bool, error)
+	GetDeleted(ctx context.Context) ([]*models.Cluster, error)
+	GetDeletedByID(ctx context.Context, clusterID string) (*models.Cluster, error)
+	Restore(ctx context.Context, trashID primitive.ObjectID) (int64, error)
+	Purge(ctx context.Context, trashID primitive.ObjectID) (int64, error)
+	PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error)
 }
 
 type FolderRepository interface {
 	Insert(ctx context.Context, folder *models.Folder) (string, error)
+	InsertMany(ctx context.Context, folders []*models.Folder) error
 	Update(ctx context.Context, folder *models.Folder) error
-	GetAll(ctx context.Context, pq *utils.Pagination) (*folder.GetAllFolderResponseDto, error)
+	Patch(ctx context.Context, folderID primitive.ObjectID, set map[string]interface{}, unset []string) error
+	UpdateParent(ctx context.Context, folderID string, parentID *primitive.ObjectID, ancestors []primitive.ObjectID, position int64) error
+	GetAll(ctx context.Context, query map[string]interface{}, pq *utils.Pagination) (*folder.GetAllFolderResponseDto, error)
+	GetAllInScope(ctx context.Context) ([]*models.Folder, error)
 	GetByID(ctx context.Context, folderID string) (*models.Folder, error)
 	Search(ctx context.Context, query map[string]interface{}, pq *utils.Pagination) (*folder.GetAllFolderResponseDto, error)
+	GetTree(ctx context.Context, rootID string, maxDepth int) (*folder.GetFolderTreeResponseDto, error)
+	GetDescendantIDs(ctx context.Context, folderID string) ([]primitive.ObjectID, error)
+	GetDescendants(ctx context.Context, folderID string) ([]*models.Folder, error)
+	GetChildren(ctx context.Context, parentID *primitive.ObjectID) ([]*models.Folder, error)
+	GetPath(ctx context.Context, folderID string) (*folder.GetFolderPathResponseDto, error)
 	Delete(ctx context.Context, folderID string) (bool, error)
+	DeleteMany(ctx context.Context, folderIDs []primitive.ObjectID, trashID primitive.ObjectID) (int64, error)
+	ChangeParent(ctx context.Context, fromParentID primitive.ObjectID, toParentID *primitive.ObjectID) (int64, error)
+	RemoveAncestor(ctx context.Context, ancestorID primitive.ObjectID) (int64, error)
+	GetNextPosition(ctx context.Context, parentID *primitive.ObjectID) (int64, error)
+	GetByIDs(ctx context.Context, folderIDs []primitive.ObjectID) ([]*models.Folder, error)
+	SetGrant(ctx context.Context, folderID primitive.ObjectID, grant models.FolderGrant) error
+	RemoveGrant(ctx context.Context, folderID primitive.ObjectID, userID string, role string) (bool, error)
+	Reorder(ctx context.Context, parentID *primitive.ObjectID, folderIDs []primitive.ObjectID) error
 	Exists(ctx context.Context, query map[string]interface{}) (bool, error)
+	GetDeleted(ctx context.Context) ([]*models.Folder, error)
+	GetDeletedByID(ctx context.Context, folderID string) (*models.Folder, error)
+	Restore(ctx context.Context, trashID primitive.ObjectID) (int64, error)
+	Purge(ctx context.Context, trashID primitive.ObjectID) (int64, error)
+	PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error)
 }
 
 type TopicRepository interface {
 	Insert(ctx context.Context, topic *models.Topic) (string, error)
+	InsertMany(ctx context.Context, topics []*models.Topic) error
 	Update(ctx context.Context, topic *models.Topic) error
+	Patch(ctx context.Context, topicID primitive.ObjectID, set map[string]interface{}, unset []string) error
+	SetLanguage(ctx context.Context, topicID primitive.ObjectID, config models.TopicLanguageConfig) (bool, error)
+	ReplaceLanguage(ctx context.Context, topicID primitive.ObjectID, config models.TopicLanguageConfig, unchangedSince time.Time) (bool, error)
+	RemoveLanguage(ctx context.Context, topicID primitive.ObjectID, language constants.Language) (bool, error)
+	AddTaxonomyTerm(ctx context.Context, topicID primitive.ObjectID, termID primitive.ObjectID) (bool, error)
+	RemoveTaxonomyTerm(ctx context.Context, topicID primitive.ObjectID, termID primitive.ObjectID) (bool, error)
+	RemoveTaxonomyTerms(ctx context.Context, termIDs []primitive.ObjectID) (int64, error)
+	Transition(ctx context.Context, topicID primitive.ObjectID, change models.TopicStatusChange) (bool, error)
+	TransitionDue(ctx context.Context, change models.TopicStatusChange) (int64, error)
+	SetSchedule(ctx context.Context, topicID primitive.ObjectID, publishAt *time.Time, unpublishAt *time.Time) (bool, error)
 	GetAll(ctx context.Context, pq *utils.Pagination) ([]topic.GetTopicResponseDto, error)
 	GetByID(ctx context.Context, topicID string) (*models.Topic, error)
+	GetByIDs(ctx context.Context, topicIDs []primitive.ObjectID) ([]*models.Topic, error)
+	GetByNames(ctx context.Context, names []string) ([]*models.Topic, error)
+	GetByFolderIDs(ctx context.Context, folderIDs []primitive.ObjectID) ([]*models.Topic, error)
+	GetAllByFolderIDs(ctx context.Context, folderIDs []primitive.ObjectID, published bool, pq *utils.Pagination) ([]*models.Topic, int64, error)
 	Search(ctx context.Context, query map[string]interface{}, pq *utils.Pagination) (*topic.GetAllTopicResponseDto, error)
 	Delete(ctx context.Context, topicID string) (bool, error)
+	DeleteByFolderIDs(ctx context.Context, folderIDs []primitive.ObjectID, trashID primitive.ObjectID) (int64, error)
+	ChangeFolder(ctx context.Context, fromFolderID primitive.ObjectID, toFolderID *primitive.ObjectID) (int64, error)
+	Move(ctx context.Context, topicID primitive.ObjectID, folderID *primitive.ObjectID, position int64) (bool, error)
+	Exists(ctx context.Context, query map[string]interface{}) (bool, error)
+	ExistsAnywhere(ctx context.Context, query map[string]interface{}) (bool, error)
+	GetPublished(ctx context.Context, sort string, descending bool, pq *utils.Pagination) ([]*models.Topic, int64, error)
+	GetNextPosition(ctx context.Context, folderID *primitive.ObjectID) (int64, error)
+	Reorder(ctx context.Context, folderID *primitive.ObjectID, topicIDs []primitive.ObjectID) error
+	GetDeleted(ctx context.Context) ([]*models.Topic, error)
+	GetDeletedByID(ctx context.Context, topicID string) (*models.Topic, error)
+	Restore(ctx context.Context, trashID primitive.ObjectID) (int64, error)
+	Purge(ctx context.Context, trashID primitive.ObjectID) (int64, error)
+	PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+type TaxonomyRepository interface {
+	Insert(ctx context.Context, term *models.TaxonomyTerm) (string, error)
+	Update(ctx context.Context, term *models.TaxonomyTerm) error
+	UpdateParent(ctx context.Context, termID primitive.ObjectID, parentID *primitive.ObjectID, ancestors []primitive.ObjectID, position int64) error
+	GetAll(ctx context.Context, kind constants.TaxonomyKind) ([]*models.TaxonomyTerm, error)
+	GetByID(ctx context.Context, termID string) (*models.TaxonomyTerm, error)
+	GetByIDs(ctx context.Context, termIDs []primitive.ObjectID) ([]*models.TaxonomyTerm, error)
+	GetSubtreeIDs(ctx context.Context, termIDs []primitive.ObjectID) (map[primitive.ObjectID][]primitive.ObjectID, error)
+	GetDescendantIDs(ctx context.Context, termID primitive.ObjectID) ([]primitive.ObjectID, error)
+	GetNextPosition(ctx context.Context, parentID *primitive.ObjectID, kind constants.TaxonomyKind) (int64, error)
+	DeleteMany(ctx context.Context, termIDs []primitive.ObjectID) (int64, error)
 	Exists(ctx context.Context, query map[string]interface{}) (bool, error)
-	GetAll4App(ctx context.Context) ([]topic.TopicForAppResponseDto, error)
+}
+
+type RevisionRepository interface {
+	Insert(ctx context.Context, revision *models.Revision) (int64, error)
+	GetAll(ctx context.Context, entityType constants.RevisionEntity, entityID primitive.ObjectID) ([]*models.Revision, error)
+	GetByNumber(ctx context.Context, entityType constants.RevisionEntity, entityID primitive.ObjectID, number int64) (*models.Revision, error)
+}
+
+type LeaseRepository interface {
+	Acquire(ctx context.Context, name string, holder string, ttl time.Duration) (bool, error)
+	Release(ctx context.Context, name string, holder string) error
+}
+
+type RegistryRepository interface {
+	InsertLanguage(ctx context.Context, language *models.GalleryLanguage) (string, error)
+	UpdateLanguage(ctx context.Context, language *models.GalleryLanguage) error
+	GetLanguages(ctx context.Context) ([]*models.GalleryLanguage, error)
+	GetLanguageByCode(ctx context.Context, code string) (*models.GalleryLanguage, error)
+	DeleteLanguage(ctx context.Context, code string) (bool, error)
+	SeedLanguages(ctx context.Context, languages []*models.GalleryLanguage) (int64, error)
+	InsertComponent(ctx context.Context, component *models.GalleryComponent) (string, error)
+	UpdateComponent(ctx context.Context, component *models.GalleryComponent) error
+	GetComponents(ctx context.Context) ([]*models.GalleryComponent, error)
+	GetComponentByKey(ctx context.Context, key string) (*models.GalleryComponent, error)
+	DeleteComponent(ctx context.Context, key string) (bool, error)
+	SeedComponents(ctx context.Context, components []*models.GalleryComponent) (int64, error)
 }
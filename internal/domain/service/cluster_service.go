@@ -1,8 +1,11 @@
 package service
 
 import (
+	"gallery-service/internal/application/access"
 	clusterCommands "gallery-service/internal/application/commands/v1/cluster"
+	"gallery-service/internal/application/patch"
 	"gallery-service/internal/application/queries/cluster"
+	"gallery-service/internal/application/revision"
 	"gallery-service/internal/domain/repository"
 	"gallery-service/pkg/kafka"
 	"gallery-service/pkg/zap"
@@ -20,32 +23,65 @@ var (
 func NewClusterService(
 	cfg kafka.Config,
 	log zap.Logger,
+	val patch.Validator,
 	clusterRepo repository.ClusterRepository,
 	folderRepo repository.FolderRepository,
+	revisionRepo repository.RevisionRepository,
+	taxonomyRepo repository.TaxonomyRepository,
+	txManager repository.TransactionManager,
 ) *ClusterService {
 	if clusterService != nil {
 		return clusterService
 	}
 
-	createClusterHandler := clusterCommands.NewCreateClusterHandler(cfg, log, clusterRepo, folderRepo)
-	updateClusterHandler := clusterCommands.NewUpdateClusterHandler(log, clusterRepo, folderRepo)
-	deleteClusterHandler := clusterCommands.NewDeleteClusterHandler(log, clusterRepo)
+	folderAccess := access.NewFolderAccess(log, folderRepo)
+	recorder := revision.NewRecorder(log, revisionRepo)
 
-	getAllClusterHandler := cluster.NewGetAllClusterHandler(log, clusterRepo)
-	getClusterFolder := cluster.NewGetAllClusterFolderHandler(log, clusterRepo)
-	getClusterByIDHandler := cluster.NewGetClusterByIDHandler(log, clusterRepo)
-	searchClustersHandler := cluster.NewSearchClustersHandler(log, clusterRepo)
+	createClusterHandler := clusterCommands.NewCreateClusterHandler(cfg, log, clusterRepo, folderRepo, folderAccess)
+	updateClusterHandler := clusterCommands.NewUpdateClusterHandler(log, clusterRepo, folderRepo, folderAccess, recorder, txManager)
+	patchClusterHandler := clusterCommands.NewPatchClusterHandler(log, val, clusterRepo, folderRepo, folderAccess, recorder, txManager)
+	deleteClusterHandler := clusterCommands.NewDeleteClusterHandler(log, clusterRepo, folderRepo, folderAccess)
+	reorderClustersHandler := clusterCommands.NewReorderClustersHandler(log, clusterRepo, folderRepo, folderAccess, txManager)
+	moveClustersHandler := clusterCommands.NewMoveClustersHandler(log, clusterRepo, folderRepo, folderAccess, txManager)
+	restoreClusterRevisionHandler := clusterCommands.NewRestoreClusterRevisionHandler(log, clusterRepo, folderRepo, revisionRepo, folderAccess, recorder, txManager)
+	setClusterLanguageHandler := clusterCommands.NewSetClusterLanguageHandler(log, clusterRepo, folderRepo, folderAccess, recorder, txManager)
+	removeClusterLanguageHandler := clusterCommands.NewRemoveClusterLanguageHandler(log, clusterRepo, folderRepo, folderAccess, recorder, txManager)
+	renameClusterTagHandler := clusterCommands.NewRenameClusterTagHandler(log, clusterRepo, folderRepo, folderAccess)
+	addClusterTermHandler := clusterCommands.NewAddClusterTermHandler(log, clusterRepo, folderRepo, taxonomyRepo, folderAccess)
+	removeClusterTermHandler := clusterCommands.NewRemoveClusterTermHandler(log, clusterRepo, folderRepo, folderAccess)
+	scheduleClusterHandler := clusterCommands.NewScheduleClusterHandler(log, clusterRepo, folderRepo, folderAccess)
+
+	getAllClusterHandler := cluster.NewGetAllClusterHandler(log, clusterRepo, taxonomyRepo, folderAccess)
+	getClusterFolder := cluster.NewGetAllClusterFolderHandler(log, clusterRepo, folderRepo, folderAccess)
+	getClusterByIDHandler := cluster.NewGetClusterByIDHandler(log, clusterRepo, folderRepo, folderAccess)
+	searchClustersHandler := cluster.NewSearchClustersHandler(log, clusterRepo, taxonomyRepo, folderAccess)
+	getClusterRevisionsHandler := cluster.NewGetClusterRevisionsHandler(log, clusterRepo, folderRepo, revisionRepo, folderAccess)
+	getClusterRevisionDiffHandler := cluster.NewGetClusterRevisionDiffHandler(log, clusterRepo, folderRepo, revisionRepo, folderAccess)
+	getClusterTagsHandler := cluster.NewGetClusterTagsHandler(log, clusterRepo, folderAccess)
 
 	commands := clusterCommands.NewClusterCommands(
 		createClusterHandler,
 		updateClusterHandler,
+		patchClusterHandler,
 		deleteClusterHandler,
+		reorderClustersHandler,
+		moveClustersHandler,
+		restoreClusterRevisionHandler,
+		setClusterLanguageHandler,
+		removeClusterLanguageHandler,
+		renameClusterTagHandler,
+		addClusterTermHandler,
+		removeClusterTermHandler,
+		scheduleClusterHandler,
 	)
 	queries := cluster.NewClusterQueries(
 		getAllClusterHandler,
 		getClusterByIDHandler,
 		searchClustersHandler,
 		getClusterFolder,
+		getClusterRevisionsHandler,
+		getClusterRevisionDiffHandler,
+		getClusterTagsHandler,
 	)
 
 	clusterService = &ClusterService{Commands: commands, Queries: queries}
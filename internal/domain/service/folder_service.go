@@ -1,7 +1,9 @@
 package service
 
 import (
+	"gallery-service/internal/application/access"
 	folderCommands "gallery-service/internal/application/commands/v1/folder"
+	"gallery-service/internal/application/patch"
 	"gallery-service/internal/application/queries/folder"
 	"gallery-service/internal/domain/repository"
 	"gallery-service/pkg/kafka"
@@ -20,29 +22,58 @@ var (
 func NewFolderService(
 	cfg kafka.Config,
 	log zap.Logger,
+	val patch.Validator,
 	folderRepo repository.FolderRepository,
+	clusterRepo repository.ClusterRepository,
+	topicRepo repository.TopicRepository,
+	taxonomyRepo repository.TaxonomyRepository,
+	txManager repository.TransactionManager,
 ) *FolderService {
 	if folderService != nil {
 		return folderService
 	}
 
-	createFolderHandler := folderCommands.NewCreateFolderHandler(cfg, log, folderRepo)
-	updateFolderHandler := folderCommands.NewUpdateFolderHandler(log, folderRepo)
-	deleteFolderHandler := folderCommands.NewDeleteFolderHandler(log, folderRepo)
+	folderAccess := access.NewFolderAccess(log, folderRepo)
 
-	getAllFolderHandler := folder.NewGetAllFolderHandler(log, folderRepo)
-	getFolderByIDHandler := folder.NewGetFolderByIDHandler(log, folderRepo)
-	searchFoldersHandler := folder.NewSearchFoldersHandler(log, folderRepo)
+	createFolderHandler := folderCommands.NewCreateFolderHandler(cfg, log, folderRepo, folderAccess)
+	updateFolderHandler := folderCommands.NewUpdateFolderHandler(log, folderRepo, folderAccess)
+	patchFolderHandler := folderCommands.NewPatchFolderHandler(log, val, folderRepo, folderAccess)
+	deleteFolderHandler := folderCommands.NewDeleteFolderHandler(log, folderRepo, folderAccess, clusterRepo, topicRepo, txManager)
+	moveFolderHandler := folderCommands.NewMoveFolderHandler(log, folderRepo, folderAccess, txManager)
+	duplicateFolderHandler := folderCommands.NewDuplicateFolderHandler(log, folderRepo, folderAccess, clusterRepo, txManager)
+	reorderFoldersHandler := folderCommands.NewReorderFoldersHandler(log, folderRepo, folderAccess, txManager)
+	grantFolderAccessHandler := folderCommands.NewGrantFolderAccessHandler(log, folderRepo, folderAccess)
+	revokeFolderAccessHandler := folderCommands.NewRevokeFolderAccessHandler(log, folderRepo, folderAccess)
+	importFolderHandler := folderCommands.NewImportFolderHandler(log, folderRepo, folderAccess, clusterRepo, topicRepo, taxonomyRepo, txManager)
+
+	getAllFolderHandler := folder.NewGetAllFolderHandler(log, folderRepo, folderAccess)
+	getFolderByIDHandler := folder.NewGetFolderByIDHandler(log, folderRepo, folderAccess)
+	searchFoldersHandler := folder.NewSearchFoldersHandler(log, folderRepo, folderAccess)
+	getFolderTreeHandler := folder.NewGetFolderTreeHandler(log, folderRepo, folderAccess)
+	getFolderPathHandler := folder.NewGetFolderPathHandler(log, folderRepo, folderAccess)
+	getFolderACLHandler := folder.NewGetFolderACLHandler(log, folderRepo, folderAccess)
+	exportFolderHandler := folder.NewExportFolderHandler(log, folderRepo, folderAccess, clusterRepo, topicRepo, taxonomyRepo)
 
 	commands := folderCommands.NewFolderCommands(
 		createFolderHandler,
 		updateFolderHandler,
+		patchFolderHandler,
 		deleteFolderHandler,
+		moveFolderHandler,
+		duplicateFolderHandler,
+		reorderFoldersHandler,
+		grantFolderAccessHandler,
+		revokeFolderAccessHandler,
+		importFolderHandler,
 	)
 	queries := folder.NewFolderQueries(
 		getAllFolderHandler,
 		getFolderByIDHandler,
 		searchFoldersHandler,
+		getFolderTreeHandler,
+		getFolderPathHandler,
+		getFolderACLHandler,
+		exportFolderHandler,
 	)
 
 	folderService = &FolderService{Commands: commands, Queries: queries}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	registryCommands "gallery-service/internal/application/commands/v1/registry"
+	"gallery-service/internal/application/queries/registry"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/pkg/zap"
+)
+
+type RegistryService struct {
+	Commands *registryCommands.Commands
+	Queries  *registry.Queries
+}
+
+var (
+	registryService *RegistryService
+)
+
+func NewRegistryService(
+	log zap.Logger,
+	registryRepo repository.RegistryRepository,
+	clusterRepo repository.ClusterRepository,
+	topicRepo repository.TopicRepository,
+) *RegistryService {
+	if registryService != nil {
+		return registryService
+	}
+
+	createLanguageHandler := registryCommands.NewCreateLanguageHandler(log, registryRepo)
+	updateLanguageHandler := registryCommands.NewUpdateLanguageHandler(log, registryRepo)
+	deleteLanguageHandler := registryCommands.NewDeleteLanguageHandler(log, registryRepo, clusterRepo, topicRepo)
+	createComponentHandler := registryCommands.NewCreateComponentHandler(log, registryRepo)
+	updateComponentHandler := registryCommands.NewUpdateComponentHandler(log, registryRepo)
+	deleteComponentHandler := registryCommands.NewDeleteComponentHandler(log, registryRepo, topicRepo)
+
+	getAllLanguagesHandler := registry.NewGetAllLanguagesHandler(log, registryRepo)
+	getAllComponentsHandler := registry.NewGetAllComponentsHandler(log, registryRepo)
+
+	commands := registryCommands.NewRegistryCommands(
+		createLanguageHandler,
+		updateLanguageHandler,
+		deleteLanguageHandler,
+		createComponentHandler,
+		updateComponentHandler,
+		deleteComponentHandler,
+	)
+	queries := registry.NewRegistryQueries(
+		getAllLanguagesHandler,
+		getAllComponentsHandler,
+	)
+
+	registryService = &RegistryService{Commands: commands, Queries: queries}
+
+	return registryService
+}
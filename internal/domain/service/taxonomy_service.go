@@ -0,0 +1,54 @@
+package service
+
+import (
+	taxonomyCommands "gallery-service/internal/application/commands/v1/taxonomy"
+	"gallery-service/internal/application/queries/taxonomy"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/pkg/zap"
+)
+
+type TaxonomyService struct {
+	Commands *taxonomyCommands.Commands
+	Queries  *taxonomy.Queries
+}
+
+var (
+	taxonomyService *TaxonomyService
+)
+
+func NewTaxonomyService(
+	log zap.Logger,
+	taxonomyRepo repository.TaxonomyRepository,
+	clusterRepo repository.ClusterRepository,
+	topicRepo repository.TopicRepository,
+	txManager repository.TransactionManager,
+) *TaxonomyService {
+	if taxonomyService != nil {
+		return taxonomyService
+	}
+
+	createTermHandler := taxonomyCommands.NewCreateTaxonomyTermHandler(log, taxonomyRepo)
+	updateTermHandler := taxonomyCommands.NewUpdateTaxonomyTermHandler(log, taxonomyRepo)
+	moveTermHandler := taxonomyCommands.NewMoveTaxonomyTermHandler(log, taxonomyRepo, txManager)
+	deleteTermHandler := taxonomyCommands.NewDeleteTaxonomyTermHandler(log, taxonomyRepo, clusterRepo, topicRepo, txManager)
+
+	getAllTermsHandler := taxonomy.NewGetAllTaxonomyTermsHandler(log, taxonomyRepo)
+	getTermByIDHandler := taxonomy.NewGetTaxonomyTermByIDHandler(log, taxonomyRepo)
+	getTreeHandler := taxonomy.NewGetTaxonomyTreeHandler(log, taxonomyRepo)
+
+	commands := taxonomyCommands.NewTaxonomyCommands(
+		createTermHandler,
+		updateTermHandler,
+		moveTermHandler,
+		deleteTermHandler,
+	)
+	queries := taxonomy.NewTaxonomyQueries(
+		getAllTermsHandler,
+		getTermByIDHandler,
+		getTreeHandler,
+	)
+
+	taxonomyService = &TaxonomyService{Commands: commands, Queries: queries}
+
+	return taxonomyService
+}
@@ -1,8 +1,11 @@
 package service
 
 import (
+	"gallery-service/internal/application/access"
 	topicCommands "gallery-service/internal/application/commands/v1/topic"
+	"gallery-service/internal/application/patch"
 	"gallery-service/internal/application/queries/topic"
+	"gallery-service/internal/application/revision"
 	"gallery-service/internal/domain/repository"
 	"gallery-service/pkg/kafka"
 	"gallery-service/pkg/zap"
@@ -20,32 +23,73 @@ var (
 func NewTopicService(
 	cfg kafka.Config,
 	log zap.Logger,
+	val patch.Validator,
 	topicRepo repository.TopicRepository,
 	folderRepo repository.FolderRepository,
+	revisionRepo repository.RevisionRepository,
+	taxonomyRepo repository.TaxonomyRepository,
+	txManager repository.TransactionManager,
 ) *TopicService {
 	if topicService != nil {
 		return topicService
 	}
 
-	createTopicHandler := topicCommands.NewCreateTopicHandler(cfg, log, topicRepo)
-	updateTopicHandler := topicCommands.NewUpdateTopicHandler(log, topicRepo)
+	recorder := revision.NewRecorder(log, revisionRepo)
+	folderAccess := access.NewFolderAccess(log, folderRepo)
+
+	createTopicHandler := topicCommands.NewCreateTopicHandler(cfg, log, topicRepo, folderRepo, folderAccess)
+	updateTopicHandler := topicCommands.NewUpdateTopicHandler(log, topicRepo, recorder, txManager)
+	patchTopicHandler := topicCommands.NewPatchTopicHandler(log, val, topicRepo, recorder, txManager)
 	deleteTopicHandler := topicCommands.NewDeleteTopicHandler(log, topicRepo)
+	reorderTopicsHandler := topicCommands.NewReorderTopicsHandler(log, topicRepo, folderRepo, folderAccess, txManager)
+	restoreTopicRevisionHandler := topicCommands.NewRestoreTopicRevisionHandler(log, topicRepo, revisionRepo, recorder, txManager)
+	setTopicLanguageHandler := topicCommands.NewSetTopicLanguageHandler(log, topicRepo, recorder, txManager)
+	removeTopicLanguageHandler := topicCommands.NewRemoveTopicLanguageHandler(log, topicRepo, recorder, txManager)
+	addTopicTermHandler := topicCommands.NewAddTopicTermHandler(log, topicRepo, taxonomyRepo)
+	removeTopicTermHandler := topicCommands.NewRemoveTopicTermHandler(log, topicRepo)
+	transitionTopicHandler := topicCommands.NewTransitionTopicHandler(log, topicRepo)
+	scheduleTopicHandler := topicCommands.NewScheduleTopicHandler(log, topicRepo)
+	moveTopicHandler := topicCommands.NewMoveTopicHandler(log, topicRepo, folderRepo, folderAccess)
+	addTopicMediaHandler := topicCommands.NewAddTopicMediaHandler(log, topicRepo, recorder, txManager)
+	updateTopicMediaHandler := topicCommands.NewUpdateTopicMediaHandler(log, topicRepo, recorder, txManager)
+	removeTopicMediaHandler := topicCommands.NewRemoveTopicMediaHandler(log, topicRepo, recorder, txManager)
+	reorderTopicMediaHandler := topicCommands.NewReorderTopicMediaHandler(log, topicRepo, recorder, txManager)
 
 	getAllTopicHandler := topic.NewGetAllTopicHandler(log, topicRepo)
-	//getTopicFolder := topic.NewGetAllTopicFolderHandler(log, topicRepo)
+	getTopicFolder := topic.NewGetAllTopicFolderHandler(log, topicRepo, folderRepo, folderAccess)
 	getTopicByIDHandler := topic.NewGetTopicByIDHandler(log, topicRepo)
-	searchTopicsHandler := topic.NewSearchTopicsHandler(log, topicRepo)
+	searchTopicsHandler := topic.NewSearchTopicsHandler(log, topicRepo, taxonomyRepo)
+	getTopicRevisionsHandler := topic.NewGetTopicRevisionsHandler(log, topicRepo, revisionRepo)
+	getTopicRevisionDiffHandler := topic.NewGetTopicRevisionDiffHandler(log, topicRepo, revisionRepo)
+	getTopicWorkflowHandler := topic.NewGetTopicWorkflowHandler(log, topicRepo)
 
 	commands := topicCommands.NewTopicCommands(
 		createTopicHandler,
 		updateTopicHandler,
+		patchTopicHandler,
 		deleteTopicHandler,
+		reorderTopicsHandler,
+		restoreTopicRevisionHandler,
+		setTopicLanguageHandler,
+		removeTopicLanguageHandler,
+		addTopicTermHandler,
+		removeTopicTermHandler,
+		transitionTopicHandler,
+		scheduleTopicHandler,
+		moveTopicHandler,
+		addTopicMediaHandler,
+		updateTopicMediaHandler,
+		removeTopicMediaHandler,
+		reorderTopicMediaHandler,
 	)
 	queries := topic.NewTopicQueries(
 		getAllTopicHandler,
 		getTopicByIDHandler,
 		searchTopicsHandler,
-		//getTopicFolder,
+		getTopicFolder,
+		getTopicRevisionsHandler,
+		getTopicRevisionDiffHandler,
+		getTopicWorkflowHandler,
 	)
 
 	topicService = &TopicService{Commands: commands, Queries: queries}
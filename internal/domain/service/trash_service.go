@@ -0,0 +1,50 @@
+package service
+
+import (
+	"gallery-service/config"
+	"gallery-service/internal/application/access"
+	trashCommands "gallery-service/internal/application/commands/v1/trash"
+	"gallery-service/internal/application/queries/trash"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/pkg/zap"
+)
+
+type TrashService struct {
+	Commands *trashCommands.Commands
+	Queries  *trash.Queries
+}
+
+var (
+	trashService *TrashService
+)
+
+func NewTrashService(
+	cfg config.TrashConfig,
+	log zap.Logger,
+	folderRepo repository.FolderRepository,
+	clusterRepo repository.ClusterRepository,
+	topicRepo repository.TopicRepository,
+	txManager repository.TransactionManager,
+) *TrashService {
+	if trashService != nil {
+		return trashService
+	}
+
+	folderAccess := access.NewFolderAccess(log, folderRepo)
+	trashAccess := access.NewTrashAccess(log, folderRepo, folderAccess)
+
+	restoreTrashItemHandler := trashCommands.NewRestoreTrashItemHandler(log, folderRepo, clusterRepo, topicRepo, trashAccess, txManager)
+	purgeTrashItemHandler := trashCommands.NewPurgeTrashItemHandler(log, folderRepo, clusterRepo, topicRepo, trashAccess, txManager)
+
+	getAllTrashHandler := trash.NewGetAllTrashHandler(log, folderRepo, clusterRepo, topicRepo, trashAccess, cfg.Retention)
+
+	commands := trashCommands.NewTrashCommands(
+		restoreTrashItemHandler,
+		purgeTrashItemHandler,
+	)
+	queries := trash.NewTrashQueries(getAllTrashHandler)
+
+	trashService = &TrashService{Commands: commands, Queries: queries}
+
+	return trashService
+}
@@ -0,0 +1,35 @@
+package migrations
+
+import (
+	"context"
+	"gallery-service/config"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// strayClusterFolderField is the field cluster updates wrote by mistake, a tab after
+// folder_id, instead of moving the cluster
+const strayClusterFolderField = "folder_id\t"
+
+// RemoveStrayClusterFolderField removes the misspelt folder field that cluster updates
+// stored, clusters in the trash included. The clusters stay in the folder of their
+// folder_id. It is safe to run more than once and returns the number of clusters it
+// changed.
+func RemoveStrayClusterFolderField(ctx context.Context, log zap.Logger, cfg *config.Config, db *mongo.Client) (int64, error) {
+	collection := db.Database(cfg.Mongo.Db).Collection(cfg.Mongo.Collections.Cluster)
+
+	result, err := collection.UpdateMany(
+		ctx,
+		bson.M{strayClusterFolderField: bson.M{"$exists": true}},
+		bson.M{"$unset": bson.M{strayClusterFolderField: ""}})
+	if err != nil {
+		return 0, errors.Wrap(err, "mongoRepository.UpdateMany")
+	}
+
+	log.Infof("(RemoveStrayClusterFolderField) clusters found: {%d}", result.MatchedCount)
+
+	return result.ModifiedCount, nil
+}
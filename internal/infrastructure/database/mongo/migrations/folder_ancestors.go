@@ -0,0 +1,125 @@
+package migrations
+
+import (
+	"context"
+	"gallery-service/config"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type folderParent struct {
+	ID       primitive.ObjectID  `bson:"_id"`
+	ParentID *primitive.ObjectID `bson:"parent_id"`
+}
+
+// BackfillFolderAncestors rebuilds the ancestors and depth of every folder from its
+// parent_id chain. It is safe to run more than once and returns the number of folders
+// it changed.
+func BackfillFolderAncestors(ctx context.Context, log zap.Logger, cfg *config.Config, db *mongo.Client) (int64, error) {
+	return backfillFolderAncestors(ctx, log, cfg, db, false)
+}
+
+// EnsureFolderAncestors fills ancestors and depth on the folders that have none, leaving
+// the others alone. The subtree, access and move checks read the ancestors, so the
+// server runs it on every start, where it costs a single count once every folder has
+// them.
+func EnsureFolderAncestors(ctx context.Context, log zap.Logger, cfg *config.Config, db *mongo.Client) (int64, error) {
+	collection := db.Database(cfg.Mongo.Db).Collection(cfg.Mongo.Collections.Folder)
+
+	missing, err := collection.CountDocuments(ctx, bson.M{"ancestors": bson.M{"$exists": false}})
+	if err != nil {
+		return 0, errors.Wrap(err, "mongoRepository.CountDocuments")
+	}
+
+	if missing == 0 {
+		return 0, nil
+	}
+
+	log.Infof("(EnsureFolderAncestors) folders without ancestors: {%d}", missing)
+
+	return backfillFolderAncestors(ctx, log, cfg, db, true)
+}
+
+func backfillFolderAncestors(ctx context.Context, log zap.Logger, cfg *config.Config, db *mongo.Client, missingOnly bool) (int64, error) {
+	collection := db.Database(cfg.Mongo.Db).Collection(cfg.Mongo.Collections.Folder)
+
+	cursor, err := collection.Find(ctx, bson.M{}, options.Find().SetProjection(bson.M{"_id": 1, "parent_id": 1}))
+	if err != nil {
+		return 0, errors.Wrap(err, "mongoRepository.Find")
+	}
+	defer cursor.Close(ctx)
+
+	var folders []folderParent
+	if err := cursor.All(ctx, &folders); err != nil {
+		return 0, errors.Wrap(err, "cursor.All")
+	}
+
+	parents := make(map[primitive.ObjectID]*primitive.ObjectID, len(folders))
+	for _, f := range folders {
+		parents[f.ID] = f.ParentID
+	}
+
+	writes := make([]mongo.WriteModel, 0, len(folders))
+	for _, f := range folders {
+		ancestors, ok := resolveAncestors(f.ID, parents)
+		if !ok {
+			log.Warnf("(BackfillFolderAncestors) folder %s is part of a parent_id cycle, skipping", f.ID.Hex())
+			continue
+		}
+
+		filter := bson.M{"_id": f.ID}
+		if missingOnly {
+			filter["ancestors"] = bson.M{"$exists": false}
+		}
+
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(filter).
+			SetUpdate(bson.M{"$set": bson.M{"ancestors": ancestors, "depth": len(ancestors)}}))
+	}
+
+	if len(writes) == 0 {
+		return 0, nil
+	}
+
+	res, err := collection.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		return 0, errors.Wrap(err, "mongoRepository.BulkWrite")
+	}
+
+	return res.ModifiedCount, nil
+}
+
+// resolveAncestors walks up parent_id links. A parent that no longer exists ends the
+// path there, and a cycle makes the folder unresolvable.
+func resolveAncestors(id primitive.ObjectID, parents map[primitive.ObjectID]*primitive.ObjectID) ([]primitive.ObjectID, bool) {
+	reversed := make([]primitive.ObjectID, 0)
+	seen := map[primitive.ObjectID]bool{id: true}
+
+	parentID := parents[id]
+	for parentID != nil {
+		if seen[*parentID] {
+			return nil, false
+		}
+		seen[*parentID] = true
+
+		next, exists := parents[*parentID]
+		if !exists {
+			break
+		}
+
+		reversed = append(reversed, *parentID)
+		parentID = next
+	}
+
+	ancestors := make([]primitive.ObjectID, 0, len(reversed))
+	for i := len(reversed) - 1; i >= 0; i-- {
+		ancestors = append(ancestors, reversed[i])
+	}
+
+	return ancestors, true
+}
@@ -0,0 +1,72 @@
+package migrations
+
+import (
+	"context"
+	"gallery-service/config"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AssignFolderOrganization hands a folder, every folder below it and their clusters
+// to an organization. Content stored before organizations were introduced has none
+// and is global until assigned. An empty organizationID makes the subtree global.
+func AssignFolderOrganization(
+	ctx context.Context,
+	log zap.Logger,
+	cfg *config.Config,
+	db *mongo.Client,
+	folderID primitive.ObjectID,
+	organizationID string,
+) (int64, int64, error) {
+	var owner *string
+	if organizationID != "" {
+		owner = &organizationID
+	}
+
+	folders := db.Database(cfg.Mongo.Db).Collection(cfg.Mongo.Collections.Folder)
+	subtreeFilter := bson.M{"$or": []bson.M{{"_id": folderID}, {"ancestors": folderID}}}
+
+	cursor, err := folders.Find(ctx, subtreeFilter, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "mongoRepository.Find")
+	}
+	defer cursor.Close(ctx)
+
+	var subtree []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &subtree); err != nil {
+		return 0, 0, errors.Wrap(err, "cursor.All")
+	}
+
+	if len(subtree) == 0 {
+		return 0, 0, errors.New("folder not found")
+	}
+
+	folderIDs := make([]primitive.ObjectID, 0, len(subtree))
+	for _, f := range subtree {
+		folderIDs = append(folderIDs, f.ID)
+	}
+
+	folderRes, err := folders.UpdateMany(ctx, subtreeFilter, bson.M{"$set": bson.M{"organization_id": owner}})
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "mongoRepository.UpdateMany")
+	}
+
+	clusterRes, err := db.Database(cfg.Mongo.Db).Collection(cfg.Mongo.Collections.Cluster).UpdateMany(
+		ctx,
+		bson.M{"folder_id": bson.M{"$in": folderIDs}},
+		bson.M{"$set": bson.M{"organization_id": owner}})
+	if err != nil {
+		return folderRes.ModifiedCount, 0, errors.Wrap(err, "mongoRepository.UpdateMany")
+	}
+
+	log.Infof("(AssignFolderOrganization) folder: {%s}, organization: {%s}", folderID.Hex(), organizationID)
+
+	return folderRes.ModifiedCount, clusterRes.ModifiedCount, nil
+}
@@ -0,0 +1,236 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"gallery-service/config"
+	"gallery-service/internal/pkg/timecode"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// InvalidTimecode is a stored media time the migration left alone because it cannot be
+// parsed, or because the media ends before it starts
+type InvalidTimecode struct {
+	Collection string
+	ID         primitive.ObjectID
+	Path       string
+	Value      interface{}
+	Reason     string
+}
+
+type rawMedia struct {
+	StartTime interface{} `bson:"start_time"`
+	EndTime   interface{} `bson:"end_time"`
+}
+
+type rawClusterMedia struct {
+	ID             primitive.ObjectID `bson:"_id"`
+	LanguageConfig []struct {
+		Video rawMedia `bson:"video"`
+		Audio rawMedia `bson:"audio"`
+	} `bson:"language_config"`
+}
+
+type rawTopicMedia struct {
+	ID             primitive.ObjectID `bson:"_id"`
+	LanguageConfig []struct {
+		Videos []rawMedia `bson:"videos"`
+		Audios []rawMedia `bson:"audios"`
+	} `bson:"language_config"`
+}
+
+// NormalizeMediaTimecodes rewrites the start and end times of cluster and topic media
+// in the canonical timecode form, documents in the trash included. It is safe to run more
+// than once and returns the number of documents it changed, with the values it could not
+// fix.
+func NormalizeMediaTimecodes(ctx context.Context, log zap.Logger, cfg *config.Config, db *mongo.Client) (int64, []InvalidTimecode, error) {
+	clusters, clusterInvalid, err := normalizeClusterTimecodes(ctx, db.Database(cfg.Mongo.Db).Collection(cfg.Mongo.Collections.Cluster))
+	if err != nil {
+		return 0, nil, err
+	}
+	log.Infof("(NormalizeMediaTimecodes) updated clusters: {%d}", clusters)
+
+	topics, topicInvalid, err := normalizeTopicTimecodes(ctx, db.Database(cfg.Mongo.Db).Collection(cfg.Mongo.Collections.Topic))
+	if err != nil {
+		return 0, nil, err
+	}
+	log.Infof("(NormalizeMediaTimecodes) updated topics: {%d}", topics)
+
+	return clusters + topics, append(clusterInvalid, topicInvalid...), nil
+}
+
+func normalizeClusterTimecodes(ctx context.Context, collection *mongo.Collection) (int64, []InvalidTimecode, error) {
+	cursor, err := collection.Find(ctx, bson.M{"language_config": bson.M{"$exists": true}}, options.Find().SetProjection(bson.M{"_id": 1, "language_config": 1}))
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "mongoRepository.Find")
+	}
+	defer cursor.Close(ctx)
+
+	var clusters []rawClusterMedia
+	if err := cursor.All(ctx, &clusters); err != nil {
+		return 0, nil, errors.Wrap(err, "cursor.All")
+	}
+
+	writes := make([]mongo.WriteModel, 0)
+	invalid := make([]InvalidTimecode, 0)
+	for _, c := range clusters {
+		n := newTimecodeNormalizer(collection.Name(), c.ID)
+		for i, config := range c.LanguageConfig {
+			n.media(fmt.Sprintf("language_config.%d.video", i), config.Video)
+			n.media(fmt.Sprintf("language_config.%d.audio", i), config.Audio)
+		}
+
+		if write := n.write(); write != nil {
+			writes = append(writes, write)
+		}
+		invalid = append(invalid, n.invalid...)
+	}
+
+	updated, err := bulkWrite(ctx, collection, writes)
+	return updated, invalid, err
+}
+
+func normalizeTopicTimecodes(ctx context.Context, collection *mongo.Collection) (int64, []InvalidTimecode, error) {
+	cursor, err := collection.Find(ctx, bson.M{"language_config": bson.M{"$exists": true}}, options.Find().SetProjection(bson.M{"_id": 1, "language_config": 1}))
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "mongoRepository.Find")
+	}
+	defer cursor.Close(ctx)
+
+	var topics []rawTopicMedia
+	if err := cursor.All(ctx, &topics); err != nil {
+		return 0, nil, errors.Wrap(err, "cursor.All")
+	}
+
+	writes := make([]mongo.WriteModel, 0)
+	invalid := make([]InvalidTimecode, 0)
+	for _, t := range topics {
+		n := newTimecodeNormalizer(collection.Name(), t.ID)
+		for i, config := range t.LanguageConfig {
+			for j, video := range config.Videos {
+				n.media(fmt.Sprintf("language_config.%d.videos.%d", i, j), video)
+			}
+			for j, audio := range config.Audios {
+				n.media(fmt.Sprintf("language_config.%d.audios.%d", i, j), audio)
+			}
+		}
+
+		if write := n.write(); write != nil {
+			writes = append(writes, write)
+		}
+		invalid = append(invalid, n.invalid...)
+	}
+
+	updated, err := bulkWrite(ctx, collection, writes)
+	return updated, invalid, err
+}
+
+func bulkWrite(ctx context.Context, collection *mongo.Collection, writes []mongo.WriteModel) (int64, error) {
+	if len(writes) == 0 {
+		return 0, nil
+	}
+
+	res, err := collection.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		return 0, errors.Wrap(err, "mongoRepository.BulkWrite")
+	}
+
+	return res.ModifiedCount, nil
+}
+
+// timecodeNormalizer collects the changes to the media times of one document
+type timecodeNormalizer struct {
+	collection string
+	id         primitive.ObjectID
+	set        bson.M
+	unset      bson.M
+	invalid    []InvalidTimecode
+}
+
+func newTimecodeNormalizer(collection string, id primitive.ObjectID) *timecodeNormalizer {
+	return &timecodeNormalizer{collection: collection, id: id, set: bson.M{}, unset: bson.M{}}
+}
+
+func (n *timecodeNormalizer) media(path string, media rawMedia) {
+	start, startOK := n.field(path+".start_time", media.StartTime)
+	end, endOK := n.field(path+".end_time", media.EndTime)
+	if !startOK || !endOK {
+		return
+	}
+
+	if err := timecode.CheckRange(start, end); err != nil {
+		n.invalid = append(n.invalid, InvalidTimecode{
+			Collection: n.collection,
+			ID:         n.id,
+			Path:       path,
+			Value:      bson.M{"start_time": media.StartTime, "end_time": media.EndTime},
+			Reason:     err.Error(),
+		})
+	}
+}
+
+// field normalizes one stored time. A time that is zero once parsed is removed, as the
+// models do not store unset timecodes.
+func (n *timecodeNormalizer) field(path string, value interface{}) (timecode.Timecode, bool) {
+	var (
+		parsed timecode.Timecode
+		err    error
+	)
+
+	switch v := value.(type) {
+	case nil:
+		return timecode.Timecode{}, true
+	case string:
+		parsed, err = timecode.Parse(v)
+	case int32:
+		parsed, err = timecode.FromSeconds(float64(v))
+	case int64:
+		parsed, err = timecode.FromSeconds(float64(v))
+	case float64:
+		parsed, err = timecode.FromSeconds(v)
+	default:
+		err = errors.Errorf("unexpected %T value", value)
+	}
+
+	if err != nil {
+		n.invalid = append(n.invalid, InvalidTimecode{
+			Collection: n.collection,
+			ID:         n.id,
+			Path:       path,
+			Value:      value,
+			Reason:     err.Error(),
+		})
+		return timecode.Timecode{}, false
+	}
+
+	switch {
+	case parsed.IsZero():
+		n.unset[path] = ""
+	case value != parsed.String():
+		n.set[path] = parsed.String()
+	}
+
+	return parsed, true
+}
+
+func (n *timecodeNormalizer) write() mongo.WriteModel {
+	update := bson.M{}
+	if len(n.set) > 0 {
+		update["$set"] = n.set
+	}
+	if len(n.unset) > 0 {
+		update["$unset"] = n.unset
+	}
+
+	if len(update) == 0 {
+		return nil
+	}
+
+	return mongo.NewUpdateOneModel().SetFilter(bson.M{"_id": n.id}).SetUpdate(update)
+}
@@ -0,0 +1,96 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"gallery-service/config"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type rawMediaID struct {
+	ID primitive.ObjectID `bson:"id"`
+}
+
+type rawTopicMediaIDs struct {
+	ID             primitive.ObjectID `bson:"_id"`
+	LanguageConfig []struct {
+		Images []rawMediaID `bson:"images"`
+		Videos []rawMediaID `bson:"videos"`
+		Audios []rawMediaID `bson:"audios"`
+	} `bson:"language_config"`
+}
+
+// BackfillTopicMediaIDs gives an ID to the images, videos and audios of the topics stored
+// before media items had one, topics in the trash included. A topic whose items changed
+// since they were read is left for the next run, so it is safe to run more than once and
+// alongside the service. It returns the number of topics it changed.
+func BackfillTopicMediaIDs(ctx context.Context, log zap.Logger, cfg *config.Config, db *mongo.Client) (int64, error) {
+	collection := db.Database(cfg.Mongo.Db).Collection(cfg.Mongo.Collections.Topic)
+
+	missing := bson.M{"$elemMatch": bson.M{"id": bson.M{"$exists": false}}}
+	filter := bson.M{"$or": bson.A{
+		bson.M{"language_config.images": missing},
+		bson.M{"language_config.videos": missing},
+		bson.M{"language_config.audios": missing},
+	}}
+
+	cursor, err := collection.Find(ctx, filter, options.Find().SetProjection(bson.M{
+		"_id":                       1,
+		"language_config.images.id": 1,
+		"language_config.videos.id": 1,
+		"language_config.audios.id": 1,
+	}))
+	if err != nil {
+		return 0, errors.Wrap(err, "mongoRepository.Find")
+	}
+	defer cursor.Close(ctx)
+
+	var topics []rawTopicMediaIDs
+	if err := cursor.All(ctx, &topics); err != nil {
+		return 0, errors.Wrap(err, "cursor.All")
+	}
+
+	writes := make([]mongo.WriteModel, 0, len(topics))
+	items := 0
+	for _, t := range topics {
+		filter := bson.M{"_id": t.ID}
+		set := bson.M{}
+		assign := func(path string, media []rawMediaID) {
+			for i, m := range media {
+				if m.ID.IsZero() {
+					itemPath := fmt.Sprintf("%s.%d.id", path, i)
+					filter[itemPath] = bson.M{"$exists": false}
+					set[itemPath] = primitive.NewObjectID()
+				}
+			}
+		}
+
+		for i, config := range t.LanguageConfig {
+			assign(fmt.Sprintf("language_config.%d.images", i), config.Images)
+			assign(fmt.Sprintf("language_config.%d.videos", i), config.Videos)
+			assign(fmt.Sprintf("language_config.%d.audios", i), config.Audios)
+		}
+
+		if len(set) == 0 {
+			continue
+		}
+
+		items += len(set)
+		writes = append(writes, mongo.NewUpdateOneModel().SetFilter(filter).SetUpdate(bson.M{"$set": set}))
+	}
+
+	updated, err := bulkWrite(ctx, collection, writes)
+	if err != nil {
+		return 0, err
+	}
+
+	log.Infof("(BackfillTopicMediaIDs) topics found: {%d}, media items: {%d}", len(topics), items)
+
+	return updated, nil
+}
@@ -0,0 +1,39 @@
+package migrations
+
+import (
+	"context"
+	"gallery-service/config"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// BackfillTopicStatus gives the topics stored before the editorial workflow the status
+// their is_published flag stood for: published topics stay published, the others become
+// drafts. It is safe to run more than once and returns the number of topics it changed.
+func BackfillTopicStatus(ctx context.Context, log zap.Logger, cfg *config.Config, db *mongo.Client) (int64, error) {
+	collection := db.Database(cfg.Mongo.Db).Collection(cfg.Mongo.Collections.Topic)
+
+	published, err := collection.UpdateMany(
+		ctx,
+		bson.M{"status": bson.M{"$exists": false}, "is_published": true},
+		bson.M{"$set": bson.M{"status": constants.TopicStatusPublished}})
+	if err != nil {
+		return 0, errors.Wrap(err, "mongoRepository.UpdateMany")
+	}
+
+	drafts, err := collection.UpdateMany(
+		ctx,
+		bson.M{"status": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"status": constants.TopicStatusDraft, "is_published": false}})
+	if err != nil {
+		return published.ModifiedCount, errors.Wrap(err, "mongoRepository.UpdateMany")
+	}
+
+	log.Infof("(BackfillTopicStatus) published: {%d}, drafts: {%d}", published.ModifiedCount, drafts.ModifiedCount)
+
+	return published.ModifiedCount + drafts.ModifiedCount, nil
+}
@@ -8,8 +8,11 @@ import (
 	"gallery-service/internal/application/dto/responses/cluster"
 	"gallery-service/internal/application/mappers"
 	"gallery-service/internal/domain/models"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/tenant"
 	"gallery-service/pkg/utils"
 	"gallery-service/pkg/zap"
+	"time"
 
 	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/bson"
@@ -37,6 +40,12 @@ func NewClusterRepository(log zap.Logger, cfg *config.Config, db *mongo.Client)
 }
 
 func (p *clusterRepository) Insert(ctx context.Context, cluster *models.Cluster) (string, error) {
+	owner, err := tenant.FromContext(ctx).Owner()
+	if err != nil {
+		return "", err
+	}
+	cluster.OrganizationID = owner
+
 	insertResult, err := p.getClustersCollection().InsertOne(ctx, cluster, &options.InsertOneOptions{})
 	if err != nil {
 		p.log.Errorf("(ClusterRepository.Insert) Error inserting cluster: %v", err)
@@ -46,6 +55,26 @@ func (p *clusterRepository) Insert(ctx context.Context, cluster *models.Cluster)
 	return insertResult.InsertedID.(primitive.ObjectID).Hex(), nil
 }
 
+func (p *clusterRepository) InsertMany(ctx context.Context, clusters []*models.Cluster) error {
+	owner, err := tenant.FromContext(ctx).Owner()
+	if err != nil {
+		return err
+	}
+
+	docs := make([]interface{}, 0, len(clusters))
+	for _, c := range clusters {
+		c.OrganizationID = owner
+		docs = append(docs, c)
+	}
+
+	if _, err := p.getClustersCollection().InsertMany(ctx, docs); err != nil {
+		p.log.Errorf("(ClusterRepository.InsertMany) Error inserting clusters: %v", err)
+		return err
+	}
+
+	return nil
+}
+
 func (p *clusterRepository) Update(ctx context.Context, cluster *models.Cluster) error {
 	req := make(bson.M)
 	req["cluster_name"] = cluster.ClusterName
@@ -53,13 +82,15 @@ func (p *clusterRepository) Update(ctx context.Context, cluster *models.Cluster)
 	req["note"] = cluster.Note
 	req["image"] = cluster.Image
 	req["language_config"] = cluster.LanguageConfig
-	req["folder_id	"] = cluster.FolderID
+	req["tags"] = cluster.Tags
+	req["folder_id"] = cluster.FolderID
+	req["position"] = cluster.Position
 	req["created_at"] = cluster.CreatedAt
 	req["updated_at"] = cluster.UpdatedAt
 
 	result, err := p.getClustersCollection().UpdateOne(
 		ctx,
-		bson.M{"_id": cluster.ID},
+		writeScope(ctx, bson.M{"_id": cluster.ID}),
 		bson.M{"$set": req})
 
 	if err != nil {
@@ -73,7 +104,135 @@ func (p *clusterRepository) Update(ctx context.Context, cluster *models.Cluster)
 	return nil
 }
 
-func (p *clusterRepository) GetAll(ctx context.Context, pq *utils.Pagination) (*cluster.GetAllClusterResponseDto, error) {
+// Patch sets and unsets only the given fields of a cluster
+func (p *clusterRepository) Patch(ctx context.Context, clusterID primitive.ObjectID, set map[string]interface{}, unset []string) error {
+	result, err := p.getClustersCollection().UpdateOne(
+		ctx,
+		writeScope(ctx, bson.M{"_id": clusterID}),
+		patchUpdate(set, unset),
+	)
+	if err != nil {
+		return fmt.Errorf("(ClusterRepository.Patch) failed to update: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("(ClusterRepository.Patch) no cluster found with ID: %s", clusterID.Hex())
+	}
+
+	return nil
+}
+
+// SetLanguage adds the language entry to a cluster or replaces the one it has for the same
+// language, and reports whether it was added
+func (p *clusterRepository) SetLanguage(ctx context.Context, clusterID primitive.ObjectID, config models.LanguageConfig) (bool, error) {
+	added, err := setLanguage(ctx, p.getClustersCollection(), clusterID, config.Language, config)
+	if err != nil {
+		p.log.Errorf("(ClusterRepository.SetLanguage) Error saving language: %v", err)
+		return false, err
+	}
+
+	return added, nil
+}
+
+// RemoveLanguage removes the entry for language from a cluster and reports whether it had one
+func (p *clusterRepository) RemoveLanguage(ctx context.Context, clusterID primitive.ObjectID, language constants.Language) (bool, error) {
+	removed, err := removeLanguage(ctx, p.getClustersCollection(), clusterID, language)
+	if err != nil {
+		p.log.Errorf("(ClusterRepository.RemoveLanguage) Error removing language: %v", err)
+		return false, err
+	}
+
+	return removed, nil
+}
+
+// AddTaxonomyTerm classifies a cluster with a taxonomy term and reports whether it was
+// not classified with it already
+func (p *clusterRepository) AddTaxonomyTerm(ctx context.Context, clusterID primitive.ObjectID, termID primitive.ObjectID) (bool, error) {
+	added, err := addTaxonomyTerm(ctx, p.getClustersCollection(), clusterID, termID)
+	if err != nil {
+		p.log.Errorf("(ClusterRepository.AddTaxonomyTerm) Error adding term: %v", err)
+		return false, err
+	}
+
+	return added, nil
+}
+
+// RemoveTaxonomyTerm removes a taxonomy term from a cluster and reports whether it had it
+func (p *clusterRepository) RemoveTaxonomyTerm(ctx context.Context, clusterID primitive.ObjectID, termID primitive.ObjectID) (bool, error) {
+	removed, err := removeTaxonomyTerm(ctx, p.getClustersCollection(), clusterID, termID)
+	if err != nil {
+		p.log.Errorf("(ClusterRepository.RemoveTaxonomyTerm) Error removing term: %v", err)
+		return false, err
+	}
+
+	return removed, nil
+}
+
+// RemoveTaxonomyTerms removes deleted taxonomy terms from the clusters of every organization
+func (p *clusterRepository) RemoveTaxonomyTerms(ctx context.Context, termIDs []primitive.ObjectID) (int64, error) {
+	removed, err := removeTaxonomyTerms(ctx, p.getClustersCollection(), termIDs)
+	if err != nil {
+		p.log.Errorf("(ClusterRepository.RemoveTaxonomyTerms) Error removing terms: %v", err)
+		return 0, err
+	}
+
+	return removed, nil
+}
+
+// SetSchedule replaces when the cluster is shown and hidden, a nil time clearing it, and
+// whether it is hidden until then
+func (p *clusterRepository) SetSchedule(ctx context.Context, clusterID primitive.ObjectID, publishAt *time.Time, unpublishAt *time.Time, hidden bool) (bool, error) {
+	res, err := p.getClustersCollection().UpdateOne(
+		ctx,
+		writeScope(ctx, bson.M{"_id": clusterID}),
+		scheduleUpdate(bson.M{"hidden": hidden, "updated_at": time.Now()}, publishAt, unpublishAt))
+	if err != nil {
+		p.log.Errorf("(ClusterRepository.SetSchedule) Error updating cluster: %v", err)
+		return false, errors.Wrap(err, "mongoRepository.UpdateOne")
+	}
+
+	return res.MatchedCount > 0, nil
+}
+
+// ApplySchedule shows the clusters whose publish_at has come by at, or hides the ones
+// whose unpublish_at has when hidden is set. The time is cleared as the cluster is shown
+// or hidden, so each cluster goes through it once.
+func (p *clusterRepository) ApplySchedule(ctx context.Context, hidden bool, at time.Time) (int64, error) {
+	field := "publish_at"
+	if hidden {
+		field = "unpublish_at"
+	}
+
+	res, err := p.getClustersCollection().UpdateMany(
+		ctx,
+		writeScope(ctx, bson.M{field: bson.M{"$lte": at}}),
+		bson.M{
+			"$set":   bson.M{"hidden": hidden, "updated_at": at},
+			"$unset": bson.M{field: ""},
+		})
+	if err != nil {
+		p.log.Errorf("(ClusterRepository.ApplySchedule) Error updating clusters: %v", err)
+		return 0, errors.Wrap(err, "mongoRepository.UpdateMany")
+	}
+
+	return res.ModifiedCount, nil
+}
+
+func (p *clusterRepository) GetAll(ctx context.Context, query map[string]interface{}, pq *utils.Pagination) (*cluster.GetAllClusterResponseDto, error) {
+	if pq.Page <= 0 {
+		pq.Page = 1
+	}
+
+	if pq.Size <= 0 {
+		pq.Size = 10000
+	}
+
+	return p.getPage(ctx, readScope(ctx, clusterFilter(query)), pq)
+}
+
+// GetAllByFolderIDs returns a page of the clusters filed in one of the folders, only the
+// ones shown to users when visible is set
+func (p *clusterRepository) GetAllByFolderIDs(ctx context.Context, folderIDs []primitive.ObjectID, visible bool, pq *utils.Pagination) (*cluster.GetAllClusterResponseDto, error) {
 	if pq.Page <= 0 {
 		pq.Page = 1
 	}
@@ -82,50 +241,90 @@ func (p *clusterRepository) GetAll(ctx context.Context, pq *utils.Pagination) (*
 		pq.Size = 10000
 	}
 
+	filter := bson.M{"folder_id": bson.M{"$in": folderIDs}}
+	if visible {
+		filter = withVisible(filter)
+	}
+
+	return p.getPage(ctx, readScope(ctx, filter), pq)
+}
+
+// getPage returns a page of the clusters matching filter, counting the total on the same
+// filter
+func (p *clusterRepository) getPage(ctx context.Context, filter bson.M, pq *utils.Pagination) (*cluster.GetAllClusterResponseDto, error) {
 	// Prepare pagination options
 	skip := int64((pq.Page - 1) * pq.Size)
 	limit := int64(pq.Size)
 
 	aggPipeline := []bson.M{
-		{
-			"$skip": skip,
-		},
-		{
-			"$limit": limit,
-		},
+		{"$match": filter},
+		{"$sort": positionSort},
+		{"$skip": skip},
+		{"$limit": limit},
 	}
 
 	// Perform the search query on the clusters collection
 	cursor, err := p.getClustersCollection().Aggregate(ctx, aggPipeline)
 	if err != nil {
-		p.log.Errorf("(ClusterRepository.GetAll) Error fetching clusters: %v", err)
-		return nil, errors.Wrap(err, "mongoRepository.Find")
+		p.log.Errorf("(ClusterRepository.getPage) Error fetching clusters: %v", err)
+		return nil, errors.Wrap(err, "mongoRepository.Aggregate")
 	}
 	defer cursor.Close(ctx)
 
-	// Create a slice to hold the search results
 	var clusters []*models.Cluster
 	if err := cursor.All(ctx, &clusters); err != nil {
-		p.log.Errorf("(ClusterRepository.GetAll) Error fetching clusters: %v", err)
+		p.log.Errorf("(ClusterRepository.getPage) Error reading cursor: %v", err)
 		return nil, errors.Wrap(err, "cursor.All")
 	}
 
-	//Prepare pagination response
-	totalCount := len(clusters)
+	count, err := p.getClustersCollection().CountDocuments(ctx, filter)
+	if err != nil {
+		p.log.Errorf("(ClusterRepository.getPage) Error counting clusters: %v", err)
+		return nil, errors.Wrap(err, "CountDocuments")
+	}
 
 	return &cluster.GetAllClusterResponseDto{
 		Pagination: responses.Pagination{
-			TotalCount: int64(totalCount),
-			TotalPages: int64(pq.GetTotalPages(totalCount)),
+			TotalCount: count,
+			TotalPages: int64(pq.GetTotalPages(int(count))),
 			Page:       int64(pq.GetPage()),
 			Size:       int64(pq.GetSize()),
-			HasMore:    pq.GetHasMore(totalCount),
+			HasMore:    pq.GetHasMore(int(count)),
 		},
 		Clusters: mappers.GetAllClustersFromModels(clusters),
 	}, nil
 }
 
-func (p *clusterRepository) GetAllByFolderID(ctx context.Context, folderID string, pq *utils.Pagination) (*cluster.GetAllClusterResponseDto, error) {
+func (p *clusterRepository) GetByID(ctx context.Context, clusterID string) (*models.Cluster, error) {
+	p.log.Infof("(clusterRepository.GetByID) ClusterID: %s", clusterID)
+	objectId, _ := primitive.ObjectIDFromHex(clusterID)
+	var cluster models.Cluster
+	if err := p.getClustersCollection().FindOne(ctx, readScope(ctx, bson.M{"_id": objectId})).Decode(&cluster); err != nil {
+		p.log.Errorf("(clusterRepository.GetByID) Error fetching cluster: %v", err)
+		return nil, err
+	}
+
+	return &cluster, nil
+}
+
+func (p *clusterRepository) GetByFolderIDs(ctx context.Context, folderIDs []primitive.ObjectID) ([]*models.Cluster, error) {
+	cursor, err := p.getClustersCollection().Find(ctx, readScope(ctx, bson.M{"folder_id": bson.M{"$in": folderIDs}}))
+	if err != nil {
+		p.log.Errorf("(ClusterRepository.GetByFolderIDs) Error fetching clusters: %v", err)
+		return nil, errors.Wrap(err, "mongoRepository.Find")
+	}
+	defer cursor.Close(ctx)
+
+	var clusters []*models.Cluster
+	if err := cursor.All(ctx, &clusters); err != nil {
+		p.log.Errorf("(ClusterRepository.GetByFolderIDs) Error fetching clusters: %v", err)
+		return nil, errors.Wrap(err, "cursor.All")
+	}
+
+	return clusters, nil
+}
+
+func (p *clusterRepository) Search(ctx context.Context, query map[string]interface{}, pq *utils.Pagination) (*cluster.GetAllClusterResponseDto, error) {
 	if pq.Page <= 0 {
 		pq.Page = 1
 	}
@@ -133,227 +332,290 @@ func (p *clusterRepository) GetAllByFolderID(ctx context.Context, folderID strin
 	if pq.Size <= 0 {
 		pq.Size = 10000
 	}
-	folderObjectID, err := primitive.ObjectIDFromHex(folderID)
-	if err != nil {
-		return nil, errors.Wrap(err, "primitive.ObjectIDFromHex")
+
+	return p.getPage(ctx, readScope(ctx, clusterFilter(query)), pq)
+}
+
+// clusterFilter matches the clusters of a listing query: keyword against the name, title
+// and note, tags with their tag_mode, the taxonomy term subtrees of taxonomy_terms, and
+// folder_ids, the folders the current user can read when their grants narrow it down.
+// Clusters outside of any folder carry no ACL and always match folder_ids. visible leaves
+// out the clusters hidden from users.
+func clusterFilter(query map[string]interface{}) bson.M {
+	filter := bson.M{}
+
+	if keyword, ok := query["keyword"].(string); ok && keyword != "" {
+		filter["$or"] = []bson.M{
+			{"cluster_name": bson.M{"$regex": primitive.Regex{Pattern: keyword, Options: "i"}}},
+			{"title": bson.M{"$regex": primitive.Regex{Pattern: keyword, Options: "i"}}},
+			{"note": bson.M{"$regex": primitive.Regex{Pattern: keyword, Options: "i"}}},
+		}
 	}
 
-	// Prepare pagination options
-	skip := int64((pq.Page - 1) * pq.Size)
-	limit := int64(pq.Size)
+	if tags, ok := query["tags"].([]string); ok && len(tags) > 0 {
+		if query["tag_mode"] == constants.TagMatchAny {
+			filter["tags"] = bson.M{"$in": tags}
+		} else {
+			filter["tags"] = bson.M{"$all": tags}
+		}
+	}
 
-	aggPipeline := []bson.M{
-		{
-			"$match": bson.M{
-				"folder_id": folderObjectID,
-			},
-		},
-		{
-			"$skip": skip,
-		},
-		{
-			"$limit": limit,
-		},
+	if subtrees, ok := query["taxonomy_terms"].([][]primitive.ObjectID); ok {
+		filter = withTaxonomyTerms(filter, subtrees)
 	}
 
+	if folderIDs, ok := query["folder_ids"].([]primitive.ObjectID); ok {
+		in := bson.A{nil}
+		for _, id := range folderIDs {
+			in = append(in, id)
+		}
+		filter["folder_id"] = bson.M{"$in": in}
+	}
 
-	// Debug: First, let's check if there are ANY documents in the collection
-	totalDocsInCollection, err := p.getClustersCollection().CountDocuments(ctx, bson.M{})
-	if err != nil {
-		p.log.Errorf("(ClusterRepository.GetAllByFolderID) Error counting total documents: %v", err)
-	} else {
-		p.log.Infof("(ClusterRepository.GetAllByFolderID) Total documents in clusters collection: %d", totalDocsInCollection)
+	if visible, ok := query["visible"].(bool); ok && visible {
+		filter = withVisible(filter)
 	}
 
-	// Debug: Check if there are documents with this folderID BEFORE pagination
-	countBeforePagination, err := p.getClustersCollection().CountDocuments(ctx, bson.M{
-		"folder_id": folderID,
-	})
+	return filter
+}
+
+// withVisible narrows filter to the clusters shown to users, the ones not published yet
+// or not anymore being hidden
+func withVisible(filter bson.M) bson.M {
+	return withField(filter, "hidden", bson.M{"$ne": true})
+}
+
+// GetTagCounts counts the clusters carrying each tag among those the query matches, per
+// folder, so that the counts can be limited to the folders the user can read
+func (p *clusterRepository) GetTagCounts(ctx context.Context, query map[string]interface{}) ([]models.ClusterTagCount, error) {
+	aggPipeline := []bson.M{
+		{"$match": readScope(ctx, clusterFilter(query))},
+		{"$unwind": "$tags"},
+		{"$group": bson.M{
+			"_id":   bson.M{"tag": "$tags", "folder_id": "$folder_id"},
+			"count": bson.M{"$sum": 1},
+		}},
+		{"$project": bson.M{
+			"_id":       0,
+			"tag":       "$_id.tag",
+			"folder_id": "$_id.folder_id",
+			"count":     1,
+		}},
+	}
+
+	cursor, err := p.getClustersCollection().Aggregate(ctx, aggPipeline)
 	if err != nil {
-		p.log.Errorf("(ClusterRepository.GetAllByFolderID) Error counting documents with folderID: %v", err)
-	} else {
-		p.log.Infof("(ClusterRepository.GetAllByFolderID) Documents matching folderID '%s': %d", folderID, countBeforePagination)
-	}
-
-	// Debug: Let's also check for variations of the folderID (in case of data inconsistency)
-	// Check for documents with similar folder_id patterns
-	pipeline := []bson.M{
-		{
-			"$group": bson.M{
-				"_id":   "$folder_id",
-				"count": bson.M{"$sum": 1},
-			},
-		},
-		{
-			"$sort": bson.M{"count": -1},
-		},
-		{
-			"$limit": 10,
-		},
+		p.log.Errorf("(ClusterRepository.GetTagCounts) err: %v", err)
+		return nil, errors.Wrap(err, "mongoRepository.Aggregate")
 	}
+	defer cursor.Close(ctx)
 
-	cursor, err := p.getClustersCollection().Aggregate(ctx, pipeline)
-	if err == nil {
-		var folderStats []bson.M
-		if err := cursor.All(ctx, &folderStats); err == nil {
-			p.log.Infof("(ClusterRepository.GetAllByFolderID) Top folder_id values in collection: %+v", folderStats)
-		}
-		cursor.Close(ctx)
+	counts := make([]models.ClusterTagCount, 0)
+	if err := cursor.All(ctx, &counts); err != nil {
+		return nil, errors.Wrap(err, "cursor.All")
 	}
 
-	// Perform the search query on the clusters collection
-	cursor, err = p.getClustersCollection().Aggregate(ctx, aggPipeline)
+	return counts, nil
+}
+
+// GetByTag returns the clusters carrying a tag that the current user can write
+func (p *clusterRepository) GetByTag(ctx context.Context, tag string) ([]*models.Cluster, error) {
+	cursor, err := p.getClustersCollection().Find(ctx, writeScope(ctx, bson.M{"tags": tag}))
 	if err != nil {
-		p.log.Errorf("(ClusterRepository.GetAllByFolderID) Error fetching clusters: %v", err)
-		return nil, errors.Wrap(err, "mongoRepository.Aggregate")
+		return nil, errors.Wrap(err, "mongoRepository.Find")
 	}
 	defer cursor.Close(ctx)
 
-	var clusters []*models.Cluster
+	clusters := make([]*models.Cluster, 0)
 	if err := cursor.All(ctx, &clusters); err != nil {
-		p.log.Errorf("(ClusterRepository.GetAllByFolderID) Error reading cursor: %v", err)
 		return nil, errors.Wrap(err, "cursor.All")
 	}
 
-	// Debug: Log the number of clusters found
-	p.log.Infof("(ClusterRepository.GetAllByFolderID) Found %d clusters after aggregation", len(clusters))
+	return clusters, nil
+}
 
-	// Debug: If we found clusters, log the first one (without sensitive data)
-	if len(clusters) > 0 {
-		p.log.Infof("(ClusterRepository.GetAllByFolderID) First cluster - ID: %s, FolderID: %s",
-			clusters[0].ID, clusters[0].FolderID)
+// RenameTag replaces tag from with to on the clusters. A cluster that already carries to
+// keeps a single copy, which merges the two tags.
+func (p *clusterRepository) RenameTag(ctx context.Context, clusterIDs []primitive.ObjectID, from string, to string) (int64, error) {
+	if len(clusterIDs) == 0 {
+		return 0, nil
 	}
 
-	// Count query for total (this is the same as countBeforePagination, but keeping for consistency)
-	count, err := p.getClustersCollection().CountDocuments(ctx, bson.M{
-		"folder_id": folderObjectID,
-	})
+	merged, err := p.getClustersCollection().UpdateMany(
+		ctx,
+		writeScope(ctx, bson.M{"_id": bson.M{"$in": clusterIDs}, "tags": bson.M{"$all": bson.A{from, to}}}),
+		bson.M{"$pull": bson.M{"tags": from}, "$set": bson.M{"updated_at": time.Now()}},
+	)
 	if err != nil {
-		p.log.Errorf("(ClusterRepository.GetAllByFolderID) Error counting clusters: %v", err)
-		return nil, errors.Wrap(err, "CountDocuments")
+		return 0, errors.Wrap(err, "mongoRepository.UpdateMany")
 	}
 
-	// Debug: Log final results
-	p.log.Infof("(ClusterRepository.GetAllByFolderID) Final results - Total count: %d, Clusters returned: %d", count, len(clusters))
+	renamed, err := p.getClustersCollection().UpdateMany(
+		ctx,
+		writeScope(ctx, bson.M{"_id": bson.M{"$in": clusterIDs}, "tags": from}),
+		bson.M{"$set": bson.M{"tags.$": to, "updated_at": time.Now()}},
+	)
+	if err != nil {
+		return 0, errors.Wrap(err, "mongoRepository.UpdateMany")
+	}
 
-	result := &cluster.GetAllClusterResponseDto{
-		Pagination: responses.Pagination{
-			TotalCount: count,
-			TotalPages: int64(pq.GetTotalPages(int(count))),
-			Page:       int64(pq.GetPage()),
-			Size:       int64(pq.GetSize()),
-			HasMore:    pq.GetHasMore(int(count)),
-		},
-		Clusters: mappers.GetAllClustersFromModels(clusters),
+	return merged.ModifiedCount + renamed.ModifiedCount, nil
+}
+
+// Delete moves a cluster to the trash on its own
+func (p *clusterRepository) Delete(ctx context.Context, clusterID string) (bool, error) {
+	objectId, _ := primitive.ObjectIDFromHex(clusterID)
+	res, err := p.getClustersCollection().UpdateOne(ctx, writeScope(ctx, bson.M{"_id": objectId}), moveToTrash(ctx, objectId))
+	if err != nil {
+		p.log.Errorf("(ClusterRepository.Delete) Error deleting cluster: %v", err)
+		return false, err
 	}
 
-	// Debug: Log the final response structure (without actual data)
-	p.log.Infof("(ClusterRepository.GetAllByFolderID) Response - TotalCount: %d, TotalPages: %d, Page: %d, Size: %d, HasMore: %v, ClustersCount: %d",
-		result.Pagination.TotalCount,
-		result.Pagination.TotalPages,
-		result.Pagination.Page,
-		result.Pagination.Size,
-		result.Pagination.HasMore,
-		len(result.Clusters))
+	return res.MatchedCount > 0, nil
+}
+
+// DeleteByFolderIDs moves the clusters of the given folders to the trash as part of the
+// trash unit trashID
+func (p *clusterRepository) DeleteByFolderIDs(ctx context.Context, folderIDs []primitive.ObjectID, trashID primitive.ObjectID) (int64, error) {
+	res, err := p.getClustersCollection().UpdateMany(ctx, writeScope(ctx, bson.M{"folder_id": bson.M{"$in": folderIDs}}), moveToTrash(ctx, trashID))
+	if err != nil {
+		p.log.Errorf("(ClusterRepository.DeleteByFolderIDs) Error deleting clusters: %v", err)
+		return 0, err
+	}
 
-	return result, nil
+	return res.ModifiedCount, nil
 }
 
-func (p *clusterRepository) GetByID(ctx context.Context, clusterID string) (*models.Cluster, error) {
-	p.log.Infof("(clusterRepository.GetByID) ClusterID: %s", clusterID)
+// GetDeleted returns the clusters that were deleted on their own, newest first
+func (p *clusterRepository) GetDeleted(ctx context.Context) ([]*models.Cluster, error) {
+	cursor, err := p.getClustersCollection().Find(
+		ctx,
+		trashScope(ctx, trashRootFilter),
+		options.Find().SetSort(bson.D{{Key: "deleted_at", Value: -1}}))
+	if err != nil {
+		p.log.Errorf("(ClusterRepository.GetDeleted) Error fetching clusters: %v", err)
+		return nil, errors.Wrap(err, "mongoRepository.Find")
+	}
+	defer cursor.Close(ctx)
+
+	var clusters []*models.Cluster
+	if err := cursor.All(ctx, &clusters); err != nil {
+		p.log.Errorf("(ClusterRepository.GetDeleted) Error fetching clusters: %v", err)
+		return nil, errors.Wrap(err, "cursor.All")
+	}
+
+	return clusters, nil
+}
+
+// GetDeletedByID returns a cluster that was deleted on its own
+func (p *clusterRepository) GetDeletedByID(ctx context.Context, clusterID string) (*models.Cluster, error) {
 	objectId, _ := primitive.ObjectIDFromHex(clusterID)
 	var cluster models.Cluster
-	if err := p.getClustersCollection().FindOne(ctx, bson.M{"_id": objectId}).Decode(&cluster); err != nil {
-		p.log.Errorf("(clusterRepository.GetByID) Error fetching cluster: %v", err)
+	if err := p.getClustersCollection().FindOne(ctx, trashScope(ctx, bson.M{"_id": objectId, "trash_id": objectId})).Decode(&cluster); err != nil {
+		p.log.Errorf("(ClusterRepository.GetDeletedByID) Error fetching cluster: %v", err)
 		return nil, err
 	}
 
 	return &cluster, nil
 }
 
-func (p *clusterRepository) Search(ctx context.Context, query map[string]interface{}, pq *utils.Pagination) (*cluster.GetAllClusterResponseDto, error) {
-	// Prepare pagination options
-	skip := int64((pq.Page - 1) * pq.Size)
-	limit := int64(pq.Size)
+// Restore brings back every cluster of the trash unit trashID
+func (p *clusterRepository) Restore(ctx context.Context, trashID primitive.ObjectID) (int64, error) {
+	res, err := p.getClustersCollection().UpdateMany(ctx, trashScope(ctx, bson.M{"trash_id": trashID}), restoreFromTrash)
+	if err != nil {
+		p.log.Errorf("(ClusterRepository.Restore) Error restoring clusters: %v", err)
+		return 0, err
+	}
 
-	queryFilter := bson.M{}
+	return res.ModifiedCount, nil
+}
 
-	if query["keyword"] != nil {
-		queryFilter = bson.M{
-			"$or": []bson.M{
-				{"cluster_name": bson.M{"$regex": primitive.Regex{Pattern: query["keyword"].(string), Options: "i"}}},
-				{"title": bson.M{"$regex": primitive.Regex{Pattern: query["keyword"].(string), Options: "i"}}},
-				{"note": bson.M{"$regex": primitive.Regex{Pattern: query["keyword"].(string), Options: "i"}}},
-			},
-		}
+// Purge permanently deletes every cluster of the trash unit trashID, with its revisions
+func (p *clusterRepository) Purge(ctx context.Context, trashID primitive.ObjectID) (int64, error) {
+	count, err := purgeWithRevisions(ctx, p.getClustersCollection(), p.getRevisionsCollection(), constants.RevisionEntityCluster, trashScope(ctx, bson.M{"trash_id": trashID}))
+	if err != nil {
+		p.log.Errorf("(ClusterRepository.Purge) Error purging clusters: %v", err)
+		return 0, err
 	}
 
-	p.log.Debugf("Starting %v", queryFilter)
+	return count, nil
+}
 
-	aggPipeline := []bson.M{
-		{
-			"$skip": skip,
-		},
-		{
-			"$limit": limit,
-		},
+// PurgeDeletedBefore permanently deletes the clusters of every organization that have
+// been in the trash since before the given time, with their revisions
+func (p *clusterRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	count, err := purgeWithRevisions(ctx, p.getClustersCollection(), p.getRevisionsCollection(), constants.RevisionEntityCluster, bson.M{"deleted_at": bson.M{"$lt": before}})
+	if err != nil {
+		p.log.Errorf("(ClusterRepository.PurgeDeletedBefore) Error purging clusters: %v", err)
+		return 0, err
 	}
 
-	if len(queryFilter) > 0 {
-		aggPipeline = append(aggPipeline, bson.M{
-			"$match": queryFilter,
-		})
-	} else {
-		p.log.Warnf("(ClusterRepository.Search) Query filter is empty, skipping $match stage.")
+	return count, nil
+}
+
+func (p *clusterRepository) ChangeFolder(ctx context.Context, fromFolderID primitive.ObjectID, toFolderID primitive.ObjectID) (int64, error) {
+	res, err := p.getClustersCollection().UpdateMany(
+		ctx,
+		treeScope(ctx, bson.M{"folder_id": fromFolderID}),
+		bson.M{"$set": bson.M{"folder_id": toFolderID, "updated_at": time.Now()}})
+	if err != nil {
+		p.log.Errorf("(ClusterRepository.ChangeFolder) Error updating clusters: %v", err)
+		return 0, err
 	}
 
-	p.log.Infof("(ClusterRepository.Search) Searching for query: %v", aggPipeline)
+	return res.ModifiedCount, nil
+}
 
-	// Perform the search query on the clusters collection
-	cursor, err := p.getClustersCollection().Aggregate(ctx, aggPipeline)
+// Move puts a cluster into folderID at the given position and reports whether the
+// current user could change it
+func (p *clusterRepository) Move(ctx context.Context, clusterID primitive.ObjectID, folderID primitive.ObjectID, position int64) (bool, error) {
+	res, err := p.getClustersCollection().UpdateOne(
+		ctx,
+		writeScope(ctx, bson.M{"_id": clusterID}),
+		bson.M{"$set": bson.M{"folder_id": folderID, "position": position, "updated_at": time.Now()}})
 	if err != nil {
-		p.log.Errorf("(ClusterRepository.Search) Error fetching clusters: %v", err)
-		return nil, errors.Wrap(err, "mongoRepository.Find")
+		p.log.Errorf("(ClusterRepository.Move) Error updating cluster: %v", err)
+		return false, err
 	}
-	defer cursor.Close(ctx)
 
-	// Create a slice to hold the search results
-	var clusters []*models.Cluster
-	if err := cursor.All(ctx, &clusters); err != nil {
-		p.log.Errorf("(ClusterRepository.Search) Error fetching clusters: %v", err)
-		return nil, errors.Wrap(err, "cursor.All")
+	return res.MatchedCount > 0, nil
+}
+
+func (p *clusterRepository) GetNextPosition(ctx context.Context, folderID primitive.ObjectID) (int64, error) {
+	position, err := nextPosition(ctx, p.getClustersCollection(), readScope(ctx, bson.M{"folder_id": folderID}))
+	if err != nil {
+		p.log.Errorf("(ClusterRepository.GetNextPosition) Error fetching position: %v", err)
+		return 0, err
 	}
 
-	// Prepare pagination response
-	totalCount := len(clusters)
+	return position, nil
+}
 
-	return &cluster.GetAllClusterResponseDto{
-		Pagination: responses.Pagination{
-			TotalCount: int64(totalCount),
-			TotalPages: int64(pq.GetTotalPages(totalCount)),
-			Page:       int64(pq.GetPage()),
-			Size:       int64(pq.GetSize()),
-			HasMore:    pq.GetHasMore(totalCount),
-		},
-		Clusters: mappers.GetAllClustersFromModels(clusters),
-	}, nil
+func (p *clusterRepository) Reorder(ctx context.Context, folderID primitive.ObjectID, clusterIDs []primitive.ObjectID) error {
+	if err := reorder(ctx, p.getClustersCollection(), writeScope(ctx, bson.M{"folder_id": folderID}), clusterIDs); err != nil {
+		p.log.Errorf("(ClusterRepository.Reorder) Error reordering clusters: %v", err)
+		return errors.Wrap(err, "cluster")
+	}
+
+	return nil
 }
 
-func (p *clusterRepository) Delete(ctx context.Context, clusterID string) (bool, error) {
-	objectId, _ := primitive.ObjectIDFromHex(clusterID)
-	res, err := p.getClustersCollection().DeleteOne(ctx, bson.M{"_id": objectId})
+func (p *clusterRepository) Exists(ctx context.Context, query map[string]interface{}) (bool, error) {
+	count, err := p.getClustersCollection().CountDocuments(ctx, readScope(ctx, query))
 	if err != nil {
-		p.log.Errorf("(ClusterRepository.Delete) Error deleting cluster: %v", err)
+		p.log.Errorf("(ClusterRepository.Exists) Error counting clusters: %v", err)
 		return false, err
 	}
 
-	return res.DeletedCount > 0, nil
+	return count > 0, nil
 }
 
-func (p *clusterRepository) Exists(ctx context.Context, query map[string]interface{}) (bool, error) {
+// ExistsAnywhere tells whether any clusters match query, in every organization and in the
+// trash, for checks that guard what all of them refer to
+func (p *clusterRepository) ExistsAnywhere(ctx context.Context, query map[string]interface{}) (bool, error) {
 	count, err := p.getClustersCollection().CountDocuments(ctx, query)
 	if err != nil {
-		p.log.Errorf("(ClusterRepository.Exists) Error counting clusters: %v", err)
+		p.log.Errorf("(ClusterRepository.ExistsAnywhere) Error counting clusters: %v", err)
 		return false, err
 	}
 
@@ -363,3 +625,11 @@ func (p *clusterRepository) Exists(ctx context.Context, query map[string]interfa
 func (p *clusterRepository) getClustersCollection() *mongo.Collection {
 	return p.db.Database(p.cfg.Mongo.Db).Collection(p.cfg.Mongo.Collections.Cluster)
 }
+
+func (p *clusterRepository) getRevisionsCollection() *mongo.Collection {
+	return p.db.Database(p.cfg.Mongo.Db).Collection(p.cfg.Mongo.Collections.Revision)
+}
+
+func (p *clusterRepository) getFoldersCollection() *mongo.Collection {
+	return p.db.Database(p.cfg.Mongo.Db).Collection(p.cfg.Mongo.Collections.Folder)
+}
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"reflect"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestClusterFilterVisible(t *testing.T) {
+	folderID := primitive.NewObjectID()
+
+	tests := []struct {
+		name  string
+		query map[string]interface{}
+		want  bson.M
+	}{
+		{
+			name:  "admin listing keeps hidden clusters",
+			query: map[string]interface{}{"visible": false},
+			want:  bson.M{},
+		},
+		{
+			name:  "user listing leaves out hidden clusters",
+			query: map[string]interface{}{"visible": true},
+			want:  bson.M{"hidden": bson.M{"$ne": true}},
+		},
+		{
+			name:  "user listing of readable folders",
+			query: map[string]interface{}{"visible": true, "folder_ids": []primitive.ObjectID{folderID}},
+			want: bson.M{
+				"folder_id": bson.M{"$in": bson.A{nil, folderID}},
+				"hidden":    bson.M{"$ne": true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := clusterFilter(tt.query); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("clusterFilter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"gallery-service/internal/application/dto/responses/folder"
 	"gallery-service/internal/application/mappers"
 	"gallery-service/internal/domain/models"
+	"gallery-service/internal/pkg/tenant"
 	"gallery-service/pkg/utils"
 	"gallery-service/pkg/zap"
 	"github.com/pkg/errors"
@@ -15,6 +16,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
 )
 
 type folderRepository struct {
@@ -27,6 +29,16 @@ var (
 	folderRepo *folderRepository
 )
 
+type folderTreeResult struct {
+	models.Folder `bson:",inline"`
+	Descendants   []folderTreeDescendant `bson:"descendants"`
+}
+
+type folderTreeDescendant struct {
+	models.Folder `bson:",inline"`
+	TreeDepth     int64 `bson:"tree_depth"`
+}
+
 func NewFolderRepository(log zap.Logger, cfg *config.Config, db *mongo.Client) *folderRepository {
 	if folderRepo == nil {
 		folderRepo = &folderRepository{log: log, cfg: cfg, db: db}
@@ -36,6 +48,12 @@ func NewFolderRepository(log zap.Logger, cfg *config.Config, db *mongo.Client) *
 }
 
 func (c *folderRepository) Insert(ctx context.Context, folder *models.Folder) (string, error) {
+	owner, err := tenant.FromContext(ctx).Owner()
+	if err != nil {
+		return "", err
+	}
+	folder.OrganizationID = owner
+
 	insertResult, err := c.getFoldersCollection().InsertOne(ctx, folder, &options.InsertOneOptions{})
 	if err != nil {
 		c.log.Errorf("(FolderRepository.Insert) Error inserting cluster: %v", err)
@@ -45,6 +63,26 @@ func (c *folderRepository) Insert(ctx context.Context, folder *models.Folder) (s
 	return insertResult.InsertedID.(primitive.ObjectID).Hex(), nil
 }
 
+func (c *folderRepository) InsertMany(ctx context.Context, folders []*models.Folder) error {
+	owner, err := tenant.FromContext(ctx).Owner()
+	if err != nil {
+		return err
+	}
+
+	docs := make([]interface{}, 0, len(folders))
+	for _, f := range folders {
+		f.OrganizationID = owner
+		docs = append(docs, f)
+	}
+
+	if _, err := c.getFoldersCollection().InsertMany(ctx, docs); err != nil {
+		c.log.Errorf("(FolderRepository.InsertMany) Error inserting folders: %v", err)
+		return err
+	}
+
+	return nil
+}
+
 func (c *folderRepository) Update(ctx context.Context, folder *models.Folder) error {
 	req := make(bson.M)
 	req["folder_name"] = folder.FolderName
@@ -54,7 +92,7 @@ func (c *folderRepository) Update(ctx context.Context, folder *models.Folder) er
 
 	result, err := c.getFoldersCollection().UpdateOne(
 		ctx,
-		bson.M{"_id": folder.ID},
+		writeScope(ctx, bson.M{"_id": folder.ID}),
 		bson.M{"$set": req})
 
 	if err != nil {
@@ -68,7 +106,101 @@ func (c *folderRepository) Update(ctx context.Context, folder *models.Folder) er
 	return nil
 }
 
-func (c *folderRepository) GetAll(ctx context.Context, pq *utils.Pagination) (*folder.GetAllFolderResponseDto, error) {
+// Patch sets and unsets only the given fields of a folder
+func (c *folderRepository) Patch(ctx context.Context, folderID primitive.ObjectID, set map[string]interface{}, unset []string) error {
+	result, err := c.getFoldersCollection().UpdateOne(
+		ctx,
+		writeScope(ctx, bson.M{"_id": folderID}),
+		patchUpdate(set, unset),
+	)
+	if err != nil {
+		return fmt.Errorf("(FolderRepository.Patch) failed to update: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("(FolderRepository.Patch) no folder found with ID: %s", folderID.Hex())
+	}
+
+	return nil
+}
+
+func (c *folderRepository) GetAll(ctx context.Context, query map[string]interface{}, pq *utils.Pagination) (*folder.GetAllFolderResponseDto, error) {
+	if pq.Page <= 0 {
+		pq.Page = 1
+	}
+
+	if pq.Size <= 0 {
+		pq.Size = 10000
+	}
+
+	return c.getPage(ctx, readScope(ctx, folderFilter(query)), pq)
+}
+
+// UpdateParent moves a folder under parentID at the given position and rewrites the
+// ancestor path of the folder and of every folder below it. Call it inside a transaction.
+func (c *folderRepository) UpdateParent(ctx context.Context, folderID string, parentID *primitive.ObjectID, ancestors []primitive.ObjectID, position int64) error {
+	objectId, _ := primitive.ObjectIDFromHex(folderID)
+	if ancestors == nil {
+		ancestors = []primitive.ObjectID{}
+	}
+
+	result, err := c.getFoldersCollection().UpdateOne(
+		ctx,
+		writeScope(ctx, bson.M{"_id": objectId}),
+		bson.M{"$set": bson.M{
+			"parent_id": parentID,
+			"ancestors": ancestors,
+			"depth":     len(ancestors),
+			"position":  position,
+		}})
+
+	if err != nil {
+		return fmt.Errorf("(FolderRepository.UpdateParent) failed to update: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("(FolderRepository.UpdateParent) no folder found with ID: %s", folderID)
+	}
+
+	// Descendants keep the part of their path below the moved folder and get the
+	// moved folder's new path in front of it.
+	prefix := append(append([]primitive.ObjectID{}, ancestors...), objectId)
+	_, err = c.getFoldersCollection().UpdateMany(
+		ctx,
+		treeScope(ctx, bson.M{"ancestors": objectId}),
+		[]bson.M{
+			{"$set": bson.M{
+				"ancestors": bson.M{"$concatArrays": []interface{}{
+					prefix,
+					bson.M{"$slice": []interface{}{
+						"$ancestors",
+						bson.M{"$add": []interface{}{bson.M{"$indexOfArray": []interface{}{"$ancestors", objectId}}, 1}},
+						bson.M{"$size": "$ancestors"},
+					}},
+				}},
+			}},
+			{"$set": bson.M{"depth": bson.M{"$size": "$ancestors"}}},
+		})
+	if err != nil {
+		return fmt.Errorf("(FolderRepository.UpdateParent) failed to update descendants: %w", err)
+	}
+
+	return nil
+}
+
+func (c *folderRepository) GetByID(ctx context.Context, folderID string) (*models.Folder, error) {
+	c.log.Infof("(FolderRepository.GetByID) FolderID: %s", folderID)
+	objectId, _ := primitive.ObjectIDFromHex(folderID)
+	var folder models.Folder
+	if err := c.getFoldersCollection().FindOne(ctx, readScope(ctx, bson.M{"_id": objectId})).Decode(&folder); err != nil {
+		c.log.Errorf("(FolderRepository.GetByID) Error fetching cluster: %v", err)
+		return nil, err
+	}
+
+	return &folder, nil
+}
+
+func (c *folderRepository) Search(ctx context.Context, query map[string]interface{}, pq *utils.Pagination) (*folder.GetAllFolderResponseDto, error) {
 	if pq.Page <= 0 {
 		pq.Page = 1
 	}
@@ -77,138 +209,545 @@ func (c *folderRepository) GetAll(ctx context.Context, pq *utils.Pagination) (*f
 		pq.Size = 10000
 	}
 
+	return c.getPage(ctx, readScope(ctx, folderFilter(query)), pq)
+}
+
+// getPage returns a page of the folders matching filter, counting the total on the same
+// filter
+func (c *folderRepository) getPage(ctx context.Context, filter bson.M, pq *utils.Pagination) (*folder.GetAllFolderResponseDto, error) {
 	// Prepare pagination options
 	skip := int64((pq.Page - 1) * pq.Size)
 	limit := int64(pq.Size)
 
 	aggPipeline := []bson.M{
-		{
-			"$skip": skip,
-		},
-		{
-			"$limit": limit,
-		},
+		{"$match": filter},
+		{"$sort": positionSort},
+		{"$skip": skip},
+		{"$limit": limit},
 	}
 
 	// Perform the search query on the folders collection
 	cursor, err := c.getFoldersCollection().Aggregate(ctx, aggPipeline)
 	if err != nil {
-		c.log.Errorf("(FolderRepository.Search) Error fetching folders: %v", err)
-		return nil, errors.Wrap(err, "mongoRepository.Find")
+		c.log.Errorf("(FolderRepository.getPage) Error fetching folders: %v", err)
+		return nil, errors.Wrap(err, "mongoRepository.Aggregate")
 	}
 	defer cursor.Close(ctx)
 
 	// Create a slice to hold the search results
 	var folders []*models.Folder
 	if err := cursor.All(ctx, &folders); err != nil {
-		c.log.Errorf("(FolderRepository.Search) Error fetching folders: %v", err)
+		c.log.Errorf("(FolderRepository.getPage) Error fetching folders: %v", err)
 		return nil, errors.Wrap(err, "cursor.All")
 	}
 
-	// Prepare pagination response
-	totalCount := len(folders)
+	count, err := c.getFoldersCollection().CountDocuments(ctx, filter)
+	if err != nil {
+		c.log.Errorf("(FolderRepository.getPage) Error counting folders: %v", err)
+		return nil, errors.Wrap(err, "CountDocuments")
+	}
 
 	return &folder.GetAllFolderResponseDto{
 		Pagination: responses.Pagination{
-			TotalCount: int64(totalCount),
-			TotalPages: int64(pq.GetTotalPages(totalCount)),
+			TotalCount: count,
+			TotalPages: int64(pq.GetTotalPages(int(count))),
 			Page:       int64(pq.GetPage()),
 			Size:       int64(pq.GetSize()),
-			HasMore:    pq.GetHasMore(totalCount),
+			HasMore:    pq.GetHasMore(int(count)),
 		},
 		Folders: mappers.GetAllFoldersFromModels(folders),
 	}, nil
 }
 
-func (c *folderRepository) GetByID(ctx context.Context, folderID string) (*models.Folder, error) {
-	c.log.Infof("(FolderRepository.GetByID) FolderID: %s", folderID)
-	objectId, _ := primitive.ObjectIDFromHex(folderID)
-	var folder models.Folder
-	if err := c.getFoldersCollection().FindOne(ctx, bson.M{"_id": objectId}).Decode(&folder); err != nil {
-		c.log.Errorf("(FolderRepository.GetByID) Error fetching cluster: %v", err)
-		return nil, err
+// folderFilter matches the folders of a listing query: keyword against the name, and
+// folder_ids, the folders the current user can read when their grants narrow it down
+func folderFilter(query map[string]interface{}) bson.M {
+	filter := bson.M{}
+
+	if keyword, ok := query["keyword"].(string); ok && keyword != "" {
+		filter["folder_name"] = bson.M{"$regex": primitive.Regex{Pattern: keyword, Options: "i"}}
 	}
 
-	return &folder, nil
+	if folderIDs, ok := query["folder_ids"].([]primitive.ObjectID); ok {
+		filter["_id"] = bson.M{"$in": folderIDs}
+	}
+
+	return filter
 }
 
-func (c *folderRepository) Search(ctx context.Context, query map[string]interface{}, pq *utils.Pagination) (*folder.GetAllFolderResponseDto, error) {
-	// Prepare pagination options
-	skip := int64((pq.Page - 1) * pq.Size)
-	limit := int64(pq.Size)
+// GetAllInScope returns every folder the current user's organizations can see, with what
+// resolving access to them needs
+func (c *folderRepository) GetAllInScope(ctx context.Context) ([]*models.Folder, error) {
+	cursor, err := c.getFoldersCollection().Find(
+		ctx,
+		readScope(ctx, bson.M{}),
+		options.Find().SetProjection(bson.M{"_id": 1, "ancestors": 1, "organization_id": 1, "acl": 1}))
+	if err != nil {
+		c.log.Errorf("(FolderRepository.GetAllInScope) Error fetching folders: %v", err)
+		return nil, errors.Wrap(err, "mongoRepository.Find")
+	}
+	defer cursor.Close(ctx)
 
-	queryFilter := bson.M{}
+	var folders []*models.Folder
+	if err := cursor.All(ctx, &folders); err != nil {
+		c.log.Errorf("(FolderRepository.GetAllInScope) Error fetching folders: %v", err)
+		return nil, errors.Wrap(err, "cursor.All")
+	}
+
+	return folders, nil
+}
 
-	if query["keyword"] != nil {
-		queryFilter = bson.M{
-			"$or": []bson.M{
-				{"folder_name": bson.M{"$regex": primitive.Regex{Pattern: query["keyword"].(string), Options: "i"}}},
-			},
+func (c *folderRepository) GetTree(ctx context.Context, rootID string, maxDepth int) (*folder.GetFolderTreeResponseDto, error) {
+	rootFilter := bson.M{"parent_id": nil}
+	if rootID != "" {
+		objectId, err := primitive.ObjectIDFromHex(rootID)
+		if err != nil {
+			return nil, errors.Wrap(err, "primitive.ObjectIDFromHex")
 		}
+		rootFilter = bson.M{"_id": objectId}
+	}
+
+	graphLookup := bson.M{
+		"from":             c.cfg.Mongo.Collections.Folder,
+		"startWith":        "$_id",
+		"connectFromField": "_id",
+		"connectToField":   "parent_id",
+		"as":               "descendants",
+		"depthField":       "tree_depth",
+	}
+	// $graphLookup counts depth from 0 for direct children, so one extra level is
+	// fetched to know the child count of the deepest folders returned.
+	if maxDepth > 0 {
+		graphLookup["maxDepth"] = maxDepth
+	}
+	if scope := readScope(ctx, bson.M{}); len(scope) > 0 {
+		graphLookup["restrictSearchWithMatch"] = scope
 	}
 
 	aggPipeline := []bson.M{
-		{
-			"$skip": skip,
-		},
-		{
-			"$limit": limit,
-		},
+		{"$match": readScope(ctx, rootFilter)},
+		{"$sort": positionSort},
+		{"$graphLookup": graphLookup},
 	}
 
-	if len(queryFilter) > 0 {
-		aggPipeline = append(aggPipeline, bson.M{
-			"$match": queryFilter,
-		})
-	} else {
-		c.log.Warnf("(TaskRepository.Search) Query filter is empty, skipping $match stage.")
+	cursor, err := c.getFoldersCollection().Aggregate(ctx, aggPipeline)
+	if err != nil {
+		c.log.Errorf("(FolderRepository.GetTree) Error fetching folders: %v", err)
+		return nil, errors.Wrap(err, "mongoRepository.Aggregate")
 	}
+	defer cursor.Close(ctx)
 
-	c.log.Infof("(TaskRepository.Search) Searching for query: %v", aggPipeline)
+	var results []*folderTreeResult
+	if err := cursor.All(ctx, &results); err != nil {
+		c.log.Errorf("(FolderRepository.GetTree) Error fetching folders: %v", err)
+		return nil, errors.Wrap(err, "cursor.All")
+	}
 
-	// Perform the search query on the folders collection
-	cursor, err := c.getFoldersCollection().Aggregate(ctx, aggPipeline)
+	if rootID != "" && len(results) == 0 {
+		return nil, errors.New("folder not found")
+	}
+
+	roots := make([]*models.Folder, 0, len(results))
+	descendants := make([]*models.Folder, 0)
+	childCounts := make(map[primitive.ObjectID]int64)
+	folderIDs := make([]primitive.ObjectID, 0, len(results))
+	for _, r := range results {
+		root := r.Folder
+		roots = append(roots, &root)
+		folderIDs = append(folderIDs, root.ID)
+
+		for _, d := range r.Descendants {
+			if d.ParentID != nil {
+				childCounts[*d.ParentID]++
+			}
+
+			if maxDepth > 0 && d.TreeDepth >= int64(maxDepth) {
+				continue
+			}
+
+			descendant := d.Folder
+			descendants = append(descendants, &descendant)
+			folderIDs = append(folderIDs, descendant.ID)
+		}
+	}
+
+	clusterCounts, err := c.countClustersByFolder(ctx, folderIDs)
 	if err != nil {
-		c.log.Errorf("(FolderRepository.Search) Error fetching folders: %v", err)
+		return nil, err
+	}
+
+	return &folder.GetFolderTreeResponseDto{
+		Folders: mappers.GetFolderTreeFromModels(roots, descendants, childCounts, clusterCounts),
+	}, nil
+}
+
+func (c *folderRepository) GetDescendantIDs(ctx context.Context, folderID string) ([]primitive.ObjectID, error) {
+	objectId, err := primitive.ObjectIDFromHex(folderID)
+	if err != nil {
+		return nil, errors.Wrap(err, "primitive.ObjectIDFromHex")
+	}
+
+	cursor, err := c.getFoldersCollection().Find(
+		ctx,
+		readScope(ctx, bson.M{"ancestors": objectId}),
+		options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		c.log.Errorf("(FolderRepository.GetDescendantIDs) Error fetching folders: %v", err)
+		return nil, errors.Wrap(err, "mongoRepository.Find")
+	}
+	defer cursor.Close(ctx)
+
+	var descendants []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &descendants); err != nil {
+		c.log.Errorf("(FolderRepository.GetDescendantIDs) Error fetching folders: %v", err)
+		return nil, errors.Wrap(err, "cursor.All")
+	}
+
+	ids := make([]primitive.ObjectID, 0, len(descendants))
+	for _, d := range descendants {
+		ids = append(ids, d.ID)
+	}
+
+	return ids, nil
+}
+
+func (c *folderRepository) GetDescendants(ctx context.Context, folderID string) ([]*models.Folder, error) {
+	objectId, err := primitive.ObjectIDFromHex(folderID)
+	if err != nil {
+		return nil, errors.Wrap(err, "primitive.ObjectIDFromHex")
+	}
+
+	cursor, err := c.getFoldersCollection().Find(ctx, readScope(ctx, bson.M{"ancestors": objectId}))
+	if err != nil {
+		c.log.Errorf("(FolderRepository.GetDescendants) Error fetching folders: %v", err)
 		return nil, errors.Wrap(err, "mongoRepository.Find")
 	}
 	defer cursor.Close(ctx)
 
-	// Create a slice to hold the search results
 	var folders []*models.Folder
 	if err := cursor.All(ctx, &folders); err != nil {
-		c.log.Errorf("(FolderRepository.Search) Error fetching folders: %v", err)
+		c.log.Errorf("(FolderRepository.GetDescendants) Error fetching folders: %v", err)
 		return nil, errors.Wrap(err, "cursor.All")
 	}
 
-	// Prepare pagination response
-	totalCount := len(folders)
+	return folders, nil
+}
 
-	return &folder.GetAllFolderResponseDto{
-		Pagination: responses.Pagination{
-			TotalCount: int64(totalCount),
-			TotalPages: int64(pq.GetTotalPages(totalCount)),
-			Page:       int64(pq.GetPage()),
-			Size:       int64(pq.GetSize()),
-			HasMore:    pq.GetHasMore(totalCount),
-		},
-		Folders: mappers.GetAllFoldersFromModels(folders),
+// GetChildren returns the folders directly under parentID, or the root folders when it
+// is nil, in their order
+func (c *folderRepository) GetChildren(ctx context.Context, parentID *primitive.ObjectID) ([]*models.Folder, error) {
+	cursor, err := c.getFoldersCollection().Find(
+		ctx,
+		readScope(ctx, bson.M{"parent_id": parentID}),
+		options.Find().SetSort(positionSort),
+	)
+	if err != nil {
+		c.log.Errorf("(FolderRepository.GetChildren) Error fetching folders: %v", err)
+		return nil, errors.Wrap(err, "mongoRepository.Find")
+	}
+	defer cursor.Close(ctx)
+
+	var folders []*models.Folder
+	if err := cursor.All(ctx, &folders); err != nil {
+		c.log.Errorf("(FolderRepository.GetChildren) Error fetching folders: %v", err)
+		return nil, errors.Wrap(err, "cursor.All")
+	}
+
+	return folders, nil
+}
+
+func (c *folderRepository) GetPath(ctx context.Context, folderID string) (*folder.GetFolderPathResponseDto, error) {
+	current, err := c.GetByID(ctx, folderID)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := c.getFoldersCollection().Find(ctx, readScope(ctx, bson.M{"_id": bson.M{"$in": current.Ancestors}}))
+	if err != nil {
+		c.log.Errorf("(FolderRepository.GetPath) Error fetching folders: %v", err)
+		return nil, errors.Wrap(err, "mongoRepository.Find")
+	}
+	defer cursor.Close(ctx)
+
+	var ancestors []*models.Folder
+	if err := cursor.All(ctx, &ancestors); err != nil {
+		c.log.Errorf("(FolderRepository.GetPath) Error fetching folders: %v", err)
+		return nil, errors.Wrap(err, "cursor.All")
+	}
+
+	// $in doesn't keep the order of the ancestors array
+	byID := make(map[primitive.ObjectID]*models.Folder, len(ancestors))
+	for _, a := range ancestors {
+		byID[a.ID] = a
+	}
+
+	path := make([]*models.Folder, 0, len(current.Ancestors)+1)
+	for _, id := range current.Ancestors {
+		if a, ok := byID[id]; ok {
+			path = append(path, a)
+		}
+	}
+	path = append(path, current)
+
+	return &folder.GetFolderPathResponseDto{
+		Folders: mappers.GetAllFoldersFromModels(path),
 	}, nil
 }
 
+func (c *folderRepository) countClustersByFolder(ctx context.Context, folderIDs []primitive.ObjectID) (map[primitive.ObjectID]int64, error) {
+	counts := make(map[primitive.ObjectID]int64, len(folderIDs))
+	if len(folderIDs) == 0 {
+		return counts, nil
+	}
+
+	aggPipeline := []bson.M{
+		{"$match": readScope(ctx, bson.M{"folder_id": bson.M{"$in": folderIDs}})},
+		{"$group": bson.M{"_id": "$folder_id", "count": bson.M{"$sum": 1}}},
+	}
+
+	cursor, err := c.getClustersCollection().Aggregate(ctx, aggPipeline)
+	if err != nil {
+		c.log.Errorf("(FolderRepository.countClustersByFolder) Error counting clusters: %v", err)
+		return nil, errors.Wrap(err, "mongoRepository.Aggregate")
+	}
+	defer cursor.Close(ctx)
+
+	var groups []struct {
+		FolderID primitive.ObjectID `bson:"_id"`
+		Count    int64              `bson:"count"`
+	}
+	if err := cursor.All(ctx, &groups); err != nil {
+		c.log.Errorf("(FolderRepository.countClustersByFolder) Error counting clusters: %v", err)
+		return nil, errors.Wrap(err, "cursor.All")
+	}
+
+	for _, g := range groups {
+		counts[g.FolderID] = g.Count
+	}
+
+	return counts, nil
+}
+
+// Delete moves a folder to the trash on its own
 func (c *folderRepository) Delete(ctx context.Context, folderID string) (bool, error) {
 	objectId, _ := primitive.ObjectIDFromHex(folderID)
-	res, err := c.getFoldersCollection().DeleteOne(ctx, bson.M{"_id": objectId})
+	res, err := c.getFoldersCollection().UpdateOne(ctx, writeScope(ctx, bson.M{"_id": objectId}), moveToTrash(ctx, objectId))
 	if err != nil {
 		c.log.Errorf("(FolderRepository.Delete) Error deleting cluster: %v", err)
 		return false, err
 	}
 
-	return res.DeletedCount > 0, nil
+	return res.MatchedCount > 0, nil
+}
+
+// DeleteMany moves folders to the trash as part of the trash unit trashID
+func (c *folderRepository) DeleteMany(ctx context.Context, folderIDs []primitive.ObjectID, trashID primitive.ObjectID) (int64, error) {
+	res, err := c.getFoldersCollection().UpdateMany(ctx, writeScope(ctx, bson.M{"_id": bson.M{"$in": folderIDs}}), moveToTrash(ctx, trashID))
+	if err != nil {
+		c.log.Errorf("(FolderRepository.DeleteMany) Error deleting folders: %v", err)
+		return 0, err
+	}
+
+	return res.ModifiedCount, nil
+}
+
+// GetDeleted returns the folders that were deleted on their own, newest first
+func (c *folderRepository) GetDeleted(ctx context.Context) ([]*models.Folder, error) {
+	cursor, err := c.getFoldersCollection().Find(
+		ctx,
+		trashScope(ctx, trashRootFilter),
+		options.Find().SetSort(bson.D{{Key: "deleted_at", Value: -1}}))
+	if err != nil {
+		c.log.Errorf("(FolderRepository.GetDeleted) Error fetching folders: %v", err)
+		return nil, errors.Wrap(err, "mongoRepository.Find")
+	}
+	defer cursor.Close(ctx)
+
+	var folders []*models.Folder
+	if err := cursor.All(ctx, &folders); err != nil {
+		c.log.Errorf("(FolderRepository.GetDeleted) Error fetching folders: %v", err)
+		return nil, errors.Wrap(err, "cursor.All")
+	}
+
+	return folders, nil
+}
+
+// GetDeletedByID returns a folder that was deleted on its own
+func (c *folderRepository) GetDeletedByID(ctx context.Context, folderID string) (*models.Folder, error) {
+	objectId, _ := primitive.ObjectIDFromHex(folderID)
+	var folder models.Folder
+	if err := c.getFoldersCollection().FindOne(ctx, trashScope(ctx, bson.M{"_id": objectId, "trash_id": objectId})).Decode(&folder); err != nil {
+		c.log.Errorf("(FolderRepository.GetDeletedByID) Error fetching folder: %v", err)
+		return nil, err
+	}
+
+	return &folder, nil
+}
+
+// Restore brings back every folder of the trash unit trashID
+func (c *folderRepository) Restore(ctx context.Context, trashID primitive.ObjectID) (int64, error) {
+	res, err := c.getFoldersCollection().UpdateMany(ctx, trashScope(ctx, bson.M{"trash_id": trashID}), restoreFromTrash)
+	if err != nil {
+		c.log.Errorf("(FolderRepository.Restore) Error restoring folders: %v", err)
+		return 0, err
+	}
+
+	return res.ModifiedCount, nil
+}
+
+// Purge permanently deletes every folder of the trash unit trashID
+func (c *folderRepository) Purge(ctx context.Context, trashID primitive.ObjectID) (int64, error) {
+	res, err := c.getFoldersCollection().DeleteMany(ctx, trashScope(ctx, bson.M{"trash_id": trashID}))
+	if err != nil {
+		c.log.Errorf("(FolderRepository.Purge) Error purging folders: %v", err)
+		return 0, err
+	}
+
+	return res.DeletedCount, nil
+}
+
+// PurgeDeletedBefore permanently deletes the folders of every organization that have been
+// in the trash since before the given time
+func (c *folderRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	res, err := c.getFoldersCollection().DeleteMany(ctx, bson.M{"deleted_at": bson.M{"$lt": before}})
+	if err != nil {
+		c.log.Errorf("(FolderRepository.PurgeDeletedBefore) Error purging folders: %v", err)
+		return 0, err
+	}
+
+	return res.DeletedCount, nil
+}
+
+func (c *folderRepository) ChangeParent(ctx context.Context, fromParentID primitive.ObjectID, toParentID *primitive.ObjectID) (int64, error) {
+	res, err := c.getFoldersCollection().UpdateMany(
+		ctx,
+		treeScope(ctx, bson.M{"parent_id": fromParentID}),
+		bson.M{"$set": bson.M{"parent_id": toParentID}})
+	if err != nil {
+		c.log.Errorf("(FolderRepository.ChangeParent) Error updating folders: %v", err)
+		return 0, err
+	}
+
+	return res.ModifiedCount, nil
+}
+
+func (c *folderRepository) RemoveAncestor(ctx context.Context, ancestorID primitive.ObjectID) (int64, error) {
+	res, err := c.getFoldersCollection().UpdateMany(
+		ctx,
+		treeScope(ctx, bson.M{"ancestors": ancestorID}),
+		bson.M{
+			"$pull": bson.M{"ancestors": ancestorID},
+			"$inc":  bson.M{"depth": -1},
+		})
+	if err != nil {
+		c.log.Errorf("(FolderRepository.RemoveAncestor) Error updating folders: %v", err)
+		return 0, err
+	}
+
+	return res.ModifiedCount, nil
+}
+
+func (c *folderRepository) GetByIDs(ctx context.Context, folderIDs []primitive.ObjectID) ([]*models.Folder, error) {
+	if len(folderIDs) == 0 {
+		return []*models.Folder{}, nil
+	}
+
+	cursor, err := c.getFoldersCollection().Find(ctx, readScope(ctx, bson.M{"_id": bson.M{"$in": folderIDs}}))
+	if err != nil {
+		c.log.Errorf("(FolderRepository.GetByIDs) Error fetching folders: %v", err)
+		return nil, errors.Wrap(err, "mongoRepository.Find")
+	}
+	defer cursor.Close(ctx)
+
+	var folders []*models.Folder
+	if err := cursor.All(ctx, &folders); err != nil {
+		c.log.Errorf("(FolderRepository.GetByIDs) Error fetching folders: %v", err)
+		return nil, errors.Wrap(err, "cursor.All")
+	}
+
+	return folders, nil
+}
+
+// SetGrant adds grant to the folder ACL, replacing the grant the same user or role
+// already had
+func (c *folderRepository) SetGrant(ctx context.Context, folderID primitive.ObjectID, grant models.FolderGrant) error {
+	samePrincipal := bson.M{"$and": []interface{}{
+		bson.M{"$eq": []interface{}{bson.M{"$ifNull": []interface{}{"$$this.user_id", ""}}, grant.UserID}},
+		bson.M{"$eq": []interface{}{bson.M{"$ifNull": []interface{}{"$$this.role", ""}}, grant.Role}},
+	}}
+
+	result, err := c.getFoldersCollection().UpdateOne(
+		ctx,
+		writeScope(ctx, bson.M{"_id": folderID}),
+		[]bson.M{
+			{"$set": bson.M{
+				"acl": bson.M{"$concatArrays": []interface{}{
+					bson.M{"$filter": bson.M{
+						"input": bson.M{"$ifNull": []interface{}{"$acl", []interface{}{}}},
+						"cond":  bson.M{"$not": []interface{}{samePrincipal}},
+					}},
+					bson.M{"$literal": []models.FolderGrant{grant}},
+				}},
+			}},
+		})
+	if err != nil {
+		return fmt.Errorf("(FolderRepository.SetGrant) failed to update: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("(FolderRepository.SetGrant) no folder found with ID: %s", folderID.Hex())
+	}
+
+	return nil
+}
+
+// RemoveGrant drops the grant of a user or of a role from the folder ACL and reports
+// whether there was one
+func (c *folderRepository) RemoveGrant(ctx context.Context, folderID primitive.ObjectID, userID string, role string) (bool, error) {
+	principal := bson.M{"role": role}
+	if userID != "" {
+		principal = bson.M{"user_id": userID}
+	}
+
+	result, err := c.getFoldersCollection().UpdateOne(
+		ctx,
+		writeScope(ctx, bson.M{"_id": folderID}),
+		bson.M{"$pull": bson.M{"acl": principal}})
+	if err != nil {
+		c.log.Errorf("(FolderRepository.RemoveGrant) Error updating folder: %v", err)
+		return false, err
+	}
+
+	if result.MatchedCount == 0 {
+		return false, fmt.Errorf("(FolderRepository.RemoveGrant) no folder found with ID: %s", folderID.Hex())
+	}
+
+	return result.ModifiedCount > 0, nil
+}
+
+func (c *folderRepository) GetNextPosition(ctx context.Context, parentID *primitive.ObjectID) (int64, error) {
+	position, err := nextPosition(ctx, c.getFoldersCollection(), readScope(ctx, bson.M{"parent_id": parentID}))
+	if err != nil {
+		c.log.Errorf("(FolderRepository.GetNextPosition) Error fetching position: %v", err)
+		return 0, err
+	}
+
+	return position, nil
+}
+
+func (c *folderRepository) Reorder(ctx context.Context, parentID *primitive.ObjectID, folderIDs []primitive.ObjectID) error {
+	if err := reorder(ctx, c.getFoldersCollection(), writeScope(ctx, bson.M{"parent_id": parentID}), folderIDs); err != nil {
+		c.log.Errorf("(FolderRepository.Reorder) Error reordering folders: %v", err)
+		return errors.Wrap(err, "folder")
+	}
+
+	return nil
 }
 
 func (c *folderRepository) Exists(ctx context.Context, query map[string]interface{}) (bool, error) {
-	count, err := c.getFoldersCollection().CountDocuments(ctx, query)
+	count, err := c.getFoldersCollection().CountDocuments(ctx, readScope(ctx, query))
 	if err != nil {
 		c.log.Errorf("(FolderRepository.Exists) Error counting folders: %v", err)
 		return false, err
@@ -220,3 +759,7 @@ func (c *folderRepository) Exists(ctx context.Context, query map[string]interfac
 func (c *folderRepository) getFoldersCollection() *mongo.Collection {
 	return c.db.Database(c.cfg.Mongo.Db).Collection(c.cfg.Mongo.Collections.Folder)
 }
+
+func (c *folderRepository) getClustersCollection() *mongo.Collection {
+	return c.db.Database(c.cfg.Mongo.Db).Collection(c.cfg.Mongo.Collections.Cluster)
+}
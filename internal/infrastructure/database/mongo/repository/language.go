@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"gallery-service/internal/pkg/constants"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// setLanguage replaces the language_config entry of the document for entry's language,
+// or appends entry when the document has none, and reports whether it was appended
+func setLanguage(ctx context.Context, collection *mongo.Collection, id primitive.ObjectID, language constants.Language, entry interface{}) (bool, error) {
+	replaced, err := collection.UpdateOne(
+		ctx,
+		writeScope(ctx, bson.M{"_id": id, "language_config.language": language}),
+		bson.M{"$set": bson.M{"language_config.$": entry, "updated_at": time.Now()}})
+	if err != nil {
+		return false, errors.Wrap(err, "mongoRepository.UpdateOne")
+	}
+
+	if replaced.MatchedCount > 0 {
+		return false, nil
+	}
+
+	// The $ne guard keeps two concurrent requests from appending the language twice
+	added, err := collection.UpdateOne(
+		ctx,
+		writeScope(ctx, bson.M{"_id": id, "language_config.language": bson.M{"$ne": language}}),
+		bson.M{
+			"$push": bson.M{"language_config": entry},
+			"$set":  bson.M{"updated_at": time.Now()},
+		})
+	if err != nil {
+		return false, errors.Wrap(err, "mongoRepository.UpdateOne")
+	}
+
+	if added.MatchedCount == 0 {
+		return false, errors.Errorf("no document found with ID: %s", id.Hex())
+	}
+
+	return true, nil
+}
+
+// removeLanguage removes the language_config entries of the document for language and
+// reports whether there was one
+func removeLanguage(ctx context.Context, collection *mongo.Collection, id primitive.ObjectID, language constants.Language) (bool, error) {
+	res, err := collection.UpdateOne(
+		ctx,
+		writeScope(ctx, bson.M{"_id": id, "language_config.language": language}),
+		bson.M{
+			"$pull": bson.M{"language_config": bson.M{"language": language}},
+			"$set":  bson.M{"updated_at": time.Now()},
+		})
+	if err != nil {
+		return false, errors.Wrap(err, "mongoRepository.UpdateOne")
+	}
+
+	return res.ModifiedCount > 0, nil
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"gallery-service/config"
+	"gallery-service/pkg/zap"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// leaseRepository stores the leases that let a single replica do the work they guard.
+// Leases belong to the service rather than to an organization and are not tenant scoped.
+type leaseRepository struct {
+	log zap.Logger
+	cfg *config.Config
+	db  *mongo.Client
+}
+
+var (
+	leaseRepo *leaseRepository
+)
+
+func NewLeaseRepository(log zap.Logger, cfg *config.Config, db *mongo.Client) *leaseRepository {
+	if leaseRepo == nil {
+		leaseRepo = &leaseRepository{log: log, cfg: cfg, db: db}
+	}
+
+	return leaseRepo
+}
+
+// Acquire takes the lease for holder, or renews it when holder already has it, and
+// reports whether holder has it now. A lease held by another holder is only taken once
+// it has expired.
+func (l *leaseRepository) Acquire(ctx context.Context, name string, holder string, ttl time.Duration) (bool, error) {
+	now := time.Now()
+
+	_, err := l.getLeasesCollection().UpdateOne(
+		ctx,
+		bson.M{
+			"_id": name,
+			"$or": []bson.M{
+				{"holder": holder},
+				{"expires_at": bson.M{"$lte": now}},
+			},
+		},
+		bson.M{"$set": bson.M{"holder": holder, "expires_at": now.Add(ttl)}},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		// The lease exists and is held by someone else, so the upsert ran into it
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+
+		l.log.Errorf("(LeaseRepository.Acquire) Error acquiring lease: %v", err)
+		return false, errors.Wrap(err, "mongoRepository.UpdateOne")
+	}
+
+	return true, nil
+}
+
+// Release gives the lease up if holder has it, so that another replica can take it
+// without waiting for it to expire
+func (l *leaseRepository) Release(ctx context.Context, name string, holder string) error {
+	if _, err := l.getLeasesCollection().DeleteOne(ctx, bson.M{"_id": name, "holder": holder}); err != nil {
+		l.log.Errorf("(LeaseRepository.Release) Error releasing lease: %v", err)
+		return errors.Wrap(err, "mongoRepository.DeleteOne")
+	}
+
+	return nil
+}
+
+func (l *leaseRepository) getLeasesCollection() *mongo.Collection {
+	return l.db.Database(l.cfg.Mongo.Db).Collection(l.cfg.Mongo.Collections.Lease)
+}
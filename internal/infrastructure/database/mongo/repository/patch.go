@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// patchUpdate builds the update that sets and unsets only the given fields
+func patchUpdate(set map[string]interface{}, unset []string) bson.M {
+	update := bson.M{}
+	if len(set) > 0 {
+		update["$set"] = set
+	}
+
+	if len(unset) > 0 {
+		fields := bson.M{}
+		for _, field := range unset {
+			fields[field] = ""
+		}
+		update["$unset"] = fields
+	}
+
+	return update
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	httpPkg "gallery-service/pkg/http"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// positionSort is the default order of folders, clusters and topics inside their parent
+var positionSort = bson.D{{Key: "position", Value: 1}, {Key: "_id", Value: 1}}
+
+// nextPosition returns the position that places a new document after every sibling
+// matched by siblingFilter
+func nextPosition(ctx context.Context, collection *mongo.Collection, siblingFilter bson.M) (int64, error) {
+	var last struct {
+		Position int64 `bson:"position"`
+	}
+
+	err := collection.FindOne(
+		ctx,
+		siblingFilter,
+		options.FindOne().SetSort(bson.D{{Key: "position", Value: -1}}).SetProjection(bson.M{"position": 1}),
+	).Decode(&last)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, errors.Wrap(err, "mongoRepository.FindOne")
+	}
+
+	return last.Position + 1, nil
+}
+
+// reorder gives ids the positions 0..n-1 and moves the remaining siblings after them,
+// keeping their current relative order. Every id must be one of the siblings, an id that
+// is not is a bad request.
+func reorder(ctx context.Context, collection *mongo.Collection, siblingFilter bson.M, ids []primitive.ObjectID) error {
+	cursor, err := collection.Find(
+		ctx,
+		siblingFilter,
+		options.Find().SetSort(positionSort).SetProjection(bson.M{"_id": 1}),
+	)
+	if err != nil {
+		return errors.Wrap(err, "mongoRepository.Find")
+	}
+	defer cursor.Close(ctx)
+
+	var siblings []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &siblings); err != nil {
+		return errors.Wrap(err, "cursor.All")
+	}
+
+	isSibling := make(map[primitive.ObjectID]bool, len(siblings))
+	for _, s := range siblings {
+		isSibling[s.ID] = true
+	}
+
+	ordered := make([]primitive.ObjectID, 0, len(siblings))
+	listed := make(map[primitive.ObjectID]bool, len(ids))
+	for _, id := range ids {
+		if !isSibling[id] {
+			return errors.Wrapf(httpPkg.BadRequest, "%s not found in parent", id.Hex())
+		}
+		if listed[id] {
+			continue
+		}
+
+		listed[id] = true
+		ordered = append(ordered, id)
+	}
+
+	for _, s := range siblings {
+		if !listed[s.ID] {
+			ordered = append(ordered, s.ID)
+		}
+	}
+
+	if len(ordered) == 0 {
+		return nil
+	}
+
+	writes := make([]mongo.WriteModel, 0, len(ordered))
+	for i, id := range ordered {
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": id}).
+			SetUpdate(bson.M{"$set": bson.M{"position": int64(i)}}))
+	}
+
+	if _, err := collection.BulkWrite(ctx, writes); err != nil {
+		return errors.Wrap(err, "mongoRepository.BulkWrite")
+	}
+
+	return nil
+}
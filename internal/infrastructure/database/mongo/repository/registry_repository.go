@@ -0,0 +1,248 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"gallery-service/config"
+	"gallery-service/internal/domain/models"
+	"gallery-service/pkg/zap"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// registryRepository stores the languages and components content can use. Like the
+// taxonomy, they are shared by every organization, so reads and writes are not tenant
+// scoped.
+type registryRepository struct {
+	log zap.Logger
+	cfg *config.Config
+	db  *mongo.Client
+}
+
+var (
+	registryRepo *registryRepository
+)
+
+func NewRegistryRepository(log zap.Logger, cfg *config.Config, db *mongo.Client) *registryRepository {
+	if registryRepo == nil {
+		registryRepo = &registryRepository{log: log, cfg: cfg, db: db}
+	}
+
+	return registryRepo
+}
+
+func (r *registryRepository) InsertLanguage(ctx context.Context, language *models.GalleryLanguage) (string, error) {
+	language.CreatedAt = time.Now()
+	language.UpdatedAt = language.CreatedAt
+
+	insertResult, err := r.getLanguagesCollection().InsertOne(ctx, language, &options.InsertOneOptions{})
+	if err != nil {
+		r.log.Errorf("(RegistryRepository.InsertLanguage) Error inserting language: %v", err)
+		return "", err
+	}
+
+	return insertResult.InsertedID.(primitive.ObjectID).Hex(), nil
+}
+
+// UpdateLanguage saves the display names, position and enabled flag of the language. Its
+// code and name do not change.
+func (r *registryRepository) UpdateLanguage(ctx context.Context, language *models.GalleryLanguage) error {
+	result, err := r.getLanguagesCollection().UpdateOne(
+		ctx,
+		bson.M{"code": language.Code},
+		bson.M{"$set": bson.M{
+			"display_names": language.DisplayNames,
+			"position":      language.Position,
+			"enabled":       language.Enabled,
+			"updated_at":    time.Now(),
+		}})
+	if err != nil {
+		return fmt.Errorf("(RegistryRepository.UpdateLanguage) failed to update: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("(RegistryRepository.UpdateLanguage) no language found with code: %s", language.Code)
+	}
+
+	return nil
+}
+
+// GetLanguages returns every language, enabled or not, in display order
+func (r *registryRepository) GetLanguages(ctx context.Context) ([]*models.GalleryLanguage, error) {
+	cursor, err := r.getLanguagesCollection().Find(ctx, bson.M{}, options.Find().SetSort(registryOrder("code")))
+	if err != nil {
+		r.log.Errorf("(RegistryRepository.GetLanguages) Error fetching languages: %v", err)
+		return nil, errors.Wrap(err, "mongoRepository.Find")
+	}
+	defer cursor.Close(ctx)
+
+	languages := make([]*models.GalleryLanguage, 0)
+	if err := cursor.All(ctx, &languages); err != nil {
+		r.log.Errorf("(RegistryRepository.GetLanguages) Error fetching languages: %v", err)
+		return nil, errors.Wrap(err, "cursor.All")
+	}
+
+	return languages, nil
+}
+
+func (r *registryRepository) GetLanguageByCode(ctx context.Context, code string) (*models.GalleryLanguage, error) {
+	var language models.GalleryLanguage
+	if err := r.getLanguagesCollection().FindOne(ctx, bson.M{"code": code}).Decode(&language); err != nil {
+		r.log.Errorf("(RegistryRepository.GetLanguageByCode) Error fetching language: %v", err)
+		return nil, err
+	}
+
+	return &language, nil
+}
+
+func (r *registryRepository) DeleteLanguage(ctx context.Context, code string) (bool, error) {
+	res, err := r.getLanguagesCollection().DeleteOne(ctx, bson.M{"code": code})
+	if err != nil {
+		r.log.Errorf("(RegistryRepository.DeleteLanguage) Error deleting language: %v", err)
+		return false, err
+	}
+
+	return res.DeletedCount > 0, nil
+}
+
+// SeedLanguages stores languages when there are none yet and reports how many it stored.
+// Replicas starting together may both find the collection empty, the unique index on the
+// code keeps the second one from adding them twice.
+func (r *registryRepository) SeedLanguages(ctx context.Context, languages []*models.GalleryLanguage) (int64, error) {
+	documents := make([]interface{}, 0, len(languages))
+	for _, language := range languages {
+		language.CreatedAt = time.Now()
+		language.UpdatedAt = language.CreatedAt
+		documents = append(documents, language)
+	}
+
+	return r.seed(ctx, r.getLanguagesCollection(), documents)
+}
+
+func (r *registryRepository) InsertComponent(ctx context.Context, component *models.GalleryComponent) (string, error) {
+	component.CreatedAt = time.Now()
+	component.UpdatedAt = component.CreatedAt
+
+	insertResult, err := r.getComponentsCollection().InsertOne(ctx, component, &options.InsertOneOptions{})
+	if err != nil {
+		r.log.Errorf("(RegistryRepository.InsertComponent) Error inserting component: %v", err)
+		return "", err
+	}
+
+	return insertResult.InsertedID.(primitive.ObjectID).Hex(), nil
+}
+
+// UpdateComponent saves the display names, media requirements, position and enabled flag
+// of the component. Its key and name do not change.
+func (r *registryRepository) UpdateComponent(ctx context.Context, component *models.GalleryComponent) error {
+	result, err := r.getComponentsCollection().UpdateOne(
+		ctx,
+		bson.M{"key": component.Key},
+		bson.M{"$set": bson.M{
+			"display_names": component.DisplayNames,
+			"media":         component.Media,
+			"position":      component.Position,
+			"enabled":       component.Enabled,
+			"updated_at":    time.Now(),
+		}})
+	if err != nil {
+		return fmt.Errorf("(RegistryRepository.UpdateComponent) failed to update: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("(RegistryRepository.UpdateComponent) no component found with key: %s", component.Key)
+	}
+
+	return nil
+}
+
+// GetComponents returns every component, enabled or not, in display order
+func (r *registryRepository) GetComponents(ctx context.Context) ([]*models.GalleryComponent, error) {
+	cursor, err := r.getComponentsCollection().Find(ctx, bson.M{}, options.Find().SetSort(registryOrder("key")))
+	if err != nil {
+		r.log.Errorf("(RegistryRepository.GetComponents) Error fetching components: %v", err)
+		return nil, errors.Wrap(err, "mongoRepository.Find")
+	}
+	defer cursor.Close(ctx)
+
+	components := make([]*models.GalleryComponent, 0)
+	if err := cursor.All(ctx, &components); err != nil {
+		r.log.Errorf("(RegistryRepository.GetComponents) Error fetching components: %v", err)
+		return nil, errors.Wrap(err, "cursor.All")
+	}
+
+	return components, nil
+}
+
+func (r *registryRepository) GetComponentByKey(ctx context.Context, key string) (*models.GalleryComponent, error) {
+	var component models.GalleryComponent
+	if err := r.getComponentsCollection().FindOne(ctx, bson.M{"key": key}).Decode(&component); err != nil {
+		r.log.Errorf("(RegistryRepository.GetComponentByKey) Error fetching component: %v", err)
+		return nil, err
+	}
+
+	return &component, nil
+}
+
+func (r *registryRepository) DeleteComponent(ctx context.Context, key string) (bool, error) {
+	res, err := r.getComponentsCollection().DeleteOne(ctx, bson.M{"key": key})
+	if err != nil {
+		r.log.Errorf("(RegistryRepository.DeleteComponent) Error deleting component: %v", err)
+		return false, err
+	}
+
+	return res.DeletedCount > 0, nil
+}
+
+// SeedComponents stores components when there are none yet and reports how many it
+// stored, the same way as SeedLanguages
+func (r *registryRepository) SeedComponents(ctx context.Context, components []*models.GalleryComponent) (int64, error) {
+	documents := make([]interface{}, 0, len(components))
+	for _, component := range components {
+		component.CreatedAt = time.Now()
+		component.UpdatedAt = component.CreatedAt
+		documents = append(documents, component)
+	}
+
+	return r.seed(ctx, r.getComponentsCollection(), documents)
+}
+
+func (r *registryRepository) seed(ctx context.Context, collection *mongo.Collection, documents []interface{}) (int64, error) {
+	count, err := collection.CountDocuments(ctx, bson.M{})
+	if err != nil {
+		return 0, errors.Wrap(err, "mongoRepository.CountDocuments")
+	}
+
+	if count > 0 || len(documents) == 0 {
+		return 0, nil
+	}
+
+	res, err := collection.InsertMany(ctx, documents, options.InsertMany().SetOrdered(false))
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		return 0, errors.Wrap(err, "mongoRepository.InsertMany")
+	}
+
+	if res == nil {
+		return 0, nil
+	}
+
+	return int64(len(res.InsertedIDs)), nil
+}
+
+// registryOrder sorts entries by position, then by key for entries at the same position
+func registryOrder(key string) bson.D {
+	return bson.D{{Key: "position", Value: 1}, {Key: key, Value: 1}}
+}
+
+func (r *registryRepository) getLanguagesCollection() *mongo.Collection {
+	return r.db.Database(r.cfg.Mongo.Db).Collection(r.cfg.Mongo.Collections.Language)
+}
+
+func (r *registryRepository) getComponentsCollection() *mongo.Collection {
+	return r.db.Database(r.cfg.Mongo.Db).Collection(r.cfg.Mongo.Collections.Component)
+}
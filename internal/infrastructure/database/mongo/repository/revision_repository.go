@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"gallery-service/config"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type revisionRepository struct {
+	log zap.Logger
+	cfg *config.Config
+	db  *mongo.Client
+}
+
+var (
+	revisionRepo *revisionRepository
+)
+
+func NewRevisionRepository(log zap.Logger, cfg *config.Config, db *mongo.Client) *revisionRepository {
+	if revisionRepo == nil {
+		revisionRepo = &revisionRepository{log: log, cfg: cfg, db: db}
+	}
+
+	return revisionRepo
+}
+
+// Insert stores the revision under the next number of its document and returns that
+// number. Call it inside the transaction that changes the document.
+func (r *revisionRepository) Insert(ctx context.Context, revision *models.Revision) (int64, error) {
+	var last struct {
+		Number int64 `bson:"number"`
+	}
+
+	err := r.getRevisionsCollection().FindOne(
+		ctx,
+		bson.M{"entity_type": revision.EntityType, "entity_id": revision.EntityID},
+		options.FindOne().SetSort(bson.D{{Key: "number", Value: -1}}).SetProjection(bson.M{"number": 1}),
+	).Decode(&last)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		r.log.Errorf("(RevisionRepository.Insert) Error fetching last revision: %v", err)
+		return 0, errors.Wrap(err, "mongoRepository.FindOne")
+	}
+
+	revision.Number = last.Number + 1
+	if _, err := r.getRevisionsCollection().InsertOne(ctx, revision); err != nil {
+		r.log.Errorf("(RevisionRepository.Insert) Error inserting revision: %v", err)
+		return 0, err
+	}
+
+	return revision.Number, nil
+}
+
+// GetAll returns the revisions of a document, newest first
+func (r *revisionRepository) GetAll(ctx context.Context, entityType constants.RevisionEntity, entityID primitive.ObjectID) ([]*models.Revision, error) {
+	cursor, err := r.getRevisionsCollection().Find(
+		ctx,
+		readScope(ctx, bson.M{"entity_type": entityType, "entity_id": entityID}),
+		options.Find().SetSort(bson.D{{Key: "number", Value: -1}}),
+	)
+	if err != nil {
+		r.log.Errorf("(RevisionRepository.GetAll) Error fetching revisions: %v", err)
+		return nil, errors.Wrap(err, "mongoRepository.Find")
+	}
+	defer cursor.Close(ctx)
+
+	revisions := make([]*models.Revision, 0)
+	if err := cursor.All(ctx, &revisions); err != nil {
+		r.log.Errorf("(RevisionRepository.GetAll) Error decoding revisions: %v", err)
+		return nil, errors.Wrap(err, "cursor.All")
+	}
+
+	return revisions, nil
+}
+
+func (r *revisionRepository) GetByNumber(ctx context.Context, entityType constants.RevisionEntity, entityID primitive.ObjectID, number int64) (*models.Revision, error) {
+	var revision models.Revision
+	err := r.getRevisionsCollection().FindOne(
+		ctx,
+		readScope(ctx, bson.M{"entity_type": entityType, "entity_id": entityID, "number": number}),
+	).Decode(&revision)
+	if err != nil {
+		r.log.Errorf("(RevisionRepository.GetByNumber) Error fetching revision: %v", err)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, errors.Errorf("revision %d not found", number)
+		}
+		return nil, err
+	}
+
+	return &revision, nil
+}
+
+// purgeWithRevisions permanently deletes the documents of collection matched by filter and
+// the revisions recorded for them, so that no history outlives its document. The
+// revisions go first: a purge that fails halfway leaves documents without history to be
+// purged again, never history without its document.
+func purgeWithRevisions(
+	ctx context.Context,
+	collection *mongo.Collection,
+	revisions *mongo.Collection,
+	entityType constants.RevisionEntity,
+	filter bson.M,
+) (int64, error) {
+	cursor, err := collection.Find(ctx, filter, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return 0, errors.Wrap(err, "mongoRepository.Find")
+	}
+	defer cursor.Close(ctx)
+
+	var docs []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &docs); err != nil {
+		return 0, errors.Wrap(err, "cursor.All")
+	}
+
+	if len(docs) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]primitive.ObjectID, 0, len(docs))
+	for _, d := range docs {
+		ids = append(ids, d.ID)
+	}
+
+	if _, err := revisions.DeleteMany(ctx, bson.M{"entity_type": entityType, "entity_id": bson.M{"$in": ids}}); err != nil {
+		return 0, errors.Wrap(err, "mongoRepository.DeleteMany")
+	}
+
+	res, err := collection.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		return 0, errors.Wrap(err, "mongoRepository.DeleteMany")
+	}
+
+	return res.DeletedCount, nil
+}
+
+func (r *revisionRepository) getRevisionsCollection() *mongo.Collection {
+	return r.db.Database(r.cfg.Mongo.Db).Collection(r.cfg.Mongo.Collections.Revision)
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// scheduleUpdate adds publish_at and unpublish_at to the fields set, unsetting the ones
+// left nil
+func scheduleUpdate(set bson.M, publishAt *time.Time, unpublishAt *time.Time) bson.M {
+	unset := make([]string, 0, 2)
+	for field, at := range map[string]*time.Time{"publish_at": publishAt, "unpublish_at": unpublishAt} {
+		if at != nil {
+			set[field] = *at
+		} else {
+			unset = append(unset, field)
+		}
+	}
+
+	return patchUpdate(set, unset)
+}
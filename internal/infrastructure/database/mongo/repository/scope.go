@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"gallery-service/internal/pkg/tenant"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// readScope narrows filter to what the current user can see: the content of their
+// organizations and the global content, leaving out what is in the trash
+func readScope(ctx context.Context, filter bson.M) bson.M {
+	return withField(readOrganizations(ctx, filter), "deleted_at", nil)
+}
+
+// writeScope narrows filter to what the current user can change: the content of the
+// organization they act for that is not in the trash. Global content is left to the
+// SuperAdmin.
+func writeScope(ctx context.Context, filter bson.M) bson.M {
+	return withField(writeOrganizations(ctx, filter), "deleted_at", nil)
+}
+
+// trashScope narrows filter to the deleted content of the organization the current user
+// acts for
+func trashScope(ctx context.Context, filter bson.M) bson.M {
+	return withField(writeOrganizations(ctx, filter), "deleted_at", bson.M{"$ne": nil})
+}
+
+// treeScope is writeScope without the trash filter. Rewriting the tree goes through it so
+// that trashed folders and clusters keep a valid place to be restored to.
+func treeScope(ctx context.Context, filter bson.M) bson.M {
+	return writeOrganizations(ctx, filter)
+}
+
+// readStage is the $match stage that scopes an aggregation to readable content
+func readStage(ctx context.Context) bson.M {
+	return bson.M{"$match": readScope(ctx, bson.M{})}
+}
+
+func readOrganizations(ctx context.Context, filter bson.M) bson.M {
+	scope := tenant.FromContext(ctx)
+	if scope.Unrestricted() {
+		return filter
+	}
+
+	organizationIDs := bson.A{nil}
+	for _, id := range scope.OrganizationIDs() {
+		organizationIDs = append(organizationIDs, id)
+	}
+
+	return withField(filter, "organization_id", bson.M{"$in": organizationIDs})
+}
+
+func writeOrganizations(ctx context.Context, filter bson.M) bson.M {
+	scope := tenant.FromContext(ctx)
+	if scope.Unrestricted() {
+		return filter
+	}
+
+	organizationID := scope.OrganizationID()
+	if organizationID == "" {
+		return withField(filter, "organization_id", bson.M{"$in": bson.A{}})
+	}
+
+	return withField(filter, "organization_id", organizationID)
+}
+
+func withField(filter bson.M, key string, value interface{}) bson.M {
+	scoped := make(bson.M, len(filter)+1)
+	for k, v := range filter {
+		scoped[k] = v
+	}
+	scoped[key] = value
+
+	return scoped
+}
@@ -0,0 +1,293 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"gallery-service/config"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/pkg/zap"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// taxonomyRepository stores the taxonomy terms. The taxonomy is shared by every
+// organization, so its reads and writes are not tenant scoped.
+type taxonomyRepository struct {
+	log zap.Logger
+	cfg *config.Config
+	db  *mongo.Client
+}
+
+var (
+	taxonomyRepo *taxonomyRepository
+)
+
+func NewTaxonomyRepository(log zap.Logger, cfg *config.Config, db *mongo.Client) *taxonomyRepository {
+	if taxonomyRepo == nil {
+		taxonomyRepo = &taxonomyRepository{log: log, cfg: cfg, db: db}
+	}
+
+	return taxonomyRepo
+}
+
+func (t *taxonomyRepository) Insert(ctx context.Context, term *models.TaxonomyTerm) (string, error) {
+	term.CreatedAt = time.Now()
+	term.UpdatedAt = term.CreatedAt
+
+	insertResult, err := t.getTaxonomyCollection().InsertOne(ctx, term, &options.InsertOneOptions{})
+	if err != nil {
+		t.log.Errorf("(TaxonomyRepository.Insert) Error inserting term: %v", err)
+		return "", err
+	}
+
+	return insertResult.InsertedID.(primitive.ObjectID).Hex(), nil
+}
+
+func (t *taxonomyRepository) Update(ctx context.Context, term *models.TaxonomyTerm) error {
+	result, err := t.getTaxonomyCollection().UpdateOne(
+		ctx,
+		bson.M{"_id": term.ID},
+		bson.M{"$set": bson.M{"name": term.Name, "updated_at": time.Now()}})
+	if err != nil {
+		return fmt.Errorf("(TaxonomyRepository.Update) failed to update: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("(TaxonomyRepository.Update) no taxonomy term found with ID: %s", term.ID.Hex())
+	}
+
+	return nil
+}
+
+// UpdateParent moves a term under parentID at the given position and rewrites the
+// ancestor path of the term and of every term below it. Call it inside a transaction.
+func (t *taxonomyRepository) UpdateParent(ctx context.Context, termID primitive.ObjectID, parentID *primitive.ObjectID, ancestors []primitive.ObjectID, position int64) error {
+	if ancestors == nil {
+		ancestors = []primitive.ObjectID{}
+	}
+
+	result, err := t.getTaxonomyCollection().UpdateOne(
+		ctx,
+		bson.M{"_id": termID},
+		bson.M{"$set": bson.M{
+			"parent_id":  parentID,
+			"ancestors":  ancestors,
+			"depth":      len(ancestors),
+			"position":   position,
+			"updated_at": time.Now(),
+		}})
+	if err != nil {
+		return fmt.Errorf("(TaxonomyRepository.UpdateParent) failed to update: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("(TaxonomyRepository.UpdateParent) no taxonomy term found with ID: %s", termID.Hex())
+	}
+
+	// Same rewrite as for folders: descendants keep the part of their path below the
+	// moved term and get the moved term's new path in front of it.
+	prefix := append(append([]primitive.ObjectID{}, ancestors...), termID)
+	_, err = t.getTaxonomyCollection().UpdateMany(
+		ctx,
+		bson.M{"ancestors": termID},
+		[]bson.M{
+			{"$set": bson.M{
+				"ancestors": bson.M{"$concatArrays": []interface{}{
+					prefix,
+					bson.M{"$slice": []interface{}{
+						"$ancestors",
+						bson.M{"$add": []interface{}{bson.M{"$indexOfArray": []interface{}{"$ancestors", termID}}, 1}},
+						bson.M{"$size": "$ancestors"},
+					}},
+				}},
+			}},
+			{"$set": bson.M{"depth": bson.M{"$size": "$ancestors"}}},
+		})
+	if err != nil {
+		return fmt.Errorf("(TaxonomyRepository.UpdateParent) failed to update descendants: %w", err)
+	}
+
+	return nil
+}
+
+// GetAll returns the terms of one kind, or of every kind when kind is empty, ordered
+// by depth then position so that parents come before their children
+func (t *taxonomyRepository) GetAll(ctx context.Context, kind constants.TaxonomyKind) ([]*models.TaxonomyTerm, error) {
+	filter := bson.M{}
+	if kind != "" {
+		filter["kind"] = kind
+	}
+
+	cursor, err := t.getTaxonomyCollection().Find(
+		ctx,
+		filter,
+		options.Find().SetSort(bson.D{{Key: "depth", Value: 1}, {Key: "position", Value: 1}, {Key: "_id", Value: 1}}))
+	if err != nil {
+		t.log.Errorf("(TaxonomyRepository.GetAll) Error fetching terms: %v", err)
+		return nil, errors.Wrap(err, "mongoRepository.Find")
+	}
+	defer cursor.Close(ctx)
+
+	terms := make([]*models.TaxonomyTerm, 0)
+	if err := cursor.All(ctx, &terms); err != nil {
+		t.log.Errorf("(TaxonomyRepository.GetAll) Error fetching terms: %v", err)
+		return nil, errors.Wrap(err, "cursor.All")
+	}
+
+	return terms, nil
+}
+
+func (t *taxonomyRepository) GetByID(ctx context.Context, termID string) (*models.TaxonomyTerm, error) {
+	objectId, _ := primitive.ObjectIDFromHex(termID)
+	var term models.TaxonomyTerm
+	if err := t.getTaxonomyCollection().FindOne(ctx, bson.M{"_id": objectId}).Decode(&term); err != nil {
+		t.log.Errorf("(TaxonomyRepository.GetByID) Error fetching term: %v", err)
+		return nil, err
+	}
+
+	return &term, nil
+}
+
+func (t *taxonomyRepository) GetByIDs(ctx context.Context, termIDs []primitive.ObjectID) ([]*models.TaxonomyTerm, error) {
+	if len(termIDs) == 0 {
+		return []*models.TaxonomyTerm{}, nil
+	}
+
+	cursor, err := t.getTaxonomyCollection().Find(ctx, bson.M{"_id": bson.M{"$in": termIDs}})
+	if err != nil {
+		t.log.Errorf("(TaxonomyRepository.GetByIDs) Error fetching terms: %v", err)
+		return nil, errors.Wrap(err, "mongoRepository.Find")
+	}
+	defer cursor.Close(ctx)
+
+	var terms []*models.TaxonomyTerm
+	if err := cursor.All(ctx, &terms); err != nil {
+		t.log.Errorf("(TaxonomyRepository.GetByIDs) Error fetching terms: %v", err)
+		return nil, errors.Wrap(err, "cursor.All")
+	}
+
+	return terms, nil
+}
+
+// GetSubtreeIDs returns, for each of termIDs that exists, its id followed by the ids of
+// every term below it
+func (t *taxonomyRepository) GetSubtreeIDs(ctx context.Context, termIDs []primitive.ObjectID) (map[primitive.ObjectID][]primitive.ObjectID, error) {
+	subtrees := make(map[primitive.ObjectID][]primitive.ObjectID, len(termIDs))
+	if len(termIDs) == 0 {
+		return subtrees, nil
+	}
+
+	cursor, err := t.getTaxonomyCollection().Find(
+		ctx,
+		bson.M{"$or": []bson.M{
+			{"_id": bson.M{"$in": termIDs}},
+			{"ancestors": bson.M{"$in": termIDs}},
+		}},
+		options.Find().SetProjection(bson.M{"_id": 1, "ancestors": 1}))
+	if err != nil {
+		t.log.Errorf("(TaxonomyRepository.GetSubtreeIDs) Error fetching terms: %v", err)
+		return nil, errors.Wrap(err, "mongoRepository.Find")
+	}
+	defer cursor.Close(ctx)
+
+	var terms []struct {
+		ID        primitive.ObjectID   `bson:"_id"`
+		Ancestors []primitive.ObjectID `bson:"ancestors"`
+	}
+	if err := cursor.All(ctx, &terms); err != nil {
+		t.log.Errorf("(TaxonomyRepository.GetSubtreeIDs) Error fetching terms: %v", err)
+		return nil, errors.Wrap(err, "cursor.All")
+	}
+
+	requested := make(map[primitive.ObjectID]bool, len(termIDs))
+	for _, id := range termIDs {
+		requested[id] = true
+	}
+
+	for _, term := range terms {
+		if requested[term.ID] {
+			subtrees[term.ID] = []primitive.ObjectID{term.ID}
+		}
+	}
+
+	for _, term := range terms {
+		for _, ancestor := range term.Ancestors {
+			if _, ok := subtrees[ancestor]; ok {
+				subtrees[ancestor] = append(subtrees[ancestor], term.ID)
+			}
+		}
+	}
+
+	return subtrees, nil
+}
+
+func (t *taxonomyRepository) GetDescendantIDs(ctx context.Context, termID primitive.ObjectID) ([]primitive.ObjectID, error) {
+	cursor, err := t.getTaxonomyCollection().Find(
+		ctx,
+		bson.M{"ancestors": termID},
+		options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		t.log.Errorf("(TaxonomyRepository.GetDescendantIDs) Error fetching terms: %v", err)
+		return nil, errors.Wrap(err, "mongoRepository.Find")
+	}
+	defer cursor.Close(ctx)
+
+	var descendants []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &descendants); err != nil {
+		t.log.Errorf("(TaxonomyRepository.GetDescendantIDs) Error fetching terms: %v", err)
+		return nil, errors.Wrap(err, "cursor.All")
+	}
+
+	ids := make([]primitive.ObjectID, 0, len(descendants))
+	for _, d := range descendants {
+		ids = append(ids, d.ID)
+	}
+
+	return ids, nil
+}
+
+// GetNextPosition returns the position after the last child of parentID, or after the
+// last root of kind when parentID is nil
+func (t *taxonomyRepository) GetNextPosition(ctx context.Context, parentID *primitive.ObjectID, kind constants.TaxonomyKind) (int64, error) {
+	position, err := nextPosition(ctx, t.getTaxonomyCollection(), bson.M{"parent_id": parentID, "kind": kind})
+	if err != nil {
+		t.log.Errorf("(TaxonomyRepository.GetNextPosition) Error fetching position: %v", err)
+		return 0, err
+	}
+
+	return position, nil
+}
+
+// DeleteMany permanently deletes the terms. Terms are not kept in the trash, the
+// classification they carried is removed from clusters and topics along with them.
+func (t *taxonomyRepository) DeleteMany(ctx context.Context, termIDs []primitive.ObjectID) (int64, error) {
+	res, err := t.getTaxonomyCollection().DeleteMany(ctx, bson.M{"_id": bson.M{"$in": termIDs}})
+	if err != nil {
+		t.log.Errorf("(TaxonomyRepository.DeleteMany) Error deleting terms: %v", err)
+		return 0, err
+	}
+
+	return res.DeletedCount, nil
+}
+
+func (t *taxonomyRepository) Exists(ctx context.Context, query map[string]interface{}) (bool, error) {
+	count, err := t.getTaxonomyCollection().CountDocuments(ctx, query)
+	if err != nil {
+		t.log.Errorf("(TaxonomyRepository.Exists) Error counting terms: %v", err)
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (t *taxonomyRepository) getTaxonomyCollection() *mongo.Collection {
+	return t.db.Database(t.cfg.Mongo.Db).Collection(t.cfg.Mongo.Collections.Taxonomy)
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// addTaxonomyTerm classifies the document with termID and reports whether it was not
+// classified with it already
+func addTaxonomyTerm(ctx context.Context, collection *mongo.Collection, id primitive.ObjectID, termID primitive.ObjectID) (bool, error) {
+	added, err := collection.UpdateOne(
+		ctx,
+		writeScope(ctx, bson.M{"_id": id, "taxonomy_terms": bson.M{"$ne": termID}}),
+		bson.M{
+			"$push": bson.M{"taxonomy_terms": termID},
+			"$set":  bson.M{"updated_at": time.Now()},
+		})
+	if err != nil {
+		return false, errors.Wrap(err, "mongoRepository.UpdateOne")
+	}
+
+	if added.MatchedCount > 0 {
+		return true, nil
+	}
+
+	count, err := collection.CountDocuments(ctx, writeScope(ctx, bson.M{"_id": id}))
+	if err != nil {
+		return false, errors.Wrap(err, "mongoRepository.CountDocuments")
+	}
+
+	if count == 0 {
+		return false, errors.Errorf("no document found with ID: %s", id.Hex())
+	}
+
+	return false, nil
+}
+
+// removeTaxonomyTerm removes termID from the classification of the document and reports
+// whether it was there
+func removeTaxonomyTerm(ctx context.Context, collection *mongo.Collection, id primitive.ObjectID, termID primitive.ObjectID) (bool, error) {
+	res, err := collection.UpdateOne(
+		ctx,
+		writeScope(ctx, bson.M{"_id": id, "taxonomy_terms": termID}),
+		bson.M{
+			"$pull": bson.M{"taxonomy_terms": termID},
+			"$set":  bson.M{"updated_at": time.Now()},
+		})
+	if err != nil {
+		return false, errors.Wrap(err, "mongoRepository.UpdateOne")
+	}
+
+	return res.ModifiedCount > 0, nil
+}
+
+// removeTaxonomyTerms removes deleted terms from every document of every organization,
+// trashed ones included so that they come back without them
+func removeTaxonomyTerms(ctx context.Context, collection *mongo.Collection, termIDs []primitive.ObjectID) (int64, error) {
+	if len(termIDs) == 0 {
+		return 0, nil
+	}
+
+	res, err := collection.UpdateMany(
+		ctx,
+		bson.M{"taxonomy_terms": bson.M{"$in": termIDs}},
+		bson.M{"$pull": bson.M{"taxonomy_terms": bson.M{"$in": termIDs}}})
+	if err != nil {
+		return 0, errors.Wrap(err, "mongoRepository.UpdateMany")
+	}
+
+	return res.ModifiedCount, nil
+}
+
+// withTaxonomyTerms narrows filter to the documents classified under every one of
+// subtrees, a subtree being the ids of a term and of the terms below it
+func withTaxonomyTerms(filter bson.M, subtrees [][]primitive.ObjectID) bson.M {
+	if len(subtrees) == 0 {
+		return filter
+	}
+
+	clauses := make([]bson.M, 0, len(subtrees))
+	for _, subtree := range subtrees {
+		clauses = append(clauses, bson.M{"taxonomy_terms": bson.M{"$in": subtree}})
+	}
+
+	return withField(filter, "$and", clauses)
+}
@@ -4,11 +4,15 @@ import (
 	"context"
 	"fmt"
 	"gallery-service/config"
+	"gallery-service/internal/application/dto/responses"
 	"gallery-service/internal/application/dto/responses/topic"
 	"gallery-service/internal/application/mappers"
 	"gallery-service/internal/domain/models"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/tenant"
 	"gallery-service/pkg/utils"
 	"gallery-service/pkg/zap"
+	"time"
 
 	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/bson"
@@ -36,6 +40,12 @@ func NewTopicRepository(log zap.Logger, cfg *config.Config, db *mongo.Client) *t
 }
 
 func (p *topicRepository) Insert(ctx context.Context, topic *models.Topic) (string, error) {
+	owner, err := tenant.FromContext(ctx).Owner()
+	if err != nil {
+		return "", err
+	}
+	topic.OrganizationID = owner
+
 	insertResult, err := p.getTopicsCollection().InsertOne(ctx, topic, &options.InsertOneOptions{})
 	if err != nil {
 		p.log.Errorf("(topicRepository.Insert) Error inserting topic: %v", err)
@@ -45,10 +55,29 @@ func (p *topicRepository) Insert(ctx context.Context, topic *models.Topic) (stri
 	return insertResult.InsertedID.(primitive.ObjectID).Hex(), nil
 }
 
+func (p *topicRepository) InsertMany(ctx context.Context, topics []*models.Topic) error {
+	owner, err := tenant.FromContext(ctx).Owner()
+	if err != nil {
+		return err
+	}
+
+	docs := make([]interface{}, 0, len(topics))
+	for _, t := range topics {
+		t.OrganizationID = owner
+		docs = append(docs, t)
+	}
+
+	if _, err := p.getTopicsCollection().InsertMany(ctx, docs); err != nil {
+		p.log.Errorf("(topicRepository.InsertMany) Error inserting topics: %v", err)
+		return err
+	}
+
+	return nil
+}
+
 func (p *topicRepository) Update(ctx context.Context, topic *models.Topic) error {
 	req := bson.M{
 		"file_name":       topic.TopicName,
-		"is_published":    topic.IsPublished,
 		"language_config": topic.LanguageConfig,
 		"created_at":      topic.CreatedAt,
 		"updated_at":      topic.UpdatedAt,
@@ -56,7 +85,7 @@ func (p *topicRepository) Update(ctx context.Context, topic *models.Topic) error
 
 	result, err := p.getTopicsCollection().UpdateOne(
 		ctx,
-		bson.M{"_id": topic.ID},
+		writeScope(ctx, bson.M{"_id": topic.ID}),
 		bson.M{"$set": req},
 	)
 	if err != nil {
@@ -70,6 +99,155 @@ func (p *topicRepository) Update(ctx context.Context, topic *models.Topic) error
 	return nil
 }
 
+// Patch sets and unsets only the given fields of a topic
+func (p *topicRepository) Patch(ctx context.Context, topicID primitive.ObjectID, set map[string]interface{}, unset []string) error {
+	result, err := p.getTopicsCollection().UpdateOne(
+		ctx,
+		writeScope(ctx, bson.M{"_id": topicID}),
+		patchUpdate(set, unset),
+	)
+	if err != nil {
+		return fmt.Errorf("(topicRepository.Patch) failed to update: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("(topicRepository.Patch) no topic found with ID: %s", topicID.Hex())
+	}
+
+	return nil
+}
+
+// SetLanguage adds the language entry to a topic or replaces the one it has for the same
+// language, and reports whether it was added
+func (p *topicRepository) SetLanguage(ctx context.Context, topicID primitive.ObjectID, config models.TopicLanguageConfig) (bool, error) {
+	added, err := setLanguage(ctx, p.getTopicsCollection(), topicID, config.Language, config)
+	if err != nil {
+		p.log.Errorf("(topicRepository.SetLanguage) Error saving language: %v", err)
+		return false, err
+	}
+
+	return added, nil
+}
+
+// ReplaceLanguage replaces the entry of a topic for config's language, provided the topic
+// was last updated at unchangedSince, and reports whether it was replaced
+func (p *topicRepository) ReplaceLanguage(ctx context.Context, topicID primitive.ObjectID, config models.TopicLanguageConfig, unchangedSince time.Time) (bool, error) {
+	var updatedAt interface{} = unchangedSince
+	if unchangedSince.IsZero() {
+		updatedAt = bson.M{"$exists": false}
+	}
+
+	res, err := p.getTopicsCollection().UpdateOne(
+		ctx,
+		writeScope(ctx, bson.M{"_id": topicID, "language_config.language": config.Language, "updated_at": updatedAt}),
+		bson.M{"$set": bson.M{"language_config.$": config, "updated_at": time.Now()}})
+	if err != nil {
+		p.log.Errorf("(topicRepository.ReplaceLanguage) Error saving language: %v", err)
+		return false, errors.Wrap(err, "mongoRepository.UpdateOne")
+	}
+
+	return res.MatchedCount > 0, nil
+}
+
+// RemoveLanguage removes the entry for language from a topic and reports whether it had one
+func (p *topicRepository) RemoveLanguage(ctx context.Context, topicID primitive.ObjectID, language constants.Language) (bool, error) {
+	removed, err := removeLanguage(ctx, p.getTopicsCollection(), topicID, language)
+	if err != nil {
+		p.log.Errorf("(topicRepository.RemoveLanguage) Error removing language: %v", err)
+		return false, err
+	}
+
+	return removed, nil
+}
+
+// AddTaxonomyTerm classifies a topic with a taxonomy term and reports whether it was
+// not classified with it already
+func (p *topicRepository) AddTaxonomyTerm(ctx context.Context, topicID primitive.ObjectID, termID primitive.ObjectID) (bool, error) {
+	added, err := addTaxonomyTerm(ctx, p.getTopicsCollection(), topicID, termID)
+	if err != nil {
+		p.log.Errorf("(topicRepository.AddTaxonomyTerm) Error adding term: %v", err)
+		return false, err
+	}
+
+	return added, nil
+}
+
+// RemoveTaxonomyTerm removes a taxonomy term from a topic and reports whether it had it
+func (p *topicRepository) RemoveTaxonomyTerm(ctx context.Context, topicID primitive.ObjectID, termID primitive.ObjectID) (bool, error) {
+	removed, err := removeTaxonomyTerm(ctx, p.getTopicsCollection(), topicID, termID)
+	if err != nil {
+		p.log.Errorf("(topicRepository.RemoveTaxonomyTerm) Error removing term: %v", err)
+		return false, err
+	}
+
+	return removed, nil
+}
+
+// RemoveTaxonomyTerms removes deleted taxonomy terms from the topics of every organization
+func (p *topicRepository) RemoveTaxonomyTerms(ctx context.Context, termIDs []primitive.ObjectID) (int64, error) {
+	removed, err := removeTaxonomyTerms(ctx, p.getTopicsCollection(), termIDs)
+	if err != nil {
+		p.log.Errorf("(topicRepository.RemoveTaxonomyTerms) Error removing terms: %v", err)
+		return 0, err
+	}
+
+	return removed, nil
+}
+
+// Transition moves a topic along the editorial workflow and appends the change to its
+// history. It only applies while the topic is still in change.From, and reports whether
+// it did.
+func (p *topicRepository) Transition(ctx context.Context, topicID primitive.ObjectID, change models.TopicStatusChange) (bool, error) {
+	res, err := p.getTopicsCollection().UpdateOne(
+		ctx,
+		writeScope(ctx, withField(inStatus(change.From), "_id", topicID)),
+		transitionUpdate(change))
+	if err != nil {
+		p.log.Errorf("(topicRepository.Transition) Error updating topic: %v", err)
+		return false, errors.Wrap(err, "mongoRepository.UpdateOne")
+	}
+
+	return res.MatchedCount > 0, nil
+}
+
+// TransitionDue applies a scheduled change to every topic in change.From whose time for
+// it has come by change.At: publish_at for a publication, unpublish_at for an archival.
+// The time is cleared as the change is made, so each topic goes through it once.
+func (p *topicRepository) TransitionDue(ctx context.Context, change models.TopicStatusChange) (int64, error) {
+	field := "publish_at"
+	if change.Action == constants.TopicActionArchive {
+		field = "unpublish_at"
+	}
+
+	update := transitionUpdate(change)
+	update["$unset"] = bson.M{field: ""}
+
+	res, err := p.getTopicsCollection().UpdateMany(
+		ctx,
+		writeScope(ctx, withField(inStatus(change.From), field, bson.M{"$lte": change.At})),
+		update)
+	if err != nil {
+		p.log.Errorf("(topicRepository.TransitionDue) Error updating topics: %v", err)
+		return 0, errors.Wrap(err, "mongoRepository.UpdateMany")
+	}
+
+	return res.ModifiedCount, nil
+}
+
+// SetSchedule replaces when the topic is published and archived, a nil time clearing it
+func (p *topicRepository) SetSchedule(ctx context.Context, topicID primitive.ObjectID, publishAt *time.Time, unpublishAt *time.Time) (bool, error) {
+	res, err := p.getTopicsCollection().UpdateOne(
+		ctx,
+		writeScope(ctx, bson.M{"_id": topicID}),
+		scheduleUpdate(bson.M{"updated_at": time.Now()}, publishAt, unpublishAt))
+	if err != nil {
+		p.log.Errorf("(topicRepository.SetSchedule) Error updating topic: %v", err)
+		return false, errors.Wrap(err, "mongoRepository.UpdateOne")
+	}
+
+	return res.MatchedCount > 0, nil
+}
+
 func (p *topicRepository) GetAll(ctx context.Context, pq *utils.Pagination) ([]topic.GetTopicResponseDto, error) {
 	if pq.Page <= 0 {
 		pq.Page = 1
@@ -84,6 +262,8 @@ func (p *topicRepository) GetAll(ctx context.Context, pq *utils.Pagination) ([]t
 	limit := int64(pq.Size)
 
 	aggPipeline := []bson.M{
+		readStage(ctx),
+		{"$sort": positionSort},
 		{"$skip": skip},
 		{"$limit": limit},
 	}
@@ -111,7 +291,7 @@ func (p *topicRepository) GetByID(ctx context.Context, topicID string) (*models.
 	p.log.Infof("(topicRepository.GetByID) topicID: %s", topicID)
 	objectId, _ := primitive.ObjectIDFromHex(topicID)
 	var topic models.Topic
-	if err := p.getTopicsCollection().FindOne(ctx, bson.M{"_id": objectId}).Decode(&topic); err != nil {
+	if err := p.getTopicsCollection().FindOne(ctx, readScope(ctx, bson.M{"_id": objectId})).Decode(&topic); err != nil {
 		p.log.Errorf("(topicRepository.GetByID) Error fetching topic: %v", err)
 		return nil, err
 	}
@@ -119,6 +299,74 @@ func (p *topicRepository) GetByID(ctx context.Context, topicID string) (*models.
 	return &topic, nil
 }
 
+func (p *topicRepository) GetByIDs(ctx context.Context, topicIDs []primitive.ObjectID) ([]*models.Topic, error) {
+	return p.find(ctx, "GetByIDs", bson.M{"_id": bson.M{"$in": topicIDs}})
+}
+
+// GetByFolderIDs returns the readable topics filed in one of the folders
+func (p *topicRepository) GetByFolderIDs(ctx context.Context, folderIDs []primitive.ObjectID) ([]*models.Topic, error) {
+	return p.find(ctx, "GetByFolderIDs", bson.M{"folder_id": bson.M{"$in": folderIDs}})
+}
+
+// GetAllByFolderIDs returns a page of the topics filed in one of the folders, in order of
+// position, and how many there are in all. When published is set only the published
+// topics are counted and returned, without their workflow history.
+func (p *topicRepository) GetAllByFolderIDs(ctx context.Context, folderIDs []primitive.ObjectID, published bool, pq *utils.Pagination) ([]*models.Topic, int64, error) {
+	filter := bson.M{"folder_id": bson.M{"$in": folderIDs}}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "position", Value: 1}, {Key: "_id", Value: 1}}).
+		SetSkip(int64(pq.GetOffset())).
+		SetLimit(int64(pq.GetLimit()))
+	if published {
+		filter = bson.M{"$and": []bson.M{filter, inStatus(constants.TopicStatusPublished)}}
+		opts.SetProjection(bson.M{"status_history": 0})
+	}
+	filter = readScope(ctx, filter)
+
+	total, err := p.getTopicsCollection().CountDocuments(ctx, filter)
+	if err != nil {
+		p.log.Errorf("(topicRepository.GetAllByFolderIDs) Error counting topics: %v", err)
+		return nil, 0, errors.Wrap(err, "mongoRepository.CountDocuments")
+	}
+
+	cur, err := p.getTopicsCollection().Find(ctx, filter, opts)
+	if err != nil {
+		p.log.Errorf("(topicRepository.GetAllByFolderIDs) Error fetching topics: %v", err)
+		return nil, 0, errors.Wrap(err, "mongoRepository.Find")
+	}
+	defer cur.Close(ctx)
+
+	topics := make([]*models.Topic, 0)
+	if err := cur.All(ctx, &topics); err != nil {
+		p.log.Errorf("(topicRepository.GetAllByFolderIDs) Error decoding topics: %v", err)
+		return nil, 0, errors.Wrap(err, "cursor.All")
+	}
+
+	return topics, total, nil
+}
+
+// GetByNames returns the readable topics named like one of names
+func (p *topicRepository) GetByNames(ctx context.Context, names []string) ([]*models.Topic, error) {
+	return p.find(ctx, "GetByNames", bson.M{"topic_name": bson.M{"$in": names}})
+}
+
+func (p *topicRepository) find(ctx context.Context, method string, filter bson.M) ([]*models.Topic, error) {
+	topics := make([]*models.Topic, 0)
+	cursor, err := p.getTopicsCollection().Find(ctx, readScope(ctx, filter), options.Find().SetSort(positionSort))
+	if err != nil {
+		p.log.Errorf("(topicRepository.%s) Error fetching topics: %v", method, err)
+		return nil, errors.Wrap(err, "mongoRepository.Find")
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &topics); err != nil {
+		p.log.Errorf("(topicRepository.%s) Error fetching topics: %v", method, err)
+		return nil, errors.Wrap(err, "cursor.All")
+	}
+
+	return topics, nil
+}
+
 func (p *topicRepository) Search(ctx context.Context, query map[string]interface{}, pq *utils.Pagination) (*topic.GetAllTopicResponseDto, error) {
 	// Prepare pagination options
 	skip := int64((pq.Page - 1) * pq.Size)
@@ -136,17 +384,15 @@ func (p *topicRepository) Search(ctx context.Context, query map[string]interface
 		}
 	}
 
+	if subtrees, ok := query["taxonomy_terms"].([][]primitive.ObjectID); ok {
+		queryFilter = withTaxonomyTerms(queryFilter, subtrees)
+	}
+
 	p.log.Debugf("Starting %v", queryFilter)
 
-	aggPipeline := []bson.M{
-		{
-			"$skip": skip,
-		},
-		{
-			"$limit": limit,
-		},
-	}
+	queryFilter = readScope(ctx, queryFilter)
 
+	aggPipeline := make([]bson.M, 0, 4)
 	if len(queryFilter) > 0 {
 		aggPipeline = append(aggPipeline, bson.M{
 			"$match": queryFilter,
@@ -155,6 +401,12 @@ func (p *topicRepository) Search(ctx context.Context, query map[string]interface
 		p.log.Warnf("(topicRepository.Search) Query filter is empty, skipping $match stage.")
 	}
 
+	aggPipeline = append(aggPipeline,
+		bson.M{"$sort": positionSort},
+		bson.M{"$skip": skip},
+		bson.M{"$limit": limit},
+	)
+
 	p.log.Infof("(topicRepository.Search) Searching for query: %v", aggPipeline)
 
 	// Perform the search query on the topics collection
@@ -172,34 +424,170 @@ func (p *topicRepository) Search(ctx context.Context, query map[string]interface
 		return nil, errors.Wrap(err, "cursor.All")
 	}
 
-	// Prepare pagination response
-	//totalCount := len(topics)
+	totalCount, err := p.getTopicsCollection().CountDocuments(ctx, queryFilter)
+	if err != nil {
+		p.log.Errorf("(topicRepository.Search) Error counting topics: %v", err)
+		return nil, errors.Wrap(err, "mongoRepository.CountDocuments")
+	}
 
 	return &topic.GetAllTopicResponseDto{
-		// Pagination: responses.Pagination{
-		// 	TotalCount: int64(totalCount),
-		// 	TotalPages: int64(pq.GetTotalPages(totalCount)),
-		// 	Page:       int64(pq.GetPage()),
-		// 	Size:       int64(pq.GetSize()),
-		// 	HasMore:    pq.GetHasMore(totalCount),
-		// },
+		Pagination: responses.Pagination{
+			TotalCount: totalCount,
+			TotalPages: int64(pq.GetTotalPages(int(totalCount))),
+			Page:       int64(pq.GetPage()),
+			Size:       int64(pq.GetSize()),
+			HasMore:    pq.GetHasMore(int(totalCount)),
+		},
 		Topics: mappers.GetTopicsFromModels(topics),
 	}, nil
 }
 
+// Delete moves a topic to the trash
 func (p *topicRepository) Delete(ctx context.Context, topicID string) (bool, error) {
 	objectId, _ := primitive.ObjectIDFromHex(topicID)
-	res, err := p.getTopicsCollection().DeleteOne(ctx, bson.M{"_id": objectId})
+	res, err := p.getTopicsCollection().UpdateOne(ctx, writeScope(ctx, bson.M{"_id": objectId}), moveToTrash(ctx, objectId))
 	if err != nil {
 		p.log.Errorf("(topicRepository.Delete) Error deleting topic: %v", err)
 		return false, err
 	}
 
-	return res.DeletedCount > 0, nil
+	return res.MatchedCount > 0, nil
+}
+
+// DeleteByFolderIDs moves the topics filed in the given folders to the trash as part of
+// the trash unit trashID
+func (p *topicRepository) DeleteByFolderIDs(ctx context.Context, folderIDs []primitive.ObjectID, trashID primitive.ObjectID) (int64, error) {
+	res, err := p.getTopicsCollection().UpdateMany(ctx, writeScope(ctx, bson.M{"folder_id": bson.M{"$in": folderIDs}}), moveToTrash(ctx, trashID))
+	if err != nil {
+		p.log.Errorf("(topicRepository.DeleteByFolderIDs) Error deleting topics: %v", err)
+		return 0, err
+	}
+
+	return res.ModifiedCount, nil
+}
+
+// ChangeFolder files the topics of fromFolderID in toFolderID, or takes them out of the
+// folder tree when toFolderID is nil
+func (p *topicRepository) ChangeFolder(ctx context.Context, fromFolderID primitive.ObjectID, toFolderID *primitive.ObjectID) (int64, error) {
+	res, err := p.getTopicsCollection().UpdateMany(
+		ctx,
+		treeScope(ctx, bson.M{"folder_id": fromFolderID}),
+		folderUpdate(toFolderID, bson.M{}))
+	if err != nil {
+		p.log.Errorf("(topicRepository.ChangeFolder) Error updating topics: %v", err)
+		return 0, err
+	}
+
+	return res.ModifiedCount, nil
+}
+
+// Move files a topic in folderID at the given position, or takes it out of the folder
+// tree when folderID is nil, and reports whether the current user could change it
+func (p *topicRepository) Move(ctx context.Context, topicID primitive.ObjectID, folderID *primitive.ObjectID, position int64) (bool, error) {
+	res, err := p.getTopicsCollection().UpdateOne(
+		ctx,
+		writeScope(ctx, bson.M{"_id": topicID}),
+		folderUpdate(folderID, bson.M{"position": position}))
+	if err != nil {
+		p.log.Errorf("(topicRepository.Move) Error updating topic: %v", err)
+		return false, err
+	}
+
+	return res.MatchedCount > 0, nil
+}
+
+// GetDeleted returns the topics in the trash, newest first
+func (p *topicRepository) GetDeleted(ctx context.Context) ([]*models.Topic, error) {
+	cursor, err := p.getTopicsCollection().Find(
+		ctx,
+		trashScope(ctx, trashRootFilter),
+		options.Find().SetSort(bson.D{{Key: "deleted_at", Value: -1}}))
+	if err != nil {
+		p.log.Errorf("(topicRepository.GetDeleted) Error fetching topics: %v", err)
+		return nil, errors.Wrap(err, "mongoRepository.Find")
+	}
+	defer cursor.Close(ctx)
+
+	var topics []*models.Topic
+	if err := cursor.All(ctx, &topics); err != nil {
+		p.log.Errorf("(topicRepository.GetDeleted) Error fetching topics: %v", err)
+		return nil, errors.Wrap(err, "cursor.All")
+	}
+
+	return topics, nil
+}
+
+// GetDeletedByID returns a topic in the trash
+func (p *topicRepository) GetDeletedByID(ctx context.Context, topicID string) (*models.Topic, error) {
+	objectId, _ := primitive.ObjectIDFromHex(topicID)
+	var topic models.Topic
+	if err := p.getTopicsCollection().FindOne(ctx, trashScope(ctx, bson.M{"_id": objectId, "trash_id": objectId})).Decode(&topic); err != nil {
+		p.log.Errorf("(topicRepository.GetDeletedByID) Error fetching topic: %v", err)
+		return nil, err
+	}
+
+	return &topic, nil
+}
+
+// Restore brings back the topics of the trash unit trashID
+func (p *topicRepository) Restore(ctx context.Context, trashID primitive.ObjectID) (int64, error) {
+	res, err := p.getTopicsCollection().UpdateMany(ctx, trashScope(ctx, bson.M{"trash_id": trashID}), restoreFromTrash)
+	if err != nil {
+		p.log.Errorf("(topicRepository.Restore) Error restoring topics: %v", err)
+		return 0, err
+	}
+
+	return res.ModifiedCount, nil
+}
+
+// Purge permanently deletes the topics of the trash unit trashID, with their revisions
+func (p *topicRepository) Purge(ctx context.Context, trashID primitive.ObjectID) (int64, error) {
+	count, err := purgeWithRevisions(ctx, p.getTopicsCollection(), p.getRevisionsCollection(), constants.RevisionEntityTopic, trashScope(ctx, bson.M{"trash_id": trashID}))
+	if err != nil {
+		p.log.Errorf("(topicRepository.Purge) Error purging topics: %v", err)
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// PurgeDeletedBefore permanently deletes the topics of every organization that have been
+// in the trash since before the given time, with their revisions
+func (p *topicRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	count, err := purgeWithRevisions(ctx, p.getTopicsCollection(), p.getRevisionsCollection(), constants.RevisionEntityTopic, bson.M{"deleted_at": bson.M{"$lt": before}})
+	if err != nil {
+		p.log.Errorf("(topicRepository.PurgeDeletedBefore) Error purging topics: %v", err)
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// GetNextPosition returns the position after the last topic of folderID, or after the
+// last topic outside the folder tree when folderID is nil
+func (p *topicRepository) GetNextPosition(ctx context.Context, folderID *primitive.ObjectID) (int64, error) {
+	position, err := nextPosition(ctx, p.getTopicsCollection(), readScope(ctx, inFolder(folderID)))
+	if err != nil {
+		p.log.Errorf("(topicRepository.GetNextPosition) Error fetching position: %v", err)
+		return 0, err
+	}
+
+	return position, nil
+}
+
+// Reorder sets the order of the topics of folderID, or of the topics outside the folder
+// tree when folderID is nil
+func (p *topicRepository) Reorder(ctx context.Context, folderID *primitive.ObjectID, topicIDs []primitive.ObjectID) error {
+	if err := reorder(ctx, p.getTopicsCollection(), writeScope(ctx, inFolder(folderID)), topicIDs); err != nil {
+		p.log.Errorf("(topicRepository.Reorder) Error reordering topics: %v", err)
+		return errors.Wrap(err, "topic")
+	}
+
+	return nil
 }
 
 func (p *topicRepository) Exists(ctx context.Context, query map[string]interface{}) (bool, error) {
-	count, err := p.getTopicsCollection().CountDocuments(ctx, query)
+	count, err := p.getTopicsCollection().CountDocuments(ctx, readScope(ctx, query))
 	if err != nil {
 		p.log.Errorf("(topicRepository.Exists) Error counting topics: %v", err)
 		return false, err
@@ -208,31 +596,116 @@ func (p *topicRepository) Exists(ctx context.Context, query map[string]interface
 	return count > 0, nil
 }
 
-func (p *topicRepository) GetAll4App(ctx context.Context) ([]topic.TopicForAppResponseDto, error) {
-	cur, err := p.getTopicsCollection().Find(ctx, bson.M{})
+// ExistsAnywhere tells whether any topics match query, in every organization and in the
+// trash, for checks that guard what all of them refer to
+func (p *topicRepository) ExistsAnywhere(ctx context.Context, query map[string]interface{}) (bool, error) {
+	count, err := p.getTopicsCollection().CountDocuments(ctx, query)
 	if err != nil {
-		p.log.Errorf("(topicRepository.GetAll4App) Error fetching topics: %v", err)
-		return nil, errors.Wrap(err, "find topics failed")
+		p.log.Errorf("(topicRepository.ExistsAnywhere) Error counting topics: %v", err)
+		return false, err
 	}
-	defer cur.Close(ctx)
 
-	var topics []*models.Topic
-	if err := cur.All(ctx, &topics); err != nil {
-		p.log.Errorf("(topicRepository.GetAll4App) Error decoding topics: %v", err)
-		return nil, errors.Wrap(err, "cursor.All")
+	return count > 0, nil
+}
+
+// GetPublished returns a page of the published topics, ordered by sort, and how many
+// published topics there are in all. sort is a stored field, position when empty. The
+// workflow history is left out, the apps have no use for it.
+func (p *topicRepository) GetPublished(ctx context.Context, sort string, descending bool, pq *utils.Pagination) ([]*models.Topic, int64, error) {
+	filter := readScope(ctx, inStatus(constants.TopicStatusPublished))
+
+	total, err := p.getTopicsCollection().CountDocuments(ctx, filter)
+	if err != nil {
+		p.log.Errorf("(topicRepository.GetPublished) Error counting topics: %v", err)
+		return nil, 0, errors.Wrap(err, "mongoRepository.CountDocuments")
 	}
 
-	var topicForAppList []topic.TopicForAppResponseDto
-	for _, t := range topics {
-		topicForAppList = append(topicForAppList, topic.TopicForAppResponseDto{
-			ID:        t.ID.Hex(),
-			TopicName: t.TopicName,
-		})
+	direction := 1
+	if descending {
+		direction = -1
 	}
 
-	return topicForAppList, nil
+	order := bson.D{{Key: "position", Value: direction}, {Key: "_id", Value: direction}}
+	if sort != "" && sort != "position" {
+		order = bson.D{{Key: sort, Value: direction}, {Key: "_id", Value: direction}}
+	}
+
+	cur, err := p.getTopicsCollection().Find(
+		ctx,
+		filter,
+		options.Find().
+			SetSort(order).
+			SetSkip(int64(pq.GetOffset())).
+			SetLimit(int64(pq.GetLimit())).
+			SetProjection(bson.M{"status_history": 0}))
+	if err != nil {
+		p.log.Errorf("(topicRepository.GetPublished) Error fetching topics: %v", err)
+		return nil, 0, errors.Wrap(err, "mongoRepository.Find")
+	}
+	defer cur.Close(ctx)
+
+	topics := make([]*models.Topic, 0)
+	if err := cur.All(ctx, &topics); err != nil {
+		p.log.Errorf("(topicRepository.GetPublished) Error decoding topics: %v", err)
+		return nil, 0, errors.Wrap(err, "cursor.All")
+	}
+
+	return topics, total, nil
 }
 
 func (p *topicRepository) getTopicsCollection() *mongo.Collection {
 	return p.db.Database(p.cfg.Mongo.Db).Collection(p.cfg.Mongo.Collections.Topic)
 }
+
+func (p *topicRepository) getRevisionsCollection() *mongo.Collection {
+	return p.db.Database(p.cfg.Mongo.Db).Collection(p.cfg.Mongo.Collections.Revision)
+}
+
+// inFolder matches the topics of folderID, or the topics outside the folder tree when
+// folderID is nil
+func inFolder(folderID *primitive.ObjectID) bson.M {
+	if folderID == nil {
+		return bson.M{"folder_id": nil}
+	}
+
+	return bson.M{"folder_id": *folderID}
+}
+
+// folderUpdate files a topic in folderID, or unsets its folder when folderID is nil,
+// setting the given fields along
+func folderUpdate(folderID *primitive.ObjectID, set bson.M) bson.M {
+	set["updated_at"] = time.Now()
+	if folderID == nil {
+		return bson.M{"$set": set, "$unset": bson.M{"folder_id": ""}}
+	}
+
+	set["folder_id"] = *folderID
+	return bson.M{"$set": set}
+}
+
+// inStatus matches the topics in the workflow status
+func inStatus(status constants.TopicStatus) bson.M {
+	filter := bson.M{"status": status}
+
+	// Topics stored before the workflow have no status, is_published stands for it
+	switch status {
+	case constants.TopicStatusDraft:
+		filter = bson.M{"$or": []bson.M{filter, {"status": bson.M{"$exists": false}, "is_published": bson.M{"$ne": true}}}}
+	case constants.TopicStatusPublished:
+		filter = bson.M{"$or": []bson.M{filter, {"status": bson.M{"$exists": false}, "is_published": true}}}
+	}
+
+	return filter
+}
+
+// transitionUpdate moves a topic to change.To and appends the change to its history
+func transitionUpdate(change models.TopicStatusChange) bson.M {
+	return bson.M{
+		"$set": bson.M{
+			"status":       change.To,
+			"is_published": change.To == constants.TopicStatusPublished,
+			"updated_at":   change.At,
+		},
+		"$push": bson.M{"status_history": change},
+	}
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"gallery-service/pkg/zap"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type transactionManager struct {
+	log zap.Logger
+	db  *mongo.Client
+}
+
+var (
+	txManager *transactionManager
+)
+
+func NewTransactionManager(log zap.Logger, db *mongo.Client) *transactionManager {
+	if txManager == nil {
+		txManager = &transactionManager{log: log, db: db}
+	}
+
+	return txManager
+}
+
+// WithTransaction runs fn inside a MongoDB transaction. Repository calls made with the
+// context passed to fn join the transaction, so fn must use it instead of the outer ctx.
+func (t *transactionManager) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	session, err := t.db.StartSession()
+	if err != nil {
+		t.log.Errorf("(TransactionManager.WithTransaction) Error starting session: %v", err)
+		return errors.Wrap(err, "mongo.StartSession")
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sessCtx)
+	})
+	if err != nil {
+		t.log.Errorf("(TransactionManager.WithTransaction) Transaction aborted: %v", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"gallery-service/internal/pkg/tenant"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// trashRootFilter matches the documents that were deleted on their own, as opposed to
+// the ones that went to the trash together with a folder
+var trashRootFilter = bson.M{"$expr": bson.M{"$eq": bson.A{"$trash_id", "$_id"}}}
+
+// moveToTrash is the update that soft deletes documents as part of the trash unit trashID
+func moveToTrash(ctx context.Context, trashID primitive.ObjectID) bson.M {
+	return bson.M{"$set": bson.M{
+		"deleted_at": time.Now(),
+		"deleted_by": tenant.FromContext(ctx).UserID(),
+		"trash_id":   trashID,
+	}}
+}
+
+// restoreFromTrash is the update that brings soft deleted documents back
+var restoreFromTrash = bson.M{"$unset": bson.M{
+	"deleted_at": "",
+	"deleted_by": "",
+	"trash_id":   "",
+}}
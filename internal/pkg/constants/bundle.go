@@ -0,0 +1,43 @@
+package constants
+
+// BundleEncoding is how a gallery bundle is written: one JSON document, or a ZIP archive
+// holding the manifest and one JSON file per kind of content
+type BundleEncoding string
+
+const (
+	BundleEncodingJSON BundleEncoding = "json"
+	BundleEncodingZIP  BundleEncoding = "zip"
+)
+
+func (e BundleEncoding) String() string {
+	return string(e)
+}
+
+// BundleConflict decides what an import does with a cluster or topic whose name is
+// already taken where it would land
+type BundleConflict string
+
+const (
+	// BundleConflictSkip keeps the existing content and leaves the imported one out
+	BundleConflictSkip BundleConflict = "skip"
+	// BundleConflictOverwrite replaces the content of the existing one with the imported one
+	BundleConflictOverwrite BundleConflict = "overwrite"
+)
+
+func (c BundleConflict) String() string {
+	return string(c)
+}
+
+// ImportAction is what an import did, or would do on a dry run, with a bundle entry
+type ImportAction string
+
+const (
+	// ImportActionCreate adds the entry under a new id
+	ImportActionCreate ImportAction = "create"
+	// ImportActionMerge reuses the existing folder of the same name and imports into it
+	ImportActionMerge ImportAction = "merge"
+	// ImportActionSkip leaves the entry out because of a name conflict
+	ImportActionSkip ImportAction = "skip"
+	// ImportActionOverwrite replaces the content of the existing entry of the same name
+	ImportActionOverwrite ImportAction = "overwrite"
+)
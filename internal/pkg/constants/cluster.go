@@ -0,0 +1,17 @@
+package constants
+
+// ClusterMoveStatus is the outcome of moving one cluster in a bulk move
+type ClusterMoveStatus string
+
+const (
+	// ClusterMoveMoved means the cluster now lives in the target folder
+	ClusterMoveMoved ClusterMoveStatus = "moved"
+	// ClusterMoveUnchanged means the cluster already was in the target folder
+	ClusterMoveUnchanged ClusterMoveStatus = "unchanged"
+	// ClusterMoveFailed means the cluster was left where it was
+	ClusterMoveFailed ClusterMoveStatus = "failed"
+)
+
+func (s ClusterMoveStatus) String() string {
+	return string(s)
+}
@@ -1,5 +1,8 @@
 package constants
 
+// GalleryLanguages, Components and ComponentMedia seed the language and component registry
+// the first time the service starts. After that the registry is edited through the admin
+// API and read through the registry package, not from these maps.
 var (
 	GalleryLanguages = map[string]Language{
 		"en": EnglishLanguageConfig,
@@ -24,6 +27,12 @@ var (
 		"alarm":            AlarmComponent,
 		"sentence":         SentenceComponent,
 	}
+	// ComponentMedia lists the media a language entry needs for its component, by key of
+	// Components. A component that is not listed needs none.
+	ComponentMedia = map[string]MediaRequirement{
+		"video-player": {MinVideos: 1},
+		"album":        {MinImages: 1},
+	}
 )
 
 type Language string
@@ -62,3 +71,10 @@ const (
 func (l Component) String() string {
 	return string(l)
 }
+
+// MediaRequirement is the least number of images, videos and audios a component needs
+type MediaRequirement struct {
+	MinImages int `json:"min_images" bson:"min_images,omitempty"`
+	MinVideos int `json:"min_videos" bson:"min_videos,omitempty"`
+	MinAudios int `json:"min_audios" bson:"min_audios,omitempty"`
+}
@@ -0,0 +1,52 @@
+package constants
+
+type FolderDeleteStrategy string
+
+const (
+	// FolderDeleteReject refuses to delete a folder that still has children or clusters
+	FolderDeleteReject FolderDeleteStrategy = "reject"
+	// FolderDeleteCascade deletes the folder, its whole subtree and their clusters
+	FolderDeleteCascade FolderDeleteStrategy = "cascade"
+	// FolderDeleteReparent moves children and clusters up to the deleted folder's parent
+	FolderDeleteReparent FolderDeleteStrategy = "reparent"
+)
+
+func (s FolderDeleteStrategy) String() string {
+	return string(s)
+}
+
+// FolderPermission is granted on a folder and applies to everything below it. Each
+// permission includes the ones before it.
+type FolderPermission string
+
+const (
+	// FolderPermissionRead lists and opens the folder, its subfolders and clusters
+	FolderPermissionRead FolderPermission = "read"
+	// FolderPermissionWrite adds, changes and removes subfolders and clusters
+	FolderPermissionWrite FolderPermission = "write"
+	// FolderPermissionManage grants and revokes access to the folder
+	FolderPermissionManage FolderPermission = "manage"
+)
+
+func (p FolderPermission) String() string {
+	return string(p)
+}
+
+// Level ranks permissions so that a higher one includes the lower ones, 0 means no access
+func (p FolderPermission) Level() int {
+	switch p {
+	case FolderPermissionRead:
+		return 1
+	case FolderPermissionWrite:
+		return 2
+	case FolderPermissionManage:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// Includes reports whether holding p also allows other
+func (p FolderPermission) Includes(other FolderPermission) bool {
+	return other.Level() > 0 && p.Level() >= other.Level()
+}
@@ -0,0 +1,27 @@
+package constants
+
+// RevisionEntity names the kind of document a revision belongs to
+type RevisionEntity string
+
+const (
+	RevisionEntityCluster RevisionEntity = "cluster"
+	RevisionEntityTopic   RevisionEntity = "topic"
+)
+
+func (e RevisionEntity) String() string {
+	return string(e)
+}
+
+// RevisionAction is the change that replaced the document stored in a revision
+type RevisionAction string
+
+const (
+	// RevisionActionUpdate is a regular edit
+	RevisionActionUpdate RevisionAction = "update"
+	// RevisionActionRestore wrote an older revision back
+	RevisionActionRestore RevisionAction = "restore"
+)
+
+func (a RevisionAction) String() string {
+	return string(a)
+}
@@ -0,0 +1,14 @@
+package constants
+
+// TagMatch is how a listing filtered by several tags matches them: a cluster needs all of
+// the tags, or any one of them
+type TagMatch string
+
+const (
+	TagMatchAll TagMatch = "and"
+	TagMatchAny TagMatch = "or"
+)
+
+func (m TagMatch) String() string {
+	return string(m)
+}
@@ -0,0 +1,18 @@
+package constants
+
+// TaxonomyKind is the dimension a taxonomy term classifies content along. Every term of a
+// tree has the kind of its root.
+type TaxonomyKind string
+
+const (
+	// TaxonomyKindSubject is what the content teaches, such as Math > Counting
+	TaxonomyKindSubject TaxonomyKind = "subject"
+	// TaxonomyKindSkill is what the content trains, such as Fine motor > Drawing
+	TaxonomyKindSkill TaxonomyKind = "skill"
+	// TaxonomyKindAgeRange is who the content is for, such as Preschool > 3-4 years
+	TaxonomyKindAgeRange TaxonomyKind = "age_range"
+)
+
+func (k TaxonomyKind) String() string {
+	return string(k)
+}
@@ -0,0 +1,74 @@
+package constants
+
+// TopicStatus is where a topic is in the editorial workflow. A topic is written as a
+// draft, reviewed, approved, published and finally archived.
+type TopicStatus string
+
+const (
+	TopicStatusDraft     TopicStatus = "draft"
+	TopicStatusInReview  TopicStatus = "in_review"
+	TopicStatusApproved  TopicStatus = "approved"
+	TopicStatusPublished TopicStatus = "published"
+	TopicStatusArchived  TopicStatus = "archived"
+)
+
+func (s TopicStatus) String() string {
+	return string(s)
+}
+
+// TopicAction moves a topic from one workflow status to the next
+type TopicAction string
+
+const (
+	// TopicActionSubmit sends a draft for review
+	TopicActionSubmit TopicAction = "submit"
+	// TopicActionApprove accepts a topic in review
+	TopicActionApprove TopicAction = "approve"
+	// TopicActionReject sends a topic in review back to draft, with a comment saying why
+	TopicActionReject TopicAction = "reject"
+	// TopicActionPublish makes an approved topic public
+	TopicActionPublish TopicAction = "publish"
+	// TopicActionArchive withdraws a published topic
+	TopicActionArchive TopicAction = "archive"
+)
+
+func (a TopicAction) String() string {
+	return string(a)
+}
+
+// topicWorkflow lists the status each action starts from and the one it leads to
+var topicWorkflow = map[TopicAction]struct {
+	from TopicStatus
+	to   TopicStatus
+}{
+	TopicActionSubmit:  {TopicStatusDraft, TopicStatusInReview},
+	TopicActionApprove: {TopicStatusInReview, TopicStatusApproved},
+	TopicActionReject:  {TopicStatusInReview, TopicStatusDraft},
+	TopicActionPublish: {TopicStatusApproved, TopicStatusPublished},
+	TopicActionArchive: {TopicStatusPublished, TopicStatusArchived},
+}
+
+// Next returns the status a topic in status from moves to, and false when the action
+// does not apply to that status
+func (a TopicAction) Next(from TopicStatus) (TopicStatus, bool) {
+	step, ok := topicWorkflow[a]
+	if !ok || step.from != from {
+		return "", false
+	}
+
+	return step.to, true
+}
+
+// TopicMediaKind names one of the media arrays of a topic language, as it appears in the
+// routes and in the language_config documents
+type TopicMediaKind string
+
+const (
+	TopicMediaImages TopicMediaKind = "images"
+	TopicMediaVideos TopicMediaKind = "videos"
+	TopicMediaAudios TopicMediaKind = "audios"
+)
+
+func (k TopicMediaKind) String() string {
+	return string(k)
+}
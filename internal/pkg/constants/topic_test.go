@@ -0,0 +1,51 @@
+package constants
+
+import "testing"
+
+func TestTopicActionNext(t *testing.T) {
+	type step struct {
+		action TopicAction
+		from   TopicStatus
+	}
+
+	// The only transitions of the workflow, every other pair must be refused
+	allowed := map[step]TopicStatus{
+		{TopicActionSubmit, TopicStatusDraft}:      TopicStatusInReview,
+		{TopicActionApprove, TopicStatusInReview}:  TopicStatusApproved,
+		{TopicActionReject, TopicStatusInReview}:   TopicStatusDraft,
+		{TopicActionPublish, TopicStatusApproved}:  TopicStatusPublished,
+		{TopicActionArchive, TopicStatusPublished}: TopicStatusArchived,
+	}
+
+	actions := []TopicAction{
+		TopicActionSubmit,
+		TopicActionApprove,
+		TopicActionReject,
+		TopicActionPublish,
+		TopicActionArchive,
+		TopicAction("delete"),
+		TopicAction(""),
+	}
+	statuses := []TopicStatus{
+		TopicStatusDraft,
+		TopicStatusInReview,
+		TopicStatusApproved,
+		TopicStatusPublished,
+		TopicStatusArchived,
+		TopicStatus("deleted"),
+		TopicStatus(""),
+	}
+
+	for _, action := range actions {
+		for _, from := range statuses {
+			t.Run(action.String()+" from "+from.String(), func(t *testing.T) {
+				want, wantOK := allowed[step{action, from}]
+
+				got, ok := action.Next(from)
+				if got != want || ok != wantOK {
+					t.Errorf("%q.Next(%q) = %q, %t, want %q, %t", action, from, got, ok, want, wantOK)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,14 @@
+package constants
+
+// TrashItemType names the kind of document a trash item is
+type TrashItemType string
+
+const (
+	TrashItemFolder  TrashItemType = "folder"
+	TrashItemCluster TrashItemType = "cluster"
+	TrashItemTopic   TrashItemType = "topic"
+)
+
+func (t TrashItemType) String() string {
+	return string(t)
+}
@@ -0,0 +1,130 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"gallery-service/config"
+	"gallery-service/internal/domain/models"
+	domainRepository "gallery-service/internal/domain/repository"
+	"gallery-service/internal/infrastructure/database/mongo/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/pkg/asyncjob"
+	"gallery-service/pkg/zap"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// publishScheduleLease is the lease a replica holds while it applies the publish schedule
+const publishScheduleLease = "publish_schedule"
+
+// publishScheduleJob publishes and archives topics, and shows and hides clusters, once
+// their publish_at and unpublish_at have come. Every replica runs it, but only the one
+// holding the lease applies the schedule, and each change only applies to the documents
+// still waiting for it, so that none is made twice.
+type publishScheduleJob struct {
+	cfg         *config.Config
+	log         zap.Logger
+	holder      string
+	leaseRepo   domainRepository.LeaseRepository
+	clusterRepo domainRepository.ClusterRepository
+	topicRepo   domainRepository.TopicRepository
+}
+
+func NewPublishScheduleJob(cfg *config.Config, log zap.Logger, db *mongo.Client) *publishScheduleJob {
+	hostname, _ := os.Hostname()
+
+	return &publishScheduleJob{
+		cfg:         cfg,
+		log:         log,
+		holder:      fmt.Sprintf("%s-%s", hostname, primitive.NewObjectID().Hex()),
+		leaseRepo:   repository.NewLeaseRepository(log, cfg, db),
+		clusterRepo: repository.NewClusterRepository(log, cfg, db),
+		topicRepo:   repository.NewTopicRepository(log, cfg, db),
+	}
+}
+
+// Start applies the schedule right away and then once every interval until ctx is done,
+// when the lease is given up for another replica to take over
+func (j *publishScheduleJob) Start(ctx context.Context) {
+	if j.cfg.Schedule.Interval <= 0 || j.cfg.Schedule.LeaseTTL <= j.cfg.Schedule.Interval {
+		j.log.Warnf("(PublishScheduleJob) disabled, interval: {%v}, lease ttl: {%v}", j.cfg.Schedule.Interval, j.cfg.Schedule.LeaseTTL)
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(j.cfg.Schedule.Interval)
+		defer ticker.Stop()
+
+		for {
+			if err := j.apply(ctx); err != nil {
+				j.log.Errorf("(PublishScheduleJob) err: {%v}", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				if err := j.leaseRepo.Release(context.Background(), publishScheduleLease, j.holder); err != nil {
+					j.log.Errorf("(PublishScheduleJob) err: {%v}", err)
+				}
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (j *publishScheduleJob) apply(ctx context.Context) error {
+	held, err := j.leaseRepo.Acquire(ctx, publishScheduleLease, j.holder, j.cfg.Schedule.LeaseTTL)
+	if err != nil || !held {
+		return err
+	}
+
+	now := time.Now()
+
+	transition := func(action constants.TopicAction, from constants.TopicStatus) asyncjob.Job {
+		return asyncjob.NewJob(func(ctx context.Context) error {
+			to, _ := action.Next(from)
+			count, err := j.topicRepo.TransitionDue(ctx, models.TopicStatusChange{
+				Action:  action,
+				From:    from,
+				To:      to,
+				Comment: "scheduled",
+				At:      now,
+			})
+			if err != nil {
+				return err
+			}
+
+			if count > 0 {
+				j.log.Infof("(PublishScheduleJob) %s %d topics due by %v", action, count, now)
+			}
+			return nil
+		})
+	}
+
+	setHidden := func(hidden bool) asyncjob.Job {
+		return asyncjob.NewJob(func(ctx context.Context) error {
+			count, err := j.clusterRepo.ApplySchedule(ctx, hidden, now)
+			if err != nil {
+				return err
+			}
+
+			if count > 0 {
+				j.log.Infof("(PublishScheduleJob) set hidden to %t on %d clusters due by %v", hidden, count, now)
+			}
+			return nil
+		})
+	}
+
+	// In order, so that what is due to be published and unpublished at once ends up
+	// unpublished
+	return asyncjob.NewGroup(
+		false,
+		transition(constants.TopicActionPublish, constants.TopicStatusApproved),
+		transition(constants.TopicActionArchive, constants.TopicStatusPublished),
+		setHidden(false),
+		setHidden(true),
+	).Run(ctx)
+}
@@ -0,0 +1,60 @@
+package jobs
+
+import (
+	"context"
+	"gallery-service/config"
+	domainRepository "gallery-service/internal/domain/repository"
+	"gallery-service/internal/infrastructure/database/mongo/repository"
+	"gallery-service/internal/pkg/registry"
+	"gallery-service/pkg/zap"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// registryRefreshJob keeps the languages and components held in process up to date with
+// the database, where they may be changed through another replica
+type registryRefreshJob struct {
+	cfg          *config.Config
+	log          zap.Logger
+	registryRepo domainRepository.RegistryRepository
+}
+
+func NewRegistryRefreshJob(cfg *config.Config, log zap.Logger, db *mongo.Client) *registryRefreshJob {
+	return &registryRefreshJob{
+		cfg:          cfg,
+		log:          log,
+		registryRepo: repository.NewRegistryRepository(log, cfg, db),
+	}
+}
+
+// Start seeds and loads the registry right away, before the server takes requests, and
+// then reloads it once every refresh interval until ctx is done. The service keeps the
+// seed from the constants when the first load fails.
+func (j *registryRefreshJob) Start(ctx context.Context) {
+	if err := registry.Init(ctx, j.registryRepo); err != nil {
+		j.log.Errorf("(RegistryRefreshJob) err: {%v}", err)
+	}
+
+	if j.cfg.RegistryCache.RefreshInterval <= 0 {
+		j.log.Warnf("(RegistryRefreshJob) disabled, refresh interval: {%v}", j.cfg.RegistryCache.RefreshInterval)
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(j.cfg.RegistryCache.RefreshInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			if err := registry.Load(ctx, j.registryRepo); err != nil {
+				j.log.Errorf("(RegistryRefreshJob) err: {%v}", err)
+			}
+		}
+	}()
+}
@@ -0,0 +1,83 @@
+package jobs
+
+import (
+	"context"
+	"gallery-service/config"
+	domainRepository "gallery-service/internal/domain/repository"
+	"gallery-service/internal/infrastructure/database/mongo/repository"
+	"gallery-service/pkg/asyncjob"
+	"gallery-service/pkg/zap"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// trashPurgeJob deletes for good what has been in the trash longer than the retention
+// period of the config
+type trashPurgeJob struct {
+	cfg         *config.Config
+	log         zap.Logger
+	folderRepo  domainRepository.FolderRepository
+	clusterRepo domainRepository.ClusterRepository
+	topicRepo   domainRepository.TopicRepository
+}
+
+func NewTrashPurgeJob(cfg *config.Config, log zap.Logger, db *mongo.Client) *trashPurgeJob {
+	return &trashPurgeJob{
+		cfg:         cfg,
+		log:         log,
+		folderRepo:  repository.NewFolderRepository(log, cfg, db),
+		clusterRepo: repository.NewClusterRepository(log, cfg, db),
+		topicRepo:   repository.NewTopicRepository(log, cfg, db),
+	}
+}
+
+// Start purges the trash right away and then once every purge interval until ctx is done
+func (j *trashPurgeJob) Start(ctx context.Context) {
+	if j.cfg.Trash.Retention <= 0 || j.cfg.Trash.PurgeInterval <= 0 {
+		j.log.Warnf("(TrashPurgeJob) disabled, retention: {%v}, purge interval: {%v}", j.cfg.Trash.Retention, j.cfg.Trash.PurgeInterval)
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(j.cfg.Trash.PurgeInterval)
+		defer ticker.Stop()
+
+		for {
+			if err := j.purge(ctx); err != nil {
+				j.log.Errorf("(TrashPurgeJob) err: {%v}", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (j *trashPurgeJob) purge(ctx context.Context) error {
+	before := time.Now().Add(-j.cfg.Trash.Retention)
+
+	purge := func(name string, purgeDeletedBefore func(ctx context.Context, before time.Time) (int64, error)) asyncjob.Job {
+		return asyncjob.NewJob(func(ctx context.Context) error {
+			count, err := purgeDeletedBefore(ctx, before)
+			if err != nil {
+				return err
+			}
+
+			if count > 0 {
+				j.log.Infof("(TrashPurgeJob) purged %d %s deleted before %v", count, name, before)
+			}
+			return nil
+		})
+	}
+
+	return asyncjob.NewGroup(
+		true,
+		purge("folders", j.folderRepo.PurgeDeletedBefore),
+		purge("clusters", j.clusterRepo.PurgeDeletedBefore),
+		purge("topics", j.topicRepo.PurgeDeletedBefore),
+	).Run(ctx)
+}
@@ -0,0 +1,115 @@
+package language
+
+import (
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/registry"
+	httpPkg "gallery-service/pkg/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Chain lists the languages a read is served in, best first: the one asked for with
+// ?lang=, the ones of the Accept-Language header by quality, then the fallback chain.
+// Regional tags such as vi-VN count as their language, and unknown or disabled ones are
+// skipped except in ?lang=, where they are an error.
+func Chain(lang string, acceptLanguage string, fallback []string) ([]constants.Language, error) {
+	chain := make([]constants.Language, 0)
+	seen := make(map[constants.Language]bool)
+	add := func(code string) bool {
+		language, ok := registry.LanguageByCode(baseCode(code))
+		if !ok || !language.Enabled {
+			return false
+		}
+		if !seen[language.Name] {
+			seen[language.Name] = true
+			chain = append(chain, language.Name)
+		}
+		return true
+	}
+
+	if lang != "" && !add(lang) {
+		return nil, errors.Wrapf(httpPkg.BadRequest, "unsupported language %s", lang)
+	}
+
+	for _, code := range acceptedCodes(acceptLanguage) {
+		add(code)
+	}
+
+	for _, code := range fallback {
+		add(code)
+	}
+
+	return chain, nil
+}
+
+// Pick returns the index of the first language of chain the content has. Content that has
+// none of them is served in its first language, and matched is false. The index is -1
+// when the content has no language at all.
+func Pick(available []constants.Language, chain []constants.Language) (index int, matched bool) {
+	for _, language := range chain {
+		for i, a := range available {
+			if a == language {
+				return i, true
+			}
+		}
+	}
+
+	if len(available) == 0 {
+		return -1, false
+	}
+
+	return 0, false
+}
+
+// acceptedCodes reads an Accept-Language header into its language tags by descending
+// quality. Tags with q=0 and the * wildcard are left out.
+func acceptedCodes(header string) []string {
+	type weighted struct {
+		code    string
+		quality float64
+	}
+
+	tags := make([]weighted, 0)
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(strings.TrimSpace(part), ";")
+		code := strings.TrimSpace(fields[0])
+		if code == "" || code == "*" {
+			continue
+		}
+
+		quality := 1.0
+		for _, param := range fields[1:] {
+			key, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if !ok || strings.TrimSpace(key) != "q" {
+				continue
+			}
+			if q, err := strconv.ParseFloat(strings.TrimSpace(value), 64); err == nil {
+				quality = q
+			}
+		}
+
+		if quality > 0 {
+			tags = append(tags, weighted{code: code, quality: quality})
+		}
+	}
+
+	sort.SliceStable(tags, func(i, j int) bool {
+		return tags[i].quality > tags[j].quality
+	})
+
+	codes := make([]string, 0, len(tags))
+	for _, t := range tags {
+		codes = append(codes, t.code)
+	}
+
+	return codes
+}
+
+func baseCode(tag string) string {
+	code, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+	code, _, _ = strings.Cut(code, "_")
+	return code
+}
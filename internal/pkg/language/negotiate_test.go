@@ -0,0 +1,209 @@
+package language
+
+import (
+	"context"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"gallery-service/internal/pkg/registry"
+	httpPkg "gallery-service/pkg/http"
+	"reflect"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	en = constants.EnglishLanguageConfig
+	vi = constants.VietnameseLanguageConfig
+	de = constants.GermanLanguageConfig
+	fr = constants.FrenchLanguageConfig
+	es = constants.SpanishLanguageConfig
+)
+
+// seededRegistry serves the seed of the constants, with the given codes disabled
+type seededRegistry struct {
+	repository.RegistryRepository
+	disabled []string
+}
+
+func (r seededRegistry) GetLanguages(_ context.Context) ([]*models.GalleryLanguage, error) {
+	languages, _ := registry.Seed()
+	for _, language := range languages {
+		for _, code := range r.disabled {
+			if language.Code == code {
+				language.Enabled = false
+			}
+		}
+	}
+
+	return languages, nil
+}
+
+func (r seededRegistry) GetComponents(_ context.Context) ([]*models.GalleryComponent, error) {
+	_, components := registry.Seed()
+	return components, nil
+}
+
+func TestChain(t *testing.T) {
+	tests := []struct {
+		name           string
+		lang           string
+		acceptLanguage string
+		fallback       []string
+		want           []constants.Language
+		wantErr        bool
+	}{
+		{
+			name: "nothing asked for",
+			want: []constants.Language{},
+		},
+		{
+			name:     "fallback only",
+			fallback: []string{"en", "vi"},
+			want:     []constants.Language{en, vi},
+		},
+		{
+			name:           "lang before Accept-Language before fallback",
+			lang:           "fr",
+			acceptLanguage: "es",
+			fallback:       []string{"en"},
+			want:           []constants.Language{fr, es, en},
+		},
+		{
+			name:           "each language once",
+			lang:           "en",
+			acceptLanguage: "fr, en;q=0.5",
+			fallback:       []string{"fr", "en"},
+			want:           []constants.Language{en, fr},
+		},
+		{
+			name:           "regional tags count as their language",
+			lang:           "vi-VN",
+			acceptLanguage: "fr_CA, EN-us;q=0.2",
+			want:           []constants.Language{vi, fr, en},
+		},
+		{
+			name:           "unknown languages of Accept-Language and fallback skipped",
+			acceptLanguage: "ja, es;q=0.8",
+			fallback:       []string{"xx", "en"},
+			want:           []constants.Language{es, en},
+		},
+		{
+			name:           "disabled language skipped",
+			acceptLanguage: "de, fr;q=0.5",
+			fallback:       []string{"de"},
+			want:           []constants.Language{fr},
+		},
+		{
+			name:    "unknown lang",
+			lang:    "ja",
+			wantErr: true,
+		},
+		{
+			name:    "disabled lang",
+			lang:    "de-AT",
+			wantErr: true,
+		},
+	}
+
+	if err := registry.Load(context.Background(), seededRegistry{disabled: []string{"de"}}); err != nil {
+		t.Fatalf("registry.Load err: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = registry.Load(context.Background(), seededRegistry{})
+	})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Chain(tt.lang, tt.acceptLanguage, tt.fallback)
+			if tt.wantErr {
+				if !errors.Is(err, httpPkg.BadRequest) {
+					t.Fatalf("Chain(%q, %q, %v) = %v, %v, want a bad request", tt.lang, tt.acceptLanguage, tt.fallback, got, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Chain(%q, %q, %v) err: %v", tt.lang, tt.acceptLanguage, tt.fallback, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Chain(%q, %q, %v) = %v, want %v", tt.lang, tt.acceptLanguage, tt.fallback, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPick(t *testing.T) {
+	tests := []struct {
+		name        string
+		available   []constants.Language
+		chain       []constants.Language
+		wantIndex   int
+		wantMatched bool
+	}{
+		{
+			name:        "first of the chain available",
+			available:   []constants.Language{en, vi},
+			chain:       []constants.Language{vi, en},
+			wantIndex:   1,
+			wantMatched: true,
+		},
+		{
+			name:        "later language of the chain available",
+			available:   []constants.Language{en, fr},
+			chain:       []constants.Language{vi, de, fr},
+			wantIndex:   1,
+			wantMatched: true,
+		},
+		{
+			name:      "none of the chain available",
+			available: []constants.Language{es, fr},
+			chain:     []constants.Language{vi, en},
+			wantIndex: 0,
+		},
+		{
+			name:      "empty chain",
+			available: []constants.Language{es},
+			wantIndex: 0,
+		},
+		{
+			name:      "no language at all",
+			chain:     []constants.Language{en},
+			wantIndex: -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index, matched := Pick(tt.available, tt.chain)
+			if index != tt.wantIndex || matched != tt.wantMatched {
+				t.Errorf("Pick(%v, %v) = %d, %t, want %d, %t", tt.available, tt.chain, index, matched, tt.wantIndex, tt.wantMatched)
+			}
+		})
+	}
+}
+
+func TestAcceptedCodes(t *testing.T) {
+	tests := []struct {
+		header string
+		want   []string
+	}{
+		{header: "", want: []string{}},
+		{header: "vi", want: []string{"vi"}},
+		{header: "vi-VN,vi;q=0.9,en;q=0.8", want: []string{"vi-VN", "vi", "en"}},
+		{header: "en;q=0.5, fr, de;q=0.7", want: []string{"fr", "de", "en"}},
+		{header: "en;q=0.5, fr;q=0.5", want: []string{"en", "fr"}},
+		{header: "fr;q=0, en", want: []string{"en"}},
+		{header: "*, en;q=0.1", want: []string{"en"}},
+		{header: " es ; q = 0.3 , , de;level=1", want: []string{"de", "es"}},
+		{header: "en;q=abc", want: []string{"en"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			if got := acceptedCodes(tt.header); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("acceptedCodes(%q) = %v, want %v", tt.header, got, tt.want)
+			}
+		})
+	}
+}
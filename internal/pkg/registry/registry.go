@@ -0,0 +1,192 @@
+// Package registry keeps the languages and components content can use in process. They
+// are stored in the database, but read on every request that writes or negotiates a
+// language, so each replica holds a copy that it reloads after its own changes and once
+// every refresh interval for the changes made through the others. Until the first load
+// the copy is the seed built from the constants.
+package registry
+
+import (
+	"context"
+	"gallery-service/internal/domain/models"
+	"gallery-service/internal/domain/repository"
+	"gallery-service/internal/pkg/constants"
+	"sort"
+	"sync/atomic"
+)
+
+// snapshot is one load of the registry, which is never changed once stored
+type snapshot struct {
+	languages  []models.GalleryLanguage
+	components []models.GalleryComponent
+}
+
+var current atomic.Pointer[snapshot]
+
+func init() {
+	languages, components := Seed()
+	store(languages, components)
+}
+
+// Seed builds the registry the service had before it was stored in the database, from
+// the constants. Every entry is enabled and named in English, and the entries are ordered
+// by code and key.
+func Seed() ([]*models.GalleryLanguage, []*models.GalleryComponent) {
+	codes := make([]string, 0, len(constants.GalleryLanguages))
+	for code := range constants.GalleryLanguages {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	languages := make([]*models.GalleryLanguage, 0, len(codes))
+	for i, code := range codes {
+		name := constants.GalleryLanguages[code]
+		languages = append(languages, &models.GalleryLanguage{
+			Code:         code,
+			Name:         name,
+			DisplayNames: map[string]string{"en": name.String()},
+			Position:     int64(i),
+			Enabled:      true,
+		})
+	}
+
+	keys := make([]string, 0, len(constants.Components))
+	for key := range constants.Components {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	components := make([]*models.GalleryComponent, 0, len(keys))
+	for i, key := range keys {
+		name := constants.Components[key]
+		components = append(components, &models.GalleryComponent{
+			Key:          key,
+			Name:         name,
+			DisplayNames: map[string]string{"en": name.String()},
+			Media:        constants.ComponentMedia[key],
+			Position:     int64(i),
+			Enabled:      true,
+		})
+	}
+
+	return languages, components
+}
+
+// Init seeds the database with the registry of the constants the first time the service
+// starts, then loads it
+func Init(ctx context.Context, registryRepo repository.RegistryRepository) error {
+	languages, components := Seed()
+	if _, err := registryRepo.SeedLanguages(ctx, languages); err != nil {
+		return err
+	}
+
+	if _, err := registryRepo.SeedComponents(ctx, components); err != nil {
+		return err
+	}
+
+	return Load(ctx, registryRepo)
+}
+
+// Load replaces the registry held in process with the one in the database
+func Load(ctx context.Context, registryRepo repository.RegistryRepository) error {
+	languages, err := registryRepo.GetLanguages(ctx)
+	if err != nil {
+		return err
+	}
+
+	components, err := registryRepo.GetComponents(ctx)
+	if err != nil {
+		return err
+	}
+
+	store(languages, components)
+
+	return nil
+}
+
+func store(languages []*models.GalleryLanguage, components []*models.GalleryComponent) {
+	s := &snapshot{
+		languages:  make([]models.GalleryLanguage, 0, len(languages)),
+		components: make([]models.GalleryComponent, 0, len(components)),
+	}
+	for _, language := range languages {
+		s.languages = append(s.languages, *language)
+	}
+	for _, component := range components {
+		s.components = append(s.components, *component)
+	}
+
+	current.Store(s)
+}
+
+// Languages returns the languages in display order. Only the enabled ones are returned
+// when enabledOnly is set.
+func Languages(enabledOnly bool) []models.GalleryLanguage {
+	languages := make([]models.GalleryLanguage, 0)
+	for _, language := range current.Load().languages {
+		if language.Enabled || !enabledOnly {
+			languages = append(languages, language)
+		}
+	}
+
+	return languages
+}
+
+// LanguageByCode returns the language with the given code, such as "fr", whether it is
+// enabled or not
+func LanguageByCode(code string) (models.GalleryLanguage, bool) {
+	for _, language := range current.Load().languages {
+		if language.Code == code {
+			return language, true
+		}
+	}
+
+	return models.GalleryLanguage{}, false
+}
+
+// Code returns the code of the language content is stored under, or an empty string
+func Code(name constants.Language) string {
+	for _, language := range current.Load().languages {
+		if language.Name == name {
+			return language.Code
+		}
+	}
+
+	return ""
+}
+
+// Codes returns the codes of the languages of chain, leaving out the unknown ones
+func Codes(chain []constants.Language) []string {
+	codes := make([]string, 0, len(chain))
+	for _, name := range chain {
+		if code := Code(name); code != "" {
+			codes = append(codes, code)
+		}
+	}
+
+	return codes
+}
+
+// Components returns the components in display order. Only the enabled ones are returned
+// when enabledOnly is set.
+func Components(enabledOnly bool) []models.GalleryComponent {
+	components := make([]models.GalleryComponent, 0)
+	for _, component := range current.Load().components {
+		if component.Enabled || !enabledOnly {
+			components = append(components, component)
+		}
+	}
+
+	return components
+}
+
+// ComponentByKey returns the component with the given key, such as "video-player",
+// whether it is enabled or not
+func ComponentByKey(key string) (models.GalleryComponent, bool) {
+	for _, component := range current.Load().components {
+		if component.Key == key {
+			return component, true
+		}
+	}
+
+	return models.GalleryComponent{}, false
+}
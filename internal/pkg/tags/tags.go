@@ -0,0 +1,29 @@
+package tags
+
+import "strings"
+
+// Normalize trims and lowercases tags and drops empty and repeated ones, keeping the
+// order they came in
+func Normalize(tags []string) []string {
+	res := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t == "" || seen[t] {
+			continue
+		}
+		seen[t] = true
+		res = append(res, t)
+	}
+
+	return res
+}
+
+// Split reads a comma separated list of tags, as query strings carry them
+func Split(list string) []string {
+	if strings.TrimSpace(list) == "" {
+		return nil
+	}
+
+	return Normalize(strings.Split(list, ","))
+}
@@ -0,0 +1,51 @@
+package tags
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		tags []string
+		want []string
+	}{
+		{name: "no tags", tags: nil, want: []string{}},
+		{name: "trimmed and lowercased", tags: []string{" Animals ", "SEA"}, want: []string{"animals", "sea"}},
+		{name: "empty tags dropped", tags: []string{"", "  ", "sea"}, want: []string{"sea"}},
+		{name: "repeated tags dropped", tags: []string{"sea", "Sea ", "animals", "SEA"}, want: []string{"sea", "animals"}},
+		{name: "order kept", tags: []string{"zoo", "animals", "mountain"}, want: []string{"zoo", "animals", "mountain"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Normalize(tt.tags); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.tags, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		list string
+		want []string
+	}{
+		{list: "", want: nil},
+		{list: "  ", want: nil},
+		{list: "sea", want: []string{"sea"}},
+		{list: "Sea, animals ,MOUNTAIN", want: []string{"sea", "animals", "mountain"}},
+		{list: "sea,,animals,", want: []string{"sea", "animals"}},
+		{list: "sea,SEA, sea", want: []string{"sea"}},
+		{list: ",", want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.list, func(t *testing.T) {
+			if got := Split(tt.list); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Split(%q) = %q, want %q", tt.list, got, tt.want)
+			}
+		})
+	}
+}
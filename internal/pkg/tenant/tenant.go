@@ -0,0 +1,125 @@
+package tenant
+
+import (
+	"context"
+	"gallery-service/internal/pkg/apicall/dto"
+	httpPkg "gallery-service/pkg/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// CurrentUserKey is the context key the Auth middleware stores the user under
+	CurrentUserKey = "current_user"
+	// SuperAdminRole authors the global content shared by every organization
+	SuperAdminRole = "SuperAdmin"
+)
+
+// Scope is the organization a request acts for. A request without a user, such as a
+// gateway call or a CLI job, is not restricted to any organization.
+type Scope struct {
+	user *dto.UserEntityResponse
+}
+
+func FromContext(ctx context.Context) Scope {
+	user, _ := ctx.Value(CurrentUserKey).(*dto.UserEntityResponse)
+	return Scope{user: user}
+}
+
+// Unrestricted reports whether the request can read and write every organization's content
+func (s Scope) Unrestricted() bool {
+	return s.user == nil || s.IsSuperAdmin()
+}
+
+func (s Scope) IsSuperAdmin() bool {
+	return s.HasRole(SuperAdminRole)
+}
+
+func (s Scope) UserID() string {
+	if s.user == nil {
+		return ""
+	}
+
+	return s.user.ID
+}
+
+func (s Scope) HasRole(roleName string) bool {
+	if s.user == nil || s.user.Roles == nil {
+		return false
+	}
+
+	for _, role := range *s.user.Roles {
+		if strings.EqualFold(role.RoleName, roleName) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsOrganizationAdmin reports whether the user administers the organization owning the content
+func (s Scope) IsOrganizationAdmin(organizationID *string) bool {
+	if s.user == nil || s.user.OrganizationAdmin == nil || organizationID == nil {
+		return false
+	}
+
+	return s.user.OrganizationAdmin.ID != "" && s.user.OrganizationAdmin.ID == *organizationID
+}
+
+// OrganizationID returns the organization new content is owned by: the one the user
+// administers, falling back to the first one they belong to
+func (s Scope) OrganizationID() string {
+	if s.user == nil {
+		return ""
+	}
+
+	if s.user.OrganizationAdmin != nil && s.user.OrganizationAdmin.ID != "" {
+		return s.user.OrganizationAdmin.ID
+	}
+
+	if len(s.user.Organization) > 0 {
+		return s.user.Organization[0]
+	}
+
+	return ""
+}
+
+// OrganizationIDs returns every organization whose content the user can read
+func (s Scope) OrganizationIDs() []string {
+	if s.user == nil {
+		return nil
+	}
+
+	ids := make([]string, 0, len(s.user.Organization)+1)
+	ids = append(ids, s.user.Organization...)
+	if s.user.OrganizationAdmin != nil && s.user.OrganizationAdmin.ID != "" {
+		ids = append(ids, s.user.OrganizationAdmin.ID)
+	}
+
+	return ids
+}
+
+// Owner returns the organization to stamp on new content, nil meaning global
+func (s Scope) Owner() (*string, error) {
+	if s.Unrestricted() {
+		return nil, nil
+	}
+
+	organizationID := s.OrganizationID()
+	if organizationID == "" {
+		return nil, errors.Wrap(httpPkg.Forbidden, "user does not belong to an organization")
+	}
+
+	return &organizationID, nil
+}
+
+// CanWrite reports whether content owned by organizationID can be changed. Global
+// content is only writable by a SuperAdmin.
+func (s Scope) CanWrite(organizationID *string) bool {
+	if s.Unrestricted() {
+		return true
+	}
+
+	return organizationID != nil && *organizationID != "" && *organizationID == s.OrganizationID()
+}
@@ -0,0 +1,226 @@
+package timecode
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+)
+
+// Timecode is a position in a video or audio track with millisecond precision. The zero
+// value means the position is not set: a media without a start time plays from the
+// beginning and one without an end time plays to the end.
+//
+// A stored value that does not parse, written before timecodes were validated, is kept
+// as it was in raw, so that saving the document writes it back unchanged until the
+// normalize-media-timecodes migration or an editor repairs it.
+type Timecode struct {
+	millis int64
+	raw    *bson.RawValue
+}
+
+var (
+	clockPattern   = regexp.MustCompile(`^(\d+):([0-5]\d):([0-5]\d)(?:\.(\d{1,3}))?$`)
+	secondsPattern = regexp.MustCompile(`^(\d+)(?:\.(\d{1,3}))?$`)
+)
+
+// Parse reads a timecode written as HH:MM:SS with optional milliseconds, or as a number
+// of seconds with optional milliseconds ("75", "75.5"). An empty string is the zero
+// timecode.
+func Parse(value string) (Timecode, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return Timecode{}, nil
+	}
+
+	if m := clockPattern.FindStringSubmatch(value); m != nil {
+		hours, _ := strconv.ParseInt(m[1], 10, 64)
+		minutes, _ := strconv.ParseInt(m[2], 10, 64)
+		seconds, _ := strconv.ParseInt(m[3], 10, 64)
+		return fromParts((hours*60+minutes)*60+seconds, m[4]), nil
+	}
+
+	if m := secondsPattern.FindStringSubmatch(value); m != nil {
+		seconds, err := strconv.ParseInt(m[1], 10, 64)
+		if err == nil {
+			return fromParts(seconds, m[2]), nil
+		}
+	}
+
+	return Timecode{}, errors.Errorf("invalid timecode %q, expected HH:MM:SS(.mmm) or seconds", value)
+}
+
+// FromSeconds converts a number of seconds, rounded to the millisecond
+func FromSeconds(seconds float64) (Timecode, error) {
+	if seconds < 0 || math.IsNaN(seconds) || math.IsInf(seconds, 0) {
+		return Timecode{}, errors.Errorf("invalid timecode %v, expected a positive number of seconds", seconds)
+	}
+
+	return Timecode{millis: int64(math.Round(seconds * 1000))}, nil
+}
+
+func fromParts(seconds int64, fraction string) Timecode {
+	millis := int64(0)
+	if fraction != "" {
+		millis, _ = strconv.ParseInt((fraction + "00")[:3], 10, 64)
+	}
+
+	return Timecode{millis: seconds*1000 + millis}
+}
+
+// IsZero reports whether the timecode is not set
+func (t Timecode) IsZero() bool {
+	return t.millis == 0 && t.raw == nil
+}
+
+// IsValid reports whether the timecode is not a stored value that does not parse
+func (t Timecode) IsValid() bool {
+	return t.raw == nil
+}
+
+// Duration returns the timecode as an offset from the start of the media
+func (t Timecode) Duration() time.Duration {
+	return time.Duration(t.millis) * time.Millisecond
+}
+
+// String formats the timecode as HH:MM:SS, followed by .mmm when it is not on a whole
+// second. The zero timecode formats as an empty string, and a stored value that does not
+// parse as it was stored.
+func (t Timecode) String() string {
+	if t.raw != nil {
+		if value, ok := t.raw.StringValueOK(); ok {
+			return value
+		}
+		return t.raw.String()
+	}
+
+	if t.IsZero() {
+		return ""
+	}
+
+	millis := t.millis % 1000
+	seconds := t.millis / 1000
+	clock := fmt.Sprintf("%02d:%02d:%02d", seconds/3600, seconds/60%60, seconds%60)
+	if millis == 0 {
+		return clock
+	}
+
+	return fmt.Sprintf("%s.%03d", clock, millis)
+}
+
+// CheckRange fails when either timecode is a stored value that does not parse, or when
+// both are set and end does not come after start
+func CheckRange(start, end Timecode) error {
+	for _, t := range []Timecode{start, end} {
+		if !t.IsValid() {
+			return errors.Errorf("invalid timecode %q, expected HH:MM:SS(.mmm) or seconds", t)
+		}
+	}
+
+	if end.IsZero() || end.millis > start.millis {
+		return nil
+	}
+
+	return errors.Errorf("end time %s must be after start time %s", end, start.orZero())
+}
+
+func (t Timecode) orZero() string {
+	if t.IsZero() {
+		return "00:00:00"
+	}
+
+	return t.String()
+}
+
+// MarshalJSON writes the timecode in its canonical string form, and a stored value that
+// does not parse as it was stored
+func (t Timecode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON accepts a timecode string or a number of seconds
+func (t *Timecode) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*t = Timecode{}
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err == nil {
+		parsed, err := Parse(value)
+		if err != nil {
+			return errors.Wrap(err, "cannot unmarshal timecode")
+		}
+		*t = parsed
+		return nil
+	}
+
+	var seconds float64
+	if err := json.Unmarshal(data, &seconds); err != nil {
+		return errors.Errorf("cannot unmarshal %s into a timecode", data)
+	}
+
+	parsed, err := FromSeconds(seconds)
+	if err != nil {
+		return errors.Wrap(err, "cannot unmarshal timecode")
+	}
+	*t = parsed
+
+	return nil
+}
+
+// MarshalBSONValue stores the timecode in its canonical string form, so documents stay
+// readable by the apps that read the collections directly. A stored value that does not
+// parse is written back as it was.
+func (t Timecode) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if t.raw != nil {
+		return t.raw.Type, t.raw.Value, nil
+	}
+
+	return bson.TypeString, bsoncore.AppendString(nil, t.String()), nil
+}
+
+// UnmarshalBSONValue reads stored timecodes. Values written before timecodes were
+// validated may not parse, those are kept as they were rather than failing the read, so
+// that one bad document does not break the listings, and rather than reading as not set,
+// so that saving the document does not lose them.
+func (t *Timecode) UnmarshalBSONValue(typ bsontype.Type, data []byte) error {
+	value := bson.RawValue{Type: typ, Value: data}
+
+	var (
+		parsed Timecode
+		err    error
+	)
+
+	switch typ {
+	case bson.TypeString:
+		parsed, err = Parse(value.StringValue())
+	case bson.TypeInt32:
+		parsed, err = FromSeconds(float64(value.Int32()))
+	case bson.TypeInt64:
+		parsed, err = FromSeconds(float64(value.Int64()))
+	case bson.TypeDouble:
+		parsed, err = FromSeconds(value.Double())
+	case bson.TypeNull, bson.TypeUndefined:
+		parsed = Timecode{}
+	default:
+		err = errors.Errorf("unexpected %s value", typ)
+	}
+
+	if err != nil {
+		value.Value = append([]byte(nil), data...)
+		*t = Timecode{raw: &value}
+		return nil
+	}
+	*t = parsed
+
+	return nil
+}
@@ -0,0 +1,228 @@
+package timecode
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type media struct {
+	StartTime Timecode `json:"start_time" bson:"start_time,omitempty"`
+	EndTime   Timecode `json:"end_time" bson:"end_time,omitempty"`
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{value: "", want: 0},
+		{value: "   ", want: 0},
+		{value: "00:00:00", want: 0},
+		{value: "00:01:15", want: 75 * time.Second},
+		{value: "01:02:03", want: time.Hour + 2*time.Minute + 3*time.Second},
+		{value: "100:00:00", want: 100 * time.Hour},
+		{value: "00:00:01.5", want: 1500 * time.Millisecond},
+		{value: "00:00:01.05", want: 1050 * time.Millisecond},
+		{value: "00:00:01.005", want: 1005 * time.Millisecond},
+		{value: " 00:00:10 ", want: 10 * time.Second},
+		{value: "75", want: 75 * time.Second},
+		{value: "75.5", want: 75500 * time.Millisecond},
+		{value: "0.001", want: time.Millisecond},
+		{value: "1:5", wantErr: true},
+		{value: "1:05", wantErr: true},
+		{value: "00:60:00", wantErr: true},
+		{value: "00:00:60", wantErr: true},
+		{value: "00:00:01.0001", wantErr: true},
+		{value: "-5", wantErr: true},
+		{value: "1e3", wantErr: true},
+		{value: "abc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := Parse(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Parse(%q) = %v, want an error", tt.value, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse(%q) err: %v", tt.value, err)
+			}
+			if got.Duration() != tt.want {
+				t.Errorf("Parse(%q) = %v, want %v", tt.value, got.Duration(), tt.want)
+			}
+		})
+	}
+}
+
+func TestFromSeconds(t *testing.T) {
+	tests := []struct {
+		seconds float64
+		want    string
+		wantErr bool
+	}{
+		{seconds: 0, want: ""},
+		{seconds: 75, want: "00:01:15"},
+		{seconds: 1.0004, want: "00:00:01"},
+		{seconds: 1.0005, want: "00:00:01.001"},
+		{seconds: -1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := FromSeconds(tt.seconds)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("FromSeconds(%v) = %v, want an error", tt.seconds, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("FromSeconds(%v) err: %v", tt.seconds, err)
+			continue
+		}
+		if got.String() != tt.want {
+			t.Errorf("FromSeconds(%v) = %q, want %q", tt.seconds, got, tt.want)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "", want: ""},
+		{value: "75", want: "00:01:15"},
+		{value: "00:00:01.5", want: "00:00:01.500"},
+		{value: "3723.004", want: "01:02:03.004"},
+		{value: "100:00:00", want: "100:00:00"},
+	}
+
+	for _, tt := range tests {
+		parsed, err := Parse(tt.value)
+		if err != nil {
+			t.Fatalf("Parse(%q) err: %v", tt.value, err)
+		}
+		if got := parsed.String(); got != tt.want {
+			t.Errorf("Parse(%q).String() = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestCheckRange(t *testing.T) {
+	tests := []struct {
+		name    string
+		start   string
+		end     string
+		wantErr bool
+	}{
+		{name: "neither set"},
+		{name: "start only", start: "00:00:10"},
+		{name: "end only", end: "00:00:10"},
+		{name: "end after start", start: "00:00:10", end: "00:00:10.001"},
+		{name: "end equal to start", start: "00:00:10", end: "00:00:10", wantErr: true},
+		{name: "end before start", start: "00:00:10", end: "00:00:05", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, _ := Parse(tt.start)
+			end, _ := Parse(tt.end)
+			if err := CheckRange(start, end); (err != nil) != tt.wantErr {
+				t.Errorf("CheckRange(%q, %q) err: %v, want error %t", tt.start, tt.end, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    string
+		wantErr bool
+	}{
+		{name: "clock", data: `{"start_time":"00:01:15","end_time":"00:02:00.5"}`, want: `{"start_time":"00:01:15","end_time":"00:02:00.500"}`},
+		{name: "seconds", data: `{"start_time":75,"end_time":"120.5"}`, want: `{"start_time":"00:01:15","end_time":"00:02:00.500"}`},
+		{name: "unset", data: `{"start_time":null,"end_time":""}`, want: `{"start_time":"","end_time":""}`},
+		{name: "short clock", data: `{"start_time":"1:5"}`, wantErr: true},
+		{name: "negative seconds", data: `{"start_time":-1}`, wantErr: true},
+		{name: "wrong type", data: `{"start_time":true}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var m media
+			err := json.Unmarshal([]byte(tt.data), &m)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Unmarshal(%s) = %+v, want an error", tt.data, m)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unmarshal(%s) err: %v", tt.data, err)
+			}
+
+			got, err := json.Marshal(m)
+			if err != nil {
+				t.Fatalf("Marshal err: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("round trip of %s = %s, want %s", tt.data, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		doc   bson.M
+		want  bson.D
+		valid bool
+	}{
+		{name: "clock", doc: bson.M{"start_time": "00:01:15", "end_time": "00:02:00.5"}, want: bson.D{{Key: "start_time", Value: "00:01:15"}, {Key: "end_time", Value: "00:02:00.500"}}, valid: true},
+		{name: "seconds", doc: bson.M{"start_time": int32(75), "end_time": 120.5}, want: bson.D{{Key: "start_time", Value: "00:01:15"}, {Key: "end_time", Value: "00:02:00.500"}}, valid: true},
+		{name: "unset", doc: bson.M{"start_time": nil, "end_time": ""}, want: bson.D{}, valid: true},
+		{name: "unparseable string kept", doc: bson.M{"start_time": "1:5", "end_time": "00:00:10"}, want: bson.D{{Key: "start_time", Value: "1:5"}, {Key: "end_time", Value: "00:00:10"}}},
+		{name: "negative number kept", doc: bson.M{"start_time": int64(-3)}, want: bson.D{{Key: "start_time", Value: int64(-3)}}},
+		{name: "wrong type kept", doc: bson.M{"start_time": true}, want: bson.D{{Key: "start_time", Value: true}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := bson.Marshal(tt.doc)
+			if err != nil {
+				t.Fatalf("Marshal(%v) err: %v", tt.doc, err)
+			}
+
+			var m media
+			if err := bson.Unmarshal(data, &m); err != nil {
+				t.Fatalf("Unmarshal(%v) err: %v", tt.doc, err)
+			}
+			if valid := m.StartTime.IsValid() && m.EndTime.IsValid(); valid != tt.valid {
+				t.Errorf("Unmarshal(%v) valid = %t, want %t", tt.doc, valid, tt.valid)
+			}
+			if err := CheckRange(m.StartTime, m.EndTime); (err == nil) != tt.valid {
+				t.Errorf("CheckRange after Unmarshal(%v) err: %v", tt.doc, err)
+			}
+
+			stored, err := bson.Marshal(m)
+			if err != nil {
+				t.Fatalf("Marshal(%+v) err: %v", m, err)
+			}
+			want, _ := bson.Marshal(tt.want)
+			if !bytes.Equal(stored, want) {
+				t.Errorf("round trip of %v = %s, want %s", tt.doc, bson.Raw(stored), bson.Raw(want))
+			}
+		})
+	}
+}
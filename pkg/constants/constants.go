@@ -47,10 +47,21 @@ const (
 	CreatedDate  = "CreatedDate"
 	UserMetadata = "UserMetadata"
 
-	Page   = "page"
-	Size   = "size"
-	Search = "search"
-	ID     = "id"
+	Page      = "page"
+	Size      = "size"
+	Search    = "search"
+	ID        = "id"
+	Strategy  = "strategy"
+	Recursive = "recursive"
+	Revision  = "revision"
+	Type      = "type"
+	Code      = "code"
+	Lang      = "lang"
+	Tag       = "tag"
+	Term      = "term"
+	Kind      = "kind"
+	Item      = "item"
+	Key       = "key"
 
 	EsAll = "$all"
 
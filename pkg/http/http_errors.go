@@ -20,6 +20,8 @@ const (
 	ErrBadRequest          = "Bad request"
 	ErrNotFound            = "Not Found"
 	ErrUnauthorized        = "Unauthorized"
+	ErrForbidden           = "Forbidden"
+	ErrConflict            = "Conflict"
 	ErrRequestTimeout      = "Request Timeout"
 	ErrInvalidProductName  = "Invalid cluster name"
 	ErrInvalidSBCode       = "Invalid SB-Code"
@@ -34,6 +36,7 @@ var (
 	NotFound            = errors.New("Not Found")
 	Unauthorized        = errors.New("Unauthorized")
 	Forbidden           = errors.New("Forbidden")
+	Conflict            = errors.New("Conflict")
 	TooManyRequest      = errors.New("Too Many Request")
 	InternalServerError = errors.New("Internal Server Error")
 )
@@ -48,10 +51,36 @@ type RestErr interface {
 
 // RestError Rest error struct
 type RestError struct {
-	ErrStatus  int       `json:"status_code,omitempty"`
-	ErrError   string    `json:"error,omitempty"`
-	ErrMessage string    `json:"message,omitempty"`
-	Timestamp  time.Time `json:"timestamp,omitempty"`
+	ErrStatus  int          `json:"status_code,omitempty"`
+	ErrError   string       `json:"error,omitempty"`
+	ErrMessage string       `json:"message,omitempty"`
+	ErrDetails []FieldError `json:"details,omitempty"`
+	Timestamp  time.Time    `json:"timestamp,omitempty"`
+}
+
+// FieldError is what is wrong with one field of a request. Field is the JSON path of the
+// field, such as language_config[1].videos.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// FieldErrors is a bad request that lists every field at fault. The fields are returned
+// in the details of the response whether or not debug errors are enabled.
+type FieldErrors []FieldError
+
+func (e FieldErrors) Error() string {
+	causes := make([]string, 0, len(e))
+	for _, fieldErr := range e {
+		causes = append(causes, fmt.Sprintf("%s: %s", fieldErr.Field, fieldErr.Message))
+	}
+
+	return fmt.Sprintf("%s: %s", ErrBadRequest, strings.Join(causes, "; "))
+}
+
+// Unwrap makes FieldErrors match BadRequest
+func (e FieldErrors) Unwrap() error {
+	return BadRequest
 }
 
 // ErrBody Error body
@@ -219,7 +248,12 @@ func NewInternalServerError(ctx *fiber.Ctx, causes string, debug bool) error {
 
 // ParseErrors Parser of error string messages returns RestError
 func ParseErrors(err error, debug bool) RestErr {
+	var fieldErrors FieldErrors
 	switch {
+	case errors.As(err, &fieldErrors):
+		restError := NewRestError(http.StatusBadRequest, ErrBadRequest, err.Error(), debug).(RestError)
+		restError.ErrDetails = fieldErrors
+		return restError
 	case errors.Is(err, sql.ErrNoRows):
 		return NewRestError(http.StatusNotFound, ErrNotFound, err.Error(), debug)
 	case errors.Is(err, context.DeadlineExceeded):
@@ -228,6 +262,12 @@ func ParseErrors(err error, debug bool) RestErr {
 		return NewRestError(http.StatusUnauthorized, ErrUnauthorized, err.Error(), debug)
 	case errors.Is(err, WrongCredentials):
 		return NewRestError(http.StatusUnauthorized, ErrUnauthorized, err.Error(), debug)
+	case errors.Is(err, Forbidden):
+		return NewRestError(http.StatusForbidden, ErrForbidden, err.Error(), debug)
+	case errors.Is(err, BadRequest):
+		return NewRestError(http.StatusBadRequest, ErrBadRequest, err.Error(), debug)
+	case errors.Is(err, Conflict):
+		return NewRestError(http.StatusConflict, ErrConflict, err.Error(), debug)
 
 	case strings.Contains(strings.ToLower(err.Error()), constants.SQLState):
 		return parseSqlErrors(err, debug)
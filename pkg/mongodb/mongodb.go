@@ -24,9 +24,14 @@ type Config struct {
 }
 
 type MongoCollections struct {
-	Cluster string `mapstructure:"cluster" validate:"required"`
-	Folder  string `mapstructure:"folder" validate:"required"`
-	Topic   string `mapstructure:"topic" validate:"required"`
+	Cluster   string `mapstructure:"cluster" validate:"required"`
+	Folder    string `mapstructure:"folder" validate:"required"`
+	Topic     string `mapstructure:"topic" validate:"required"`
+	Revision  string `mapstructure:"revision" validate:"required"`
+	Taxonomy  string `mapstructure:"taxonomy" validate:"required"`
+	Lease     string `mapstructure:"lease" validate:"required"`
+	Language  string `mapstructure:"language" validate:"required"`
+	Component string `mapstructure:"component" validate:"required"`
 }
 
 // Client represents a service that interacts with MongoDB.
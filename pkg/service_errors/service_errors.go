@@ -3,4 +3,6 @@ package serviceErrors
 const (
 	ErrMsgMongoCollectionAlreadyExists = "Collection already exists"
 	ErrMsgAlreadyExists                = "already exists"
+	ErrMsgIndexNotFound                = "index not found"
+	ErrMsgNamespaceNotFound            = "ns not found"
 )